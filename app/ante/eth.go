@@ -2,7 +2,6 @@ package ante
 
 import (
 	"fmt"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"math/big"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
@@ -12,6 +11,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	authante "github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	vestexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/ethereum/go-ethereum/common"
 	ethcore "github.com/ethereum/go-ethereum/core"
 	ethermint "github.com/okex/exchain/app/types"
@@ -22,6 +22,8 @@ import (
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetChainConfig(ctx sdk.Context) (evmtypes.ChainConfig, bool)
 }
 
 // EthSetupContextDecorator sets the infinite GasMeter in the Context and wraps
@@ -378,8 +380,25 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		)
 	}
 
+	// the state objects of the evm only hold eth accounts, which keeps the vesting coins from being spent by evm txs
+	if _, ok := senderAcc.(vestexported.VestingAccount); ok {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"vesting account %s (%s) can't send evm txs", common.BytesToAddress(address.Bytes()), address,
+		)
+	}
+
+	// the typed transactions are rejected before their forks, so that no access list gas is charged then
+	config, found := egcd.evmKeeper.GetChainConfig(ctx)
+	if !found {
+		return ctx, evmtypes.ErrChainConfigNotFound
+	}
+	if err := msgEthTx.ValidateTxType(config, ctx.BlockHeight()); err != nil {
+		return ctx, err
+	}
+
 	gasLimit := msgEthTx.GetGas()
-	gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, []ethtypes.AccessTuple{}, msgEthTx.To() == nil, true, false)
+	gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, msgEthTx.AccessList(), msgEthTx.To() == nil, true, false)
 	if err != nil {
 		return ctx, sdkerrors.Wrap(err, "failed to compute intrinsic gas cost")
 	}
@@ -389,10 +408,17 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "intrinsic gas too low: %d < %d", gasLimit, gas)
 	}
 
+	// the max fee per gas of the transactions must cover the base fee of the block after the London fork
+	baseFee := egcd.evmKeeper.GetBaseFee(ctx)
+	if baseFee != nil && msgEthTx.Data.Price.Cmp(baseFee) < 0 {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+			"max fee per gas less than block base fee (%s < %s)", msgEthTx.Data.Price, baseFee)
+	}
+
 	// Charge sender for gas up to limit
 	if gasLimit != 0 {
-		// Cost calculates the fees paid to validators based on gas limit and price
-		cost := new(big.Int).Mul(msgEthTx.Data.Price, new(big.Int).SetUint64(gasLimit))
+		// Cost calculates the fees paid to validators based on gas limit and the effective gas price
+		cost := new(big.Int).Mul(msgEthTx.EffectiveGasPrice(baseFee), new(big.Int).SetUint64(gasLimit))
 
 		evmDenom := sdk.DefaultBondDenom
 
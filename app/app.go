@@ -35,6 +35,7 @@ import (
 	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
 	tendermintTypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/okex/exchain/x/ammswap"
+	ammswapclient "github.com/okex/exchain/x/ammswap/client"
 	"github.com/okex/exchain/x/backend"
 	"github.com/okex/exchain/x/common/analyzer"
 	commonversion "github.com/okex/exchain/x/common/version"
@@ -42,6 +43,9 @@ import (
 	"github.com/okex/exchain/x/dex"
 	dexclient "github.com/okex/exchain/x/dex/client"
 	distr "github.com/okex/exchain/x/distribution"
+	"github.com/okex/exchain/x/erc20"
+	erc20client "github.com/okex/exchain/x/erc20/client"
+	erc20types "github.com/okex/exchain/x/erc20/types"
 	"github.com/okex/exchain/x/evidence"
 	"github.com/okex/exchain/x/evm"
 	evmclient "github.com/okex/exchain/x/evm/client"
@@ -58,6 +62,7 @@ import (
 	"github.com/okex/exchain/x/staking"
 	"github.com/okex/exchain/x/stream"
 	"github.com/okex/exchain/x/token"
+	tokenclient "github.com/okex/exchain/x/token/client"
 	"github.com/spf13/viper"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -97,6 +102,11 @@ var (
 			evmclient.ManageContractDeploymentWhitelistProposalHandler,
 			evmclient.ManageContractBlockedListProposalHandler,
 			evmclient.ManageContractMethodBlockedListProposalHandler,
+			erc20client.TokenMappingProposalHandler,
+			erc20client.PauseTokenMappingProposalHandler,
+			ammswapclient.WithdrawProtocolFeeProposalHandler,
+			ammswapclient.RampAmplificationProposalHandler,
+			tokenclient.VerifyTokenMetadataProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -113,26 +123,29 @@ var (
 		debug.AppModuleBasic{},
 		ammswap.AppModuleBasic{},
 		farm.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 
 		nameservice.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            nil,
-		token.ModuleName:          {supply.Minter, supply.Burner},
-		dex.ModuleName:            nil,
-		order.ModuleName:          nil,
-		backend.ModuleName:        nil,
-		ammswap.ModuleName:        {supply.Minter, supply.Burner},
-		farm.ModuleName:           nil,
-		farm.YieldFarmingAccount:  nil,
-		farm.MintFarmingAccount:   {supply.Burner},
+		auth.FeeCollectorName:      nil,
+		distr.ModuleName:           nil,
+		mint.ModuleName:            {supply.Minter},
+		staking.BondedPoolName:     {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:  {supply.Burner, supply.Staking},
+		gov.ModuleName:             nil,
+		token.ModuleName:           {supply.Minter, supply.Burner},
+		dex.ModuleName:             nil,
+		order.ModuleName:           nil,
+		backend.ModuleName:         nil,
+		ammswap.ModuleName:         {supply.Minter, supply.Burner},
+		ammswap.ProtocolFeeAccount: nil,
+		farm.ModuleName:            nil,
+		farm.YieldFarmingAccount:   nil,
+		farm.MintFarmingAccount:    {supply.Burner},
+		erc20.ModuleName:           nil,
 	}
 
 	GlobalGpIndex = GasPriceIndex{}
@@ -177,6 +190,7 @@ type OKExChainApp struct {
 	OrderKeeper    order.Keeper
 	SwapKeeper     ammswap.Keeper
 	FarmKeeper     farm.Keeper
+	Erc20Keeper    erc20.Keeper
 	BackendKeeper  backend.Keeper
 	StreamKeeper   stream.Keeper
 
@@ -246,6 +260,7 @@ func NewOKExChainApp(
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		evm.StoreKey, token.StoreKey, token.KeyLock, dex.StoreKey, dex.TokenPairStoreKey,
 		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey,nameservice.StoreKey,
+		erc20.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -277,6 +292,7 @@ func NewOKExChainApp(
 	app.subspaces[ammswap.ModuleName] = app.ParamsKeeper.Subspace(ammswap.DefaultParamspace)
 	app.subspaces[farm.ModuleName] = app.ParamsKeeper.Subspace(farm.DefaultParamspace)
 	app.subspaces[nameservice.ModuleName]=app.ParamsKeeper.Subspace(nameservice.DefaultParamspace)
+	app.subspaces[erc20.ModuleName] = app.ParamsKeeper.Subspace(erc20.DefaultParamspace)
 
 	// use custom OKExChain account for contracts
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -329,6 +345,9 @@ func NewOKExChainApp(
 	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, *app.EvmKeeper, app.subspaces[farm.StoreKey],
 		app.keys[farm.StoreKey], app.cdc)
 
+	app.Erc20Keeper = erc20.NewKeeper(app.SupplyKeeper, app.TokenKeeper, app.EvmKeeper, app.subspaces[erc20.ModuleName],
+		app.keys[erc20.StoreKey], app.cdc)
+
 	app.StreamKeeper = stream.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.AccountKeeper, &app.SwapKeeper,
 		&app.FarmKeeper, app.cdc, logger, appConfig, streamMetrics)
 	app.BackendKeeper = backend.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.SwapKeeper, &app.FarmKeeper,
@@ -352,12 +371,18 @@ func NewOKExChainApp(
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
 		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
-		AddRoute(evm.RouterKey, evm.NewManageContractDeploymentWhitelistProposalHandler(app.EvmKeeper))
+		AddRoute(evm.RouterKey, evm.NewManageContractDeploymentWhitelistProposalHandler(app.EvmKeeper)).
+		AddRoute(erc20.RouterKey, erc20.NewProposalHandler(&app.Erc20Keeper)).
+		AddRoute(ammswap.RouterKey, ammswap.NewProposalHandler(&app.SwapKeeper)).
+		AddRoute(token.RouterKey, token.NewProposalHandler(&app.TokenKeeper))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
 		AddRoute(farm.RouterKey, &app.FarmKeeper).
-		AddRoute(evm.RouterKey, app.EvmKeeper)
+		AddRoute(evm.RouterKey, app.EvmKeeper).
+		AddRoute(erc20.RouterKey, &app.Erc20Keeper).
+		AddRoute(ammswap.RouterKey, &app.SwapKeeper).
+		AddRoute(token.RouterKey, &app.TokenKeeper)
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultParamspace, govRouter,
@@ -367,6 +392,9 @@ func NewOKExChainApp(
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
 	app.EvmKeeper.SetGovKeeper(app.GovKeeper)
+	app.Erc20Keeper.SetGovKeeper(app.GovKeeper)
+	app.SwapKeeper.SetGovKeeper(app.GovKeeper)
+	app.TokenKeeper.SetGovKeeper(app.GovKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -374,6 +402,16 @@ func NewOKExChainApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// register the native contracts through which the evm contracts call the native modules, they are only
+	// called after enabled by the native contracts block of the evm chain config
+	evmtypes.RegisterNativeContract(evmtypes.NativeTokenContractAddress,
+		evmtypes.NewNativeTokenContract(token.NewTokenHandler(app.TokenKeeper, commonversion.ProtocolVersionV0)))
+	evmtypes.RegisterNativeContract(evmtypes.NativeStakingContractAddress,
+		evmtypes.NewNativeStakingContract(staking.NewHandler(app.StakingKeeper)))
+	evmtypes.RegisterNativeContract(evmtypes.NativeSwapContractAddress,
+		evmtypes.NewNativeSwapContract(ammswap.NewHandler(app.SwapKeeper)))
+	evmtypes.RegisterNativeContract(erc20types.BridgeContractAddress, erc20.NewBridgeContract(app.Erc20Keeper))
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -394,6 +432,7 @@ func NewOKExChainApp(
 		order.NewAppModule(commonversion.ProtocolVersionV0, app.OrderKeeper, app.SupplyKeeper),
 		ammswap.NewAppModule(app.SwapKeeper),
 		farm.NewAppModule(app.FarmKeeper),
+		erc20.NewAppModule(app.Erc20Keeper),
 		backend.NewAppModule(app.BackendKeeper),
 		stream.NewAppModule(app.StreamKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -435,7 +474,7 @@ func NewOKExChainApp(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		token.ModuleName, dex.ModuleName, order.ModuleName, ammswap.ModuleName, farm.ModuleName,
-		evm.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
+		evm.ModuleName, erc20.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
 		nameservice.ModuleName,
 	)
 
@@ -469,8 +508,9 @@ func NewOKExChainApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper)))
 	app.SetEndBlocker(app.EndBlocker)
-	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper))
+	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper))
 	app.SetAccHandler(NewAccHandler(app.AccountKeeper))
+	app.SetGasPriceHandler(NewGasPriceHandler(app.EvmKeeper))
 	app.SetParallelTxHandlers(updateFeeCollectorHandler(app.BankKeeper, app.SupplyKeeper), evmTxFeeHandler(), fixLogForParallelTxHandler(app.EvmKeeper))
 
 	if loadLatest {
@@ -625,6 +665,16 @@ func NewAccHandler(ak auth.AccountKeeper) sdk.AccHandler {
 	}
 }
 
+// NewGasPriceHandler returns the effective gas prices of the evm txs by the base fee in the evm keeper
+func NewGasPriceHandler(ek *evm.Keeper) sdk.GasPriceHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) *big.Int {
+		if msgEthTx, ok := tx.(evmtypes.MsgEthereumTx); ok {
+			return msgEthTx.EffectiveGasPrice(ek.GetBaseFee(ctx))
+		}
+		return tx.GetGasPrice()
+	}
+}
+
 func PreRun(ctx *server.Context) error {
 	// set the dynamic config
 	appconfig.RegisterDynamicConfig(ctx.Logger.With("module", "config"))
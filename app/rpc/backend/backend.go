@@ -182,6 +182,10 @@ func (b *EthermintBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethty
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header)
 	ethHeader.Bloom = bloomRes.Bloom
+	ethHeader.BaseFee, err = rpctypes.BlockBaseFee(b.clientCtx, resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
 	return ethHeader, nil
 }
 
@@ -212,6 +216,10 @@ func (b *EthermintBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header)
 	ethHeader.Bloom = bloomRes.Bloom
+	ethHeader.BaseFee, err = rpctypes.BlockBaseFee(b.clientCtx, resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
 	return ethHeader, nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/okex/exchain/app/crypto/hd"
 	"github.com/okex/exchain/app/rpc/pendingtx"
 	"github.com/okex/exchain/app/rpc/websockets"
+	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/spf13/viper"
 )
 
@@ -25,6 +26,7 @@ const (
 	flagWebsocket = "wsport"
 
 	FlagPersonalAPI    = "personal-api"
+	FlagDebugAPI       = evmtypes.FlagDebugAPI
 	FlagRateLimitAPI   = "rpc.rate-limit-api"
 	FlagRateLimitCount = "rpc.rate-limit-count"
 	FlagRateLimitBurst = "rpc.rate-limit-burst"
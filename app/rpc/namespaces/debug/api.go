@@ -0,0 +1,220 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/monitor"
+	"github.com/okex/exchain/app/rpc/namespaces/eth"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	ethermint "github.com/okex/exchain/app/types"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// PublicDebugAPI is the debug_ prefixed set of APIs in the Web3 JSON-RPC spec. The evm transactions
+// and calls are re-executed on the archived state of the node with the tracers of go-ethereum.
+type PublicDebugAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+	Metrics   map[string]*monitor.RpcMetrics
+}
+
+// NewAPI creates an instance of the public debug Web3 API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicDebugAPI {
+	return &PublicDebugAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", "debug"),
+		backend:   backend,
+	}
+}
+
+// TraceTransaction returns the trace of the transaction by re-executing it on the state of its block,
+// the transactions before it in the same block are re-executed first.
+func (api *PublicDebugAPI) TraceTransaction(txHash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	monitor := monitor.GetMonitor("debug_traceTransaction", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", txHash, "config", config)
+
+	tx, err := api.clientCtx.Client.Tx(txHash.Bytes(), false)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := api.clientCtx.Client.Block(&tx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := api.blockTraceTxs(resBlock, int(tx.Index)+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 || txs[len(txs)-1].Hash != txHash {
+		return nil, fmt.Errorf("transaction %s is not an evm transaction", txHash.Hex())
+	}
+
+	results, err := api.traceTxs(resBlock, txs, len(txs)-1, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("invalid trace results of transaction %s", txHash.Hex())
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+	return results[0].Result, nil
+}
+
+// TraceBlockByNumber returns the traces of all the evm transactions in the block by re-executing them
+// on the state of the parent block.
+func (api *PublicDebugAPI) TraceBlockByNumber(blockNum rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]evmtypes.QueryResTxTrace, error) {
+	monitor := monitor.GetMonitor("debug_traceBlockByNumber", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("number", blockNum, "config", config)
+
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := api.blockTraceTxs(resBlock, len(resBlock.Block.Txs))
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return []evmtypes.QueryResTxTrace{}, nil
+	}
+	results, err := api.traceTxs(resBlock, txs, 0, config)
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		// no evm transactions in the block
+		return []evmtypes.QueryResTxTrace{}, nil
+	}
+	return results, nil
+}
+
+// TraceCall returns the trace of the call executed on the state of the given block
+func (api *PublicDebugAPI) TraceCall(args rpctypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	monitor := monitor.GetMonitor("debug_traceCall", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args, "block number", blockNrOrHash, "config", config)
+
+	blockNum, err := api.backend.ConvertToBlockNumber(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		if height, err = api.backend.LatestBlockNumber(); err != nil {
+			return nil, err
+		}
+	}
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+	gas := uint64(ethermint.DefaultRPCGasLimit)
+	if args.Gas != nil && uint64(*args.Gas) < gas {
+		gas = uint64(*args.Gas)
+	}
+	// the nonce is set to the one of the sender on the state, and the call pays no gas fee by default
+	msg := eth.NewCallMsg(args, from, 0, gas)
+	if args.GasPrice == nil && args.MaxFeePerGas == nil {
+		msg.Price = sdk.ZeroInt()
+	}
+
+	bz, err := json.Marshal(evmtypes.QueryTraceCallParams{
+		Msg:         msg,
+		BlockHeight: height,
+		BlockTime:   resBlock.Block.Time,
+		TraceConfig: config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := api.clientCtx.WithHeight(height).QueryWithData(
+		fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryTraceCall), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var out evmtypes.QueryResTxTrace
+	if err := json.Unmarshal(res, &out); err != nil {
+		return nil, err
+	}
+	if out.Error != "" {
+		return nil, errors.New(out.Error)
+	}
+	return out.Result, nil
+}
+
+// blockTraceTxs returns the evm transactions in the first count transactions of the block, the cosmos
+// transactions are not replayed for tracing
+func (api *PublicDebugAPI) blockTraceTxs(resBlock *ctypes.ResultBlock, count int) ([]evmtypes.TraceTx, error) {
+	var txs []evmtypes.TraceTx
+	for _, tx := range resBlock.Block.Txs[:count] {
+		ethTx, err := rpctypes.RawTxToEthTx(api.clientCtx, tx)
+		if err != nil {
+			// ignore the cosmos transactions
+			continue
+		}
+		bz, err := ethTx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, evmtypes.TraceTx{Tx: bz, Hash: common.BytesToHash(tx.Hash())})
+	}
+	return txs, nil
+}
+
+// traceTxs traces the evm transactions of the block from traceFrom on the state of the parent block
+func (api *PublicDebugAPI) traceTxs(resBlock *ctypes.ResultBlock, txs []evmtypes.TraceTx, traceFrom int,
+	config *evmtypes.TraceConfig) ([]evmtypes.QueryResTxTrace, error) {
+	height := resBlock.Block.Height
+	if height <= 1 {
+		return nil, errors.New("genesis block is not traceable")
+	}
+
+	bz, err := json.Marshal(evmtypes.QueryTraceTxsParams{
+		Txs:         txs,
+		TraceFrom:   traceFrom,
+		BlockHeight: height,
+		BlockTime:   resBlock.Block.Time,
+		BlockHash:   common.BytesToHash(resBlock.Block.Hash()),
+		TraceConfig: config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := api.clientCtx.WithHeight(height-1).QueryWithData(
+		fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryTraceTxs), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []evmtypes.QueryResTxTrace
+	if err := json.Unmarshal(res, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
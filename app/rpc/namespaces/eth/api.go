@@ -18,7 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/okex/exchain/app"
 	"github.com/okex/exchain/app/config"
@@ -44,8 +43,10 @@ import (
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	erc20types "github.com/okex/exchain/x/erc20/types"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/evm/watcher"
+	tokentypes "github.com/okex/exchain/x/token/types"
 	"github.com/spf13/viper"
 )
 
@@ -53,6 +54,9 @@ const (
 	CacheOfEthCallLru = 40960
 
 	FlagEnableMultiCall = "rpc.enable-multi-call"
+
+	// maxInternalTransactionsLimit is the max number of transactions returned by eth_getInternalTransactionsByAddress
+	maxInternalTransactionsLimit = 100
 )
 
 // PublicEthereumAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
@@ -229,11 +233,55 @@ func (api *PublicEthereumAPI) GasPrice() *hexutil.Big {
 	monitor := monitor.GetMonitor("eth_gasPrice", api.logger, api.Metrics).OnBegin()
 	defer monitor.OnEnd()
 
+	gasPrice := api.suggestGasPrice()
+	// the gas price must cover the base fee of the next block after the London fork
+	if baseFee := api.pendingBaseFee(); baseFee != nil && gasPrice.Cmp(baseFee) < 0 {
+		gasPrice = baseFee
+	}
+	return (*hexutil.Big)(gasPrice)
+}
+
+// MaxPriorityFeePerGas returns a suggestion for the max priority fee per gas of the dynamic fee transactions,
+// which is the part of the suggested gas price above the base fee of the next block.
+func (api *PublicEthereumAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	monitor := monitor.GetMonitor("eth_maxPriorityFeePerGas", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+
+	return (*hexutil.Big)(api.suggestGasTipCap())
+}
+
+func (api *PublicEthereumAPI) suggestGasPrice() *big.Int {
 	if app.GlobalGpIndex.RecommendGp != nil {
-		return (*hexutil.Big)(app.GlobalGpIndex.RecommendGp)
+		return app.GlobalGpIndex.RecommendGp
 	}
 
-	return api.gasPrice
+	return api.gasPrice.ToInt()
+}
+
+func (api *PublicEthereumAPI) suggestGasTipCap() *big.Int {
+	gasTipCap := api.suggestGasPrice()
+	if baseFee := api.pendingBaseFee(); baseFee != nil {
+		gasTipCap = new(big.Int).Sub(gasTipCap, baseFee)
+		if gasTipCap.Sign() < 0 {
+			gasTipCap.SetInt64(0)
+		}
+	}
+	return gasTipCap
+}
+
+// pendingBaseFee returns the base fee of the next block, nil before the London fork
+func (api *PublicEthereumAPI) pendingBaseFee() *big.Int {
+	height, err := api.backend.LatestBlockNumber()
+	if err != nil {
+		return nil
+	}
+
+	baseFee, err := rpctypes.BlockBaseFee(api.clientCtx, height+1)
+	if err != nil {
+		api.logger.Debug("failed to get base fee of pending block", "error", err)
+		return nil
+	}
+	return baseFee
 }
 
 // Accounts returns the list of accounts available to this node.
@@ -734,8 +782,8 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	defer monitor.OnEnd("data", data)
 	tx := new(evmtypes.MsgEthereumTx)
 
-	// RLP decode raw transaction bytes
-	if err := rlp.DecodeBytes(data, tx); err != nil {
+	// decode the legacy RLP transaction or the EIP-2718 typed transaction envelope
+	if err := tx.UnmarshalBinary(data); err != nil {
 		// Return nil is for when gasLimit overflows uint64
 		return common.Hash{}, err
 	}
@@ -881,35 +929,9 @@ func (api *PublicEthereumAPI) doCall(
 		gas = globalGasCap.Uint64()
 	}
 
-	// Set gas price using default or parameter if passed in
-	gasPrice := new(big.Int).SetUint64(ethermint.DefaultGasPrice)
-	if args.GasPrice != nil {
-		gasPrice = args.GasPrice.ToInt()
-	}
-
-	// Set value for transaction
-	value := new(big.Int)
-	if args.Value != nil {
-		value = args.Value.ToInt()
-	}
-
-	// Set Data if provided
-	var data []byte
-	if args.Data != nil {
-		data = []byte(*args.Data)
-	}
-
-	// Set destination address for call
-	var toAddr *sdk.AccAddress
-	if args.To != nil {
-		pTemp := sdk.AccAddress(args.To.Bytes())
-		toAddr = &pTemp
-	}
-
 	var msgs []sdk.Msg
 	// Create new call message
-	msg := evmtypes.NewMsgEthermint(nonce, toAddr, sdk.NewIntFromBigInt(value), gas,
-		sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(addr.Bytes()))
+	msg := NewCallMsg(args, addr, nonce, gas)
 	msgs = append(msgs, msg)
 
 	sim := api.evmFactory.BuildSimulator(api)
@@ -956,6 +978,97 @@ func (api *PublicEthereumAPI) doCall(
 	return &simResponse, nil
 }
 
+// NewCallMsg creates the ethermint message of the call with the given sender, nonce and gas limit
+func NewCallMsg(args rpctypes.CallArgs, from common.Address, nonce, gas uint64) evmtypes.MsgEthermint {
+	// Set gas price using default or parameter if passed in
+	gasPrice := new(big.Int).SetUint64(ethermint.DefaultGasPrice)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	} else if args.MaxFeePerGas != nil {
+		gasPrice = args.MaxFeePerGas.ToInt()
+	}
+
+	// Set value for transaction
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+
+	// Set Data if provided
+	var data []byte
+	if args.Data != nil {
+		data = []byte(*args.Data)
+	}
+
+	// Set destination address for call
+	var toAddr *sdk.AccAddress
+	if args.To != nil {
+		pTemp := sdk.AccAddress(args.To.Bytes())
+		toAddr = &pTemp
+	}
+
+	msg := evmtypes.NewMsgEthermint(nonce, toAddr, sdk.NewIntFromBigInt(value), gas,
+		sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(from.Bytes()))
+	if args.AccessList != nil {
+		msg.AccessList = *args.AccessList
+	}
+	return msg
+}
+
+// CreateAccessList creates an EIP-2930 access list for the given transaction, along with
+// the gas used by the transaction with the access list.
+func (api *PublicEthereumAPI) CreateAccessList(args rpctypes.CallArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error) {
+	monitor := monitor.GetMonitor("eth_createAccessList", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args, "block number", blockNrOrHash)
+
+	bNrOrHash := rpctypes.BlockNumberOrHashWithNumber(rpctypes.PendingBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	blockNum, err := api.backend.ConvertToBlockNumber(bNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx := api.clientCtx
+	// pass the given block height to the context if the height is not pending or latest
+	if !(blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber) {
+		clientCtx = api.clientCtx.WithHeight(blockNum.Int64())
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+	nonce, err := api.accountNonce(clientCtx, from, blockNum == rpctypes.PendingBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	gas := uint64(ethermint.DefaultRPCGasLimit)
+	if args.Gas != nil && uint64(*args.Gas) < gas {
+		gas = uint64(*args.Gas)
+	}
+
+	bz, err := json.Marshal(NewCallMsg(args, from, nonce, gas))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryCreateAccessList), bz)
+	if err != nil {
+		return nil, TransformDataError(err, "eth_createAccessList")
+	}
+
+	var out evmtypes.QueryResAccessList
+	if err := json.Unmarshal(res, &out); err != nil {
+		return nil, err
+	}
+	return &rpctypes.AccessListResult{
+		Accesslist: &out.AccessList,
+		Error:      out.Error,
+		GasUsed:    hexutil.Uint64(out.GasUsed),
+	}, nil
+}
+
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
 // It adds 1,000 gas to the returned value instead of using the gas adjustment
 // param from the SDK.
@@ -1024,6 +1137,11 @@ func (api *PublicEthereumAPI) GetBlockByNumber(blockNum rpctypes.BlockNumber, fu
 		blockTxs = pendingTxs
 	}
 
+	baseFee, err := rpctypes.BlockBaseFee(api.clientCtx, height+1)
+	if err != nil {
+		return nil, err
+	}
+
 	return rpctypes.FormatBlock(
 		tmtypes.Header{
 			Version:         latestBlock.Block.Version,
@@ -1040,6 +1158,7 @@ func (api *PublicEthereumAPI) GetBlockByNumber(blockNum rpctypes.BlockNumber, fu
 		gasUsed,
 		blockTxs,
 		ethtypes.Bloom{},
+		baseFee,
 	), nil
 
 }
@@ -1524,7 +1643,26 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 	amount := (*big.Int)(args.Value)
 	gasPrice := (*big.Int)(args.GasPrice)
 
-	if args.GasPrice == nil {
+	dynamicFee := args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil
+	if dynamicFee && args.GasPrice != nil {
+		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	}
+
+	var gasTipCap *big.Int
+	if dynamicFee {
+		gasTipCap = (*big.Int)(args.MaxPriorityFeePerGas)
+		if gasTipCap == nil {
+			gasTipCap = api.suggestGasTipCap()
+		}
+		// the max fee per gas defaults to gasTipCap + 2 * baseFee as go-ethereum
+		gasPrice = (*big.Int)(args.MaxFeePerGas)
+		if gasPrice == nil {
+			gasPrice = new(big.Int).Set(gasTipCap)
+			if baseFee := api.pendingBaseFee(); baseFee != nil {
+				gasPrice.Add(gasPrice, new(big.Int).Mul(baseFee, big.NewInt(2)))
+			}
+		}
+	} else if args.GasPrice == nil {
 		// Set default gas price
 		// TODO: Change to min gas price from context once available through server/daemon
 		gasPrice = ParseGasPrice().ToInt()
@@ -1559,12 +1697,15 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 
 	if args.Gas == nil {
 		callArgs := rpctypes.CallArgs{
-			From:     args.From,
-			To:       args.To,
-			Gas:      args.Gas,
-			GasPrice: args.GasPrice,
-			Value:    args.Value,
-			Data:     &input,
+			From:                 args.From,
+			To:                   args.To,
+			Gas:                  args.Gas,
+			GasPrice:             args.GasPrice,
+			Value:                args.Value,
+			Data:                 &input,
+			AccessList:           args.AccessList,
+			MaxFeePerGas:         args.MaxFeePerGas,
+			MaxPriorityFeePerGas: args.MaxPriorityFeePerGas,
 		}
 		gl, err := api.EstimateGas(callArgs)
 		if err != nil {
@@ -1574,6 +1715,18 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 	} else {
 		gasLimit = (uint64)(*args.Gas)
 	}
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	if dynamicFee {
+		msg := evmtypes.NewMsgEthereumTxWithDynamicFee(api.chainIDEpoch, nonce, args.To, amount, gasLimit, gasTipCap, gasPrice, input, accessList)
+		return &msg, nil
+	}
+	if args.AccessList != nil {
+		msg := evmtypes.NewMsgEthereumTxWithAccessList(api.chainIDEpoch, nonce, args.To, amount, gasLimit, gasPrice, input, accessList)
+		return &msg, nil
+	}
 	msg := evmtypes.NewMsgEthereumTx(nonce, args.To, amount, gasLimit, gasPrice, input)
 
 	return &msg, nil
@@ -1675,6 +1828,71 @@ func (api *PublicEthereumAPI) DeleteTxTrace(txHash common.Hash) string {
 	return "delete trace succeed"
 }
 
+// GetInternalTransactions returns the internal transactions of the evm transaction by txhash.
+func (api *PublicEthereumAPI) GetInternalTransactions(txHash common.Hash) (*evmtypes.TxInnerTxs, error) {
+	monitor := monitor.GetMonitor("eth_getInternalTransactions", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", txHash)
+
+	return evmtypes.GetTxInnerTxs(txHash)
+}
+
+// GetInternalTransactionsByBlockNumber returns the internal transactions of the evm transactions in the block.
+func (api *PublicEthereumAPI) GetInternalTransactionsByBlockNumber(blockNum rpctypes.BlockNumber) ([]*evmtypes.TxInnerTxs, error) {
+	monitor := monitor.GetMonitor("eth_getInternalTransactionsByBlockNumber", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("number", blockNum)
+
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+	return evmtypes.GetBlockInnerTxs(height)
+}
+
+// GetInternalTransactionsByAddress returns the internal transactions of the evm transactions involving the address
+// from the latest one, the first offset transactions are skipped and at most limit transactions are returned.
+func (api *PublicEthereumAPI) GetInternalTransactionsByAddress(address common.Address, offset, limit hexutil.Uint) ([]*evmtypes.TxInnerTxs, error) {
+	monitor := monitor.GetMonitor("eth_getInternalTransactionsByAddress", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "offset", offset, "limit", limit)
+
+	if limit == 0 || limit > maxInternalTransactionsLimit {
+		limit = maxInternalTransactionsLimit
+	}
+	return evmtypes.GetAddressInnerTxs(address, int(offset), int(limit))
+}
+
+// GetTokenMetadata returns the metadata of the native token mapped to the erc20 contract.
+func (api *PublicEthereumAPI) GetTokenMetadata(contract common.Address) (*tokentypes.TokenMetadata, error) {
+	monitor := monitor.GetMonitor("eth_getTokenMetadata", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("contract", contract)
+
+	bz, err := api.clientCtx.Codec.MarshalJSON(erc20types.NewQueryContractParams(contract.Hex()))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := api.clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", erc20types.QuerierRoute, erc20types.QueryContract), bz)
+	if err != nil {
+		return nil, err
+	}
+	var tokenMapping erc20types.TokenMapping
+	if err := api.clientCtx.Codec.UnmarshalJSON(res, &tokenMapping); err != nil {
+		return nil, err
+	}
+
+	res, _, err = api.clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", tokentypes.QuerierRoute, tokentypes.QueryTokenMetadata, tokenMapping.Denom), nil)
+	if err != nil {
+		return nil, err
+	}
+	var metadata tokentypes.TokenMetadata
+	if err := api.clientCtx.Codec.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
 func (api *PublicEthereumAPI) saveZeroAccount(address common.Address) {
 	zeroAccount := ethermint.EthAccount{BaseAccount: &auth.BaseAccount{}}
 	zeroAccount.SetAddress(address.Bytes())
@@ -0,0 +1,161 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/okex/exchain/app/rpc/monitor"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+)
+
+// maxFeeHistory is the max number of blocks returned by eth_feeHistory
+const maxFeeHistory = 1024
+
+// txGasAndReward is the gas used and the effective priority fee of a transaction in a block
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+// FeeHistory returns the base fees, gas used ratios and the priority fee percentiles of the blocks in the range
+// ending with lastBlock. The base fee of the block next to lastBlock is appended to the base fees.
+func (api *PublicEthereumAPI) FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpctypes.BlockNumber,
+	rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	monitor := monitor.GetMonitor("eth_feeHistory", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("count", blockCount, "last", lastBlock, "percentiles", rewardPercentiles)
+
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f > #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+
+	latest, err := api.backend.LatestBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	last := lastBlock.Int64()
+	if lastBlock == rpctypes.LatestBlockNumber || lastBlock == rpctypes.PendingBlockNumber || last > latest {
+		last = latest
+	}
+
+	count := int64(blockCount)
+	if count > maxFeeHistory {
+		count = maxFeeHistory
+	}
+	if count > last {
+		count = last
+	}
+	if count <= 0 {
+		return &rpctypes.FeeHistoryResult{OldestBlock: (*hexutil.Big)(new(big.Int))}, nil
+	}
+	oldest := last - count + 1
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(context.Background(), api.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(oldest)),
+		BaseFee:      make([]*hexutil.Big, count+1),
+		GasUsedRatio: make([]float64, count),
+	}
+	if len(rewardPercentiles) != 0 {
+		result.Reward = make([][]*hexutil.Big, count)
+	}
+	for i := int64(0); i <= count; i++ {
+		baseFee, err := rpctypes.BlockBaseFee(api.clientCtx, oldest+i)
+		if err != nil {
+			return nil, err
+		}
+		if baseFee == nil {
+			baseFee = new(big.Int)
+		}
+		result.BaseFee[i] = (*hexutil.Big)(baseFee)
+		if i == count {
+			break
+		}
+
+		gasUsed, txRewards, err := api.blockGasAndRewards(oldest+i, baseFee)
+		if err != nil {
+			return nil, err
+		}
+		if gasLimit > 0 {
+			result.GasUsedRatio[i] = float64(gasUsed) / float64(gasLimit)
+		}
+		if result.Reward != nil {
+			result.Reward[i] = rewardPercentilesOf(txRewards, gasUsed, rewardPercentiles)
+		}
+	}
+	return result, nil
+}
+
+// blockGasAndRewards returns the gas used of the block and the effective priority fees of its evm transactions
+func (api *PublicEthereumAPI) blockGasAndRewards(height int64, baseFee *big.Int) (uint64, []txGasAndReward, error) {
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return 0, nil, err
+	}
+	blockResults, err := api.clientCtx.Client.BlockResults(&height)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var gasUsed uint64
+	var txRewards []txGasAndReward
+	for i, txResult := range blockResults.TxsResults {
+		if txResult.GasUsed <= 0 {
+			continue
+		}
+		gasUsed += uint64(txResult.GasUsed)
+		if i >= len(resBlock.Block.Txs) {
+			continue
+		}
+		ethTx, err := rpctypes.RawTxToEthTx(api.clientCtx, resBlock.Block.Txs[i])
+		if err != nil {
+			// ignore the cosmos transactions
+			continue
+		}
+		reward := new(big.Int).Sub(ethTx.EffectiveGasPrice(baseFee), baseFee)
+		if reward.Sign() < 0 {
+			reward.SetInt64(0)
+		}
+		txRewards = append(txRewards, txGasAndReward{gasUsed: uint64(txResult.GasUsed), reward: reward})
+	}
+	return gasUsed, txRewards, nil
+}
+
+// rewardPercentilesOf returns the priority fees at the percentiles of the gas used in the block as go-ethereum
+func rewardPercentilesOf(txRewards []txGasAndReward, gasUsed uint64, percentiles []float64) []*hexutil.Big {
+	rewards := make([]*hexutil.Big, len(percentiles))
+	if len(txRewards) == 0 {
+		for i := range rewards {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+		return rewards
+	}
+
+	sort.SliceStable(txRewards, func(i, j int) bool {
+		return txRewards[i].reward.Cmp(txRewards[j].reward) < 0
+	})
+	var txIndex int
+	sumGasUsed := txRewards[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(gasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txRewards)-1 {
+			txIndex++
+			sumGasUsed += txRewards[txIndex].gasUsed
+		}
+		rewards[i] = (*hexutil.Big)(txRewards[txIndex].reward)
+	}
+	return rewards
+}
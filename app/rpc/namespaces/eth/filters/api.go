@@ -288,6 +288,9 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 				}
 
 				header := rpctypes.EthHeaderFromTendermint(data.Header)
+				if header.BaseFee, err = rpctypes.BlockBaseFee(api.clientCtx, data.Header.Height); err != nil {
+					api.logger.Error("failed to get base fee of header", "error", err)
+				}
 				err = notifier.Notify(rpcSub.ID, header)
 				if err != nil {
 					headersSub.err <- err
@@ -139,20 +139,21 @@ type BankKeeperProxy struct {
 func NewBankKeeperProxy() BankKeeperProxy {
 	modAccAddrs := make(map[string]bool)
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            nil,
-		token.ModuleName:          {supply.Minter, supply.Burner},
-		dex.ModuleName:            nil,
-		order.ModuleName:          nil,
-		backend.ModuleName:        nil,
-		ammswap.ModuleName:        {supply.Minter, supply.Burner},
-		farm.ModuleName:           nil,
-		farm.YieldFarmingAccount:  nil,
-		farm.MintFarmingAccount:   {supply.Burner},
+		auth.FeeCollectorName:      nil,
+		distr.ModuleName:           nil,
+		mint.ModuleName:            {supply.Minter},
+		staking.BondedPoolName:     {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:  {supply.Burner, supply.Staking},
+		gov.ModuleName:             nil,
+		token.ModuleName:           {supply.Minter, supply.Burner},
+		dex.ModuleName:             nil,
+		order.ModuleName:           nil,
+		backend.ModuleName:         nil,
+		ammswap.ModuleName:         {supply.Minter, supply.Burner},
+		ammswap.ProtocolFeeAccount: nil,
+		farm.ModuleName:            nil,
+		farm.YieldFarmingAccount:   nil,
+		farm.MintFarmingAccount:    {supply.Burner},
 	}
 
 	for acc := range maccPerms {
@@ -32,20 +32,25 @@ type StorageResult struct {
 
 // Transaction represents a transaction returned to RPC clients.
 type Transaction struct {
-	BlockHash        *common.Hash    `json:"blockHash"`
-	BlockNumber      *hexutil.Big    `json:"blockNumber"`
-	From             common.Address  `json:"from"`
-	Gas              hexutil.Uint64  `json:"gas"`
-	GasPrice         *hexutil.Big    `json:"gasPrice"`
-	Hash             common.Hash     `json:"hash"`
-	Input            hexutil.Bytes   `json:"input"`
-	Nonce            hexutil.Uint64  `json:"nonce"`
-	To               *common.Address `json:"to"`
-	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
-	Value            *hexutil.Big    `json:"value"`
-	V                *hexutil.Big    `json:"v"`
-	R                *hexutil.Big    `json:"r"`
-	S                *hexutil.Big    `json:"s"`
+	BlockHash        *common.Hash         `json:"blockHash"`
+	BlockNumber      *hexutil.Big         `json:"blockNumber"`
+	From             common.Address       `json:"from"`
+	Gas              hexutil.Uint64       `json:"gas"`
+	GasPrice         *hexutil.Big         `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
+	Hash             common.Hash          `json:"hash"`
+	Input            hexutil.Bytes        `json:"input"`
+	Nonce            hexutil.Uint64       `json:"nonce"`
+	To               *common.Address      `json:"to"`
+	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
+	Value            *hexutil.Big         `json:"value"`
+	Type             hexutil.Uint64       `json:"type"`
+	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
+	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
+	V                *hexutil.Big         `json:"v"`
+	R                *hexutil.Big         `json:"r"`
+	S                *hexutil.Big         `json:"s"`
 }
 
 // SendTxArgs represents the arguments to submit a new transaction into the transaction pool.
@@ -62,6 +67,11 @@ type SendTxArgs struct {
 	// newer name and should be preferred by clients.
 	Data  *hexutil.Bytes `json:"data"`
 	Input *hexutil.Bytes `json:"input"`
+	// An EIP-2930 access list transaction is sent if the access list is set
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	// An EIP-1559 dynamic fee transaction is sent if the max fees are set
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
 }
 
 func (ca SendTxArgs) String() string {
@@ -90,17 +100,30 @@ func (ca SendTxArgs) String() string {
 	if ca.Input != nil {
 		arg += fmt.Sprintf("Input: %s, ", ca.Input.String())
 	}
+	if ca.AccessList != nil {
+		arg += fmt.Sprintf("AccessList: %v, ", *ca.AccessList)
+	}
+	if ca.MaxFeePerGas != nil {
+		arg += fmt.Sprintf("MaxFeePerGas: %s, ", ca.MaxFeePerGas.String())
+	}
+	if ca.MaxPriorityFeePerGas != nil {
+		arg += fmt.Sprintf("MaxPriorityFeePerGas: %s, ", ca.MaxPriorityFeePerGas.String())
+	}
 	return strings.TrimRight(arg, ", ")
 }
 
 // CallArgs represents the arguments for a call.
 type CallArgs struct {
-	From     *common.Address `json:"from"`
-	To       *common.Address `json:"to"`
-	Gas      *hexutil.Uint64 `json:"gas"`
-	GasPrice *hexutil.Big    `json:"gasPrice"`
-	Value    *hexutil.Big    `json:"value"`
-	Data     *hexutil.Bytes  `json:"data"`
+	From       *common.Address      `json:"from"`
+	To         *common.Address      `json:"to"`
+	Gas        *hexutil.Uint64      `json:"gas"`
+	GasPrice   *hexutil.Big         `json:"gasPrice"`
+	Value      *hexutil.Big         `json:"value"`
+	Data       *hexutil.Bytes       `json:"data"`
+	AccessList *ethtypes.AccessList `json:"accessList"`
+
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
 }
 
 func (ca CallArgs) String() string {
@@ -123,9 +146,35 @@ func (ca CallArgs) String() string {
 	if ca.Data != nil {
 		arg += fmt.Sprintf("Data: %s, ", ca.Data.String())
 	}
+	if ca.AccessList != nil {
+		arg += fmt.Sprintf("AccessList: %v, ", *ca.AccessList)
+	}
+	if ca.MaxFeePerGas != nil {
+		arg += fmt.Sprintf("MaxFeePerGas: %s, ", ca.MaxFeePerGas.String())
+	}
+	if ca.MaxPriorityFeePerGas != nil {
+		arg += fmt.Sprintf("MaxPriorityFeePerGas: %s, ", ca.MaxPriorityFeePerGas.String())
+	}
 	return strings.TrimRight(arg, ", ")
 }
 
+// AccessListResult returns an optional accesslist
+// Its the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// FeeHistoryResult is the result of the `eth_feeHistory` RPC call
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
 // Account indicates the overriding fields of account during the execution of
 // a message call.
 // NOTE: state and stateDiff can't be specified at the same time. If state is
@@ -158,4 +207,5 @@ type EthHeaderWithBlockHash struct {
 	MixDigest   common.Hash         `json:"mixHash"`
 	Nonce       ethtypes.BlockNonce `json:"nonce"`
 	Hash        common.Hash         `json:"hash"`
+	BaseFee     *hexutil.Big        `json:"baseFeePerGas,omitempty"`
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -67,6 +68,17 @@ func NewTransaction(tx *evmtypes.MsgEthereumTx, txHash, blockHash common.Hash, b
 		S:        (*hexutil.Big)(tx.Data.S),
 	}
 
+	if tx.Data.Type != ethtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		rpcTx.Type = hexutil.Uint64(tx.Data.Type)
+		rpcTx.Accesses = &accessList
+		rpcTx.ChainID = (*hexutil.Big)(tx.Data.ChainID)
+	}
+	if tx.Data.Type == ethtypes.DynamicFeeTxType {
+		rpcTx.GasFeeCap = (*hexutil.Big)(tx.Data.Price)
+		rpcTx.GasTipCap = (*hexutil.Big)(tx.Data.GasTipCap)
+	}
+
 	if blockHash != (common.Hash{}) {
 		rpcTx.BlockHash = &blockHash
 		rpcTx.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
@@ -97,6 +109,11 @@ func EthBlockFromTendermint(clientCtx clientcontext.CLIContext, block *tmtypes.B
 	var bloomRes evmtypes.QueryBloomFilter
 	clientCtx.Codec.MustUnmarshalJSON(res, &bloomRes)
 
+	baseFee, err := BlockBaseFee(clientCtx, block.Height)
+	if err != nil {
+		return nil, err
+	}
+
 	bloom := bloomRes.Bloom
 	if fullTx {
 		blockTxs = ethTxs
@@ -104,7 +121,21 @@ func EthBlockFromTendermint(clientCtx clientcontext.CLIContext, block *tmtypes.B
 		blockTxs = transactions
 	}
 
-	return FormatBlock(block.Header, block.Size(), block.Hash(), gasLimit, gasUsed, blockTxs, bloom), nil
+	return FormatBlock(block.Header, block.Size(), block.Hash(), gasLimit, gasUsed, blockTxs, bloom, baseFee), nil
+}
+
+// BlockBaseFee returns the base fee of the block at the given height, nil before the London fork.
+func BlockBaseFee(clientCtx clientcontext.CLIContext, height int64) (*big.Int, error) {
+	res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryBaseFee, height))
+	if err != nil {
+		return nil, err
+	}
+
+	var out evmtypes.QueryResBaseFee
+	if err := json.Unmarshal(res, &out); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(out.BaseFee), nil
 }
 
 // EthHeaderFromTendermint is an util function that returns an Ethereum Header
@@ -177,7 +208,7 @@ func BlockMaxGasFromConsensusParams(_ context.Context, clientCtx clientcontext.C
 // transactions.
 func FormatBlock(
 	header tmtypes.Header, size int, curBlockHash tmbytes.HexBytes, gasLimit int64,
-	gasUsed *big.Int, transactions interface{}, bloom ethtypes.Bloom,
+	gasUsed *big.Int, transactions interface{}, bloom ethtypes.Bloom, baseFee *big.Int,
 ) map[string]interface{} {
 	if len(header.DataHash) == 0 {
 		header.DataHash = tmbytes.HexBytes(common.Hash{}.Bytes())
@@ -207,6 +238,9 @@ func FormatBlock(
 		"uncles":           []common.Hash{},
 		"receiptsRoot":     ethtypes.EmptyRootHash,
 	}
+	if baseFee != nil {
+		ret["baseFeePerGas"] = (*hexutil.Big)(baseFee)
+	}
 	if !reflect.ValueOf(transactions).IsNil() {
 		switch transactions.(type) {
 		case []common.Hash:
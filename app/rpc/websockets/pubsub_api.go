@@ -110,6 +110,11 @@ func (api *PubSubAPI) subscribeNewHeads(conn *wsConn) (rpc.ID, error) {
 					api.logger.Error("failed to get header with block hash", "error", err)
 					continue
 				}
+				baseFee, err := rpctypes.BlockBaseFee(api.clientCtx, data.Header.Height)
+				if err != nil {
+					api.logger.Error("failed to get base fee of header", "error", err)
+				}
+				headerWithBlockHash.BaseFee = (*hexutil.Big)(baseFee)
 
 				api.filtersMu.RLock()
 				if f, found := api.filters[sub.ID()]; found {
@@ -274,6 +274,7 @@ func InitTestnet(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
 			stakingtypes.NewDescription(nodeDirName, "", "", ""),
+			stakingtypes.DefaultCommissionRates(),
 			sdk.NewDecCoinFromDec(common.NativeToken, stakingtypes.DefaultMinSelfDelegation),
 		)
 
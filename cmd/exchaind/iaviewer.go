@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	minttypes "github.com/okex/exchain/libs/cosmos-sdk/x/mint"
 	supplytypes "github.com/okex/exchain/libs/cosmos-sdk/x/supply"
 	"github.com/okex/exchain/app"
@@ -474,6 +475,9 @@ func evmPrintKey(cdc *codec.Codec, key []byte, value []byte) {
 	case evmtypes.KeyPrefixContractBlockedList[0]:
 		log.Println(fmt.Sprintf("blockedAddres:%s\n", hex.EncodeToString(key[1:])))
 		return
+	case evmtypes.KeyPrefixBaseFee[0]:
+		log.Println(fmt.Sprintf("baseFeeHeight:%s;baseFee:%s\n", hex.EncodeToString(key[1:]), new(big.Int).SetBytes(value).String()))
+		return
 	default:
 		printKey := parseWeaveKey(key)
 		digest := hex.EncodeToString(value)
@@ -110,6 +110,7 @@ func closeApp(iApp abci.Application) {
 	app.StopStore()
 	evmtypes.CloseIndexer()
 	evmtypes.CloseTracer()
+	evmtypes.CloseInnerTxDB()
 	rpc.CloseEthBackend()
 }
 
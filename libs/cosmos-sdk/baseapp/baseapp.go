@@ -107,6 +107,7 @@ type BaseApp struct { // nolint: maligned
 	anteHandler      sdk.AnteHandler      // ante handler for fee and auth
 	GasRefundHandler sdk.GasRefundHandler // gas refund handler for gas refund
 	AccHandler       sdk.AccHandler       // account handler for cm tx nonce
+	GasPriceHandler  sdk.GasPriceHandler  // gas price handler for the tx gas price by the state
 
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
@@ -1000,6 +1001,9 @@ func (app *BaseApp) GetTxInfo(ctx sdk.Context, tx sdk.Tx) mempool.ExTxInfo {
 			exTxInfo.Nonce -= 1 // in ante handler logical, the nonce will incress one
 		}
 	}
+	if exTxInfo.Sender != "" && app.GasPriceHandler != nil {
+		exTxInfo.GasPrice = app.GasPriceHandler(ctx, tx)
+	}
 
 	return exTxInfo
 }
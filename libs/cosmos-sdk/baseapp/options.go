@@ -120,6 +120,13 @@ func (app *BaseApp) SetAccHandler(ah sdk.AccHandler) {
 	app.AccHandler = ah
 }
 
+func (app *BaseApp) SetGasPriceHandler(gh sdk.GasPriceHandler) {
+	if app.sealed {
+		panic("SetGasPriceHandler() on sealed BaseApp")
+	}
+	app.GasPriceHandler = gh
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
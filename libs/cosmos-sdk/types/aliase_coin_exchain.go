@@ -17,7 +17,8 @@ var (
 	reDnmString = fmt.Sprintf(`[a-z][a-z0-9]{0,9}(\-[a-f0-9]{3})?`)
 	reDecAmt    = `[[:digit:]]*\.?[[:digit:]]+`
 
-	rePoolTokenDnmString = fmt.Sprintf(`(ammswap_)[a-z][a-z0-9]{0,9}(\-[a-f0-9]{3})?_[a-z][a-z0-9]{0,9}(\-[a-f0-9]{3})?`)
+	// the pool token of the stable swap pool is made up of at most 4 tokens
+	rePoolTokenDnmString = fmt.Sprintf(`(ammswap_)[a-z][a-z0-9]{0,9}(\-[a-f0-9]{3})?(_[a-z][a-z0-9]{0,9}(\-[a-f0-9]{3})?){1,3}`)
 	rePoolTokenDnm       = regexp.MustCompile(fmt.Sprintf(`^%s$`, rePoolTokenDnmString))
 	reDecCoinPoolToken   = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, rePoolTokenDnmString))
 
@@ -1,5 +1,7 @@
 package types
 
+import "math/big"
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -11,6 +13,9 @@ type GasRefundHandler func(ctx Context, tx Tx) (fee Coins, err error)
 
 type AccHandler func(ctx Context, address AccAddress) (nonce uint64)
 
+// GasPriceHandler returns the gas price of the tx by the state of ctx, which sorts the txs in the mempool
+type GasPriceHandler func(ctx Context, tx Tx) *big.Int
+
 type UpdateFeeCollectorAccHandler func(ctx Context, balance Coins) error
 
 type LogFix func(isAnteFailed [][]string) (logs [][]byte)
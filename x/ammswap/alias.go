@@ -7,11 +7,12 @@ import (
 
 const (
 	// nolint
-	ModuleName        = types.ModuleName
-	RouterKey         = types.RouterKey
-	StoreKey          = types.StoreKey
-	DefaultParamspace = types.DefaultParamspace
-	QuerierRoute      = types.QuerierRoute
+	ModuleName         = types.ModuleName
+	RouterKey          = types.RouterKey
+	StoreKey           = types.StoreKey
+	DefaultParamspace  = types.DefaultParamspace
+	QuerierRoute       = types.QuerierRoute
+	ProtocolFeeAccount = types.ProtocolFeeAccount
 )
 
 var (
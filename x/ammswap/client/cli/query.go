@@ -32,6 +32,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdAllSwapTokenPairs(queryRoute, cdc),
 			GetCmdRedeemableAssets(queryRoute, cdc),
 			GetCmdQueryBuyAmount(queryRoute, cdc),
+			GetCmdQuerySwapRoute(queryRoute, cdc),
+			GetCmdQuerySwapTWAP(queryRoute, cdc),
+			GetCmdQueryProtocolFees(queryRoute, cdc),
+			GetCmdQueryConcentratedPool(queryRoute, cdc),
+			GetCmdQueryConcentratedPosition(queryRoute, cdc),
+			GetCmdQueryConcentratedPositions(queryRoute, cdc),
+			GetCmdQueryStablePool(queryRoute, cdc),
 		)...,
 	)
 
@@ -198,4 +205,231 @@ $ exchaincli query swap redeemable-assets eth xxb 1
 			return nil
 		},
 	}
-}
\ No newline at end of file
+}
+// GetCmdQuerySwapRoute queries the best swap route through all the swap token pairs
+func GetCmdQuerySwapRoute(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	var exactOutput bool
+	cmd := &cobra.Command{
+		Use:   "route [token-amount] [token-name]",
+		Short: "Query the best swap route to sell the amount of token for the token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the best swap route to sell the amount of token for the token, or to buy the amount of
+token by selling the token with --exact-output.
+
+Example:
+$ %s query swap route 100eth-245 xxb
+$ %s query swap route 100xxb eth-245 --exact-output`, version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var params interface{}
+			var route string
+			if exactOutput {
+				params = types.NewQuerySwapSellInfoParams(args[0], args[1])
+				route = fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapRouteExactOutput)
+			} else {
+				params = types.NewQuerySwapBuyInfoParams(args[0], args[1])
+				route = fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapRoute)
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&exactOutput, "exact-output", false, "query the route to buy the exact amount of token")
+	return cmd
+}
+
+// GetCmdQuerySwapTWAP queries the time-weighted average prices of the swap token pair
+func GetCmdQuerySwapTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	var startHeight, endHeight int64
+	cmd := &cobra.Command{
+		Use:   "twap [base-token] [quote-token]",
+		Short: "Query the time-weighted average prices of the pool over the blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the time-weighted average prices of the pool over the blocks from the start height to the one
+before the end height, which is the latest height by default.
+
+Example:
+$ %s query swap twap eth-245 okt --start-height 1000 --end-height 2000`, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			tokenPairName := types.GetSwapTokenPairName(args[0], args[1])
+			bz, err := cdc.MarshalJSON(types.NewQuerySwapTWAPParams(tokenPairName, startHeight, endHeight))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapTWAP), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height the window starts at")
+	cmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height the window ends before, the latest height by default")
+	cmd.MarkFlagRequired("start-height")
+	return cmd
+}
+
+// GetCmdQueryProtocolFees queries the protocol fees collected from the swaps
+func GetCmdQueryProtocolFees(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "protocol-fees",
+		Short: "Query the protocol fees collected from the swaps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the protocol fees collected from the swaps, which are withdrawn by the proposal.
+
+Example:
+$ %s query swap protocol-fees
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProtocolFees), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryConcentratedPool queries the concentrated pool of the token pair
+func GetCmdQueryConcentratedPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "concentrated-pool [base-token] [quote-token]",
+		Short: "Query the concentrated pool of the token pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the concentrated pool of the token pair, including the current price, tick and
+the liquidity in range.
+
+Example:
+$ %s query swap concentrated-pool eth-355 okt
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			tokenPairName := types.GetSwapTokenPairName(args[0], args[1])
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryConcentratedPool, tokenPairName), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryConcentratedPosition queries the concentrated position by id
+func GetCmdQueryConcentratedPosition(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "position [position-id]",
+		Short: "Query the concentrated position by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the concentrated position by id, including its tick range, liquidity and the
+fees owed to it.
+
+Example:
+$ %s query swap position 1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryConcentratedPosition, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryConcentratedPositions queries the concentrated positions owned by the address
+func GetCmdQueryConcentratedPositions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "positions [address]",
+		Short: "Query the concentrated positions owned by the address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the concentrated positions owned by the address.
+
+Example:
+$ %s query swap positions ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryConcentratedPositions, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryStablePool queries the stable pool of the tokens
+func GetCmdQueryStablePool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stable-pool [token]...",
+		Short: "Query the stable pool of the tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the stable pool of the tokens, including the pooled coins and the current
+amplification coefficient.
+
+Example:
+$ %s query swap stable-pool dai-355 usdc-366 usdt-377
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.RangeArgs(types.MinStablePoolTokens, types.MaxStablePoolTokens),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			poolName := types.GetStablePoolName(args)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryStablePool, poolName), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,9 +11,12 @@ import (
 	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	swaputils "github.com/okex/exchain/x/ammswap/client/utils"
 	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/gov"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +34,21 @@ const (
 	flagRecipient        = "recipient"
 	flagToken0           = "token0"
 	flagToken1           = "token1"
+	flagRoute            = "route"
+	flagMaxSellAmount    = "max-sell-amount"
+	flagBuyAmount        = "buy-amount"
+	flagFeeRate          = "fee-rate"
+	flagMaxQuoteAmount   = "max-quote-amount"
+	flagPoolType         = "pool-type"
+	flagInitialPrice     = "initial-price"
+	flagTickSpacing      = "tick-spacing"
+	flagPositionID       = "position-id"
+	flagLowerTick        = "lower-tick"
+	flagUpperTick        = "upper-tick"
+	flagExtraAmounts     = "extra-amounts"
+	flagExtraMinAmounts  = "extra-min-amounts"
+	flagExtraTokens      = "extra-tokens"
+	flagAmplification    = "amplification"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,6 +63,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdRemoveLiquidity(cdc),
 		getCmdCreateExchange(cdc),
 		getCmdTokenSwap(cdc),
+		getCmdTokenSwapByRoute(cdc),
+		getCmdTokenSwapExactOutput(cdc),
+		getCmdAddPosition(cdc),
+		getCmdRemovePosition(cdc),
+		getCmdCollectFees(cdc),
+		getCmdTransferPosition(cdc),
 	)...)
 
 	return txCmd
@@ -54,6 +79,7 @@ func getCmdAddLiquidity(cdc *codec.Codec) *cobra.Command {
 	var minLiquidity string
 	var maxBaseAmount string
 	var quoteAmount string
+	var extraAmounts string
 	var deadlineDuration string
 	cmd := &cobra.Command{
 		Use:   "add-liquidity",
@@ -64,6 +90,10 @@ func getCmdAddLiquidity(cdc *codec.Codec) *cobra.Command {
 Example:
 $ exchaincli tx swap add-liquidity --max-base-amount 10eth-355 --quote-amount 100btc-366 --min-liquidity 0.001
 
+The exact amounts of all the tokens are deposited into a stable pool, the ones beyond the first two are set by
+--extra-amounts:
+$ exchaincli tx swap add-liquidity --max-base-amount 100dai-355 --quote-amount 100usdc-366 --extra-amounts 100usdt-377 --min-liquidity 299
+
 `),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -89,6 +119,11 @@ $ exchaincli tx swap add-liquidity --max-base-amount 10eth-355 --quote-amount 10
 			}
 			deadline := time.Now().Add(duration).Unix()
 			msg := types.NewMsgAddLiquidity(minLiquidityDec, maxBaseAmountDecCoin, quoteAmountDecCoin, deadline, cliCtx.FromAddress)
+			if extraAmounts != "" {
+				if msg.ExtraAmounts, err = sdk.ParseDecCoins(extraAmounts); err != nil {
+					return err
+				}
+			}
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -97,6 +132,7 @@ $ exchaincli tx swap add-liquidity --max-base-amount 10eth-355 --quote-amount 10
 	cmd.Flags().StringVarP(&minLiquidity, flagMinLiquidity, "l", "", "Minimum number of sender will mint if total pool token supply is greater than 0")
 	cmd.Flags().StringVarP(&maxBaseAmount, flagMaxBaseAmount, "", "", "Maximum number of base amount deposited. Deposits max amount if total pool token supply is 0. For example \"100xxb\"")
 	cmd.Flags().StringVarP(&quoteAmount, flagQuoteAmount, "q", "", "The number of quote amount. For example \"100okb\"")
+	cmd.Flags().StringVar(&extraAmounts, flagExtraAmounts, "", "The amounts of the other tokens deposited into the stable pool, separated by commas. For example \"100usdt,100dai\"")
 	cmd.Flags().StringVarP(&deadlineDuration, flagDeadlineDuration, "d", "30s", "Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
 	cmd.MarkFlagRequired(flagMinLiquidity)
 	cmd.MarkFlagRequired(flagMaxBaseAmount)
@@ -109,6 +145,7 @@ func getCmdRemoveLiquidity(cdc *codec.Codec) *cobra.Command {
 	var liquidity string
 	var minBaseAmount string
 	var minQuoteAmount string
+	var extraMinAmounts string
 	var deadlineDuration string
 	cmd := &cobra.Command{
 		Use:   "remove-liquidity",
@@ -118,6 +155,7 @@ func getCmdRemoveLiquidity(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ exchaincli tx swap remove-liquidity --liquidity 1 --min-base-amount 10eth-355 --min-quote-amount 1btc-366
+$ exchaincli tx swap remove-liquidity --liquidity 3 --min-base-amount 1dai-355 --min-quote-amount 1usdc-366 --extra-min-amounts 1usdt-377
 
 `),
 		),
@@ -144,6 +182,11 @@ $ exchaincli tx swap remove-liquidity --liquidity 1 --min-base-amount 10eth-355
 			}
 			deadline := time.Now().Add(duration).Unix()
 			msg := types.NewMsgRemoveLiquidity(liquidityDec, minBaseAmountDecCoin, minQuoteAmountDecCoin, deadline, cliCtx.FromAddress)
+			if extraMinAmounts != "" {
+				if msg.ExtraMinAmounts, err = sdk.ParseDecCoins(extraMinAmounts); err != nil {
+					return err
+				}
+			}
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -152,6 +195,7 @@ $ exchaincli tx swap remove-liquidity --liquidity 1 --min-base-amount 10eth-355
 	cmd.Flags().StringVarP(&liquidity, flagLiquidity, "l", "", "Liquidity amount of sender will burn")
 	cmd.Flags().StringVarP(&minBaseAmount, flagMinBaseAmount, "", "", "Minimum number of base amount withdrawn")
 	cmd.Flags().StringVarP(&minQuoteAmount, flagMinQuoteAmount, "q", "", "Minimum number of quote amount withdrawn")
+	cmd.Flags().StringVar(&extraMinAmounts, flagExtraMinAmounts, "", "Minimum amounts of the other tokens withdrawn from the stable pool, separated by commas")
 	cmd.Flags().StringVarP(&deadlineDuration, flagDeadlineDuration, "d", "30s", "Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
 	cmd.MarkFlagRequired(flagLiquidity)
 	cmd.MarkFlagRequired(flagMinBaseAmount)
@@ -163,14 +207,26 @@ func getCmdCreateExchange(cdc *codec.Codec) *cobra.Command {
 	// flags
 	var token0 string
 	var token1 string
+	var feeRate string
+	var poolType string
+	var initialPrice string
+	var tickSpacing int64
+	var extraTokens []string
+	var amplification int64
 	cmd := &cobra.Command{
 		Use:   "create-pair",
 		Short: "create token pair",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`create token pair.
+			fmt.Sprintf(`create token pair with one of the fee tiers of the params, the default fee rate is used if
+the fee rate is not set. A concentrated pool is created at the initial price with --pool-type concentrated, whose
+liquidity is provided within the tick ranges. A stable pool of up to 4 tokens is created with --pool-type stable,
+whose swaps follow the StableSwap curve amplified by the amplification coefficient.
 
 Example:
 $ exchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01okt 
+$ exchaincli tx swap create-pair --token0 usdt-355 --token1 usdk-366 --fee-rate 0.0005 --fees 0.01okt 
+$ exchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --pool-type concentrated --initial-price 0.05 --tick-spacing 60 --fees 0.01okt
+$ exchaincli tx swap create-pair --token0 dai-355 --token1 usdc-366 --extra-tokens usdt-377 --pool-type stable --amplification 100 --fees 0.01okt
 
 `),
 		),
@@ -179,7 +235,28 @@ $ exchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01ok
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
-			msg := types.NewMsgCreateExchange(token0, token1, cliCtx.FromAddress)
+			fee := sdk.ZeroDec()
+			if feeRate != "" {
+				var err error
+				if fee, err = sdk.NewDecFromStr(feeRate); err != nil {
+					return fmt.Errorf("invalid fee rate %s: %s", feeRate, err)
+				}
+			}
+			msg := types.NewMsgCreateExchangeWithFeeRate(token0, token1, fee, cliCtx.FromAddress)
+			switch poolType {
+			case types.PoolTypeConstantProduct:
+			case types.PoolTypeConcentrated:
+				price, err := sdk.NewDecFromStr(initialPrice)
+				if err != nil {
+					return fmt.Errorf("invalid initial price %s: %s", initialPrice, err)
+				}
+				msg = types.NewMsgCreateConcentratedExchange(token0, token1, fee, price, tickSpacing, cliCtx.FromAddress)
+			case types.PoolTypeStable:
+				tokens := append([]string{token0, token1}, extraTokens...)
+				msg = types.NewMsgCreateStableExchange(tokens, fee, amplification, cliCtx.FromAddress)
+			default:
+				return fmt.Errorf("invalid pool type %s", poolType)
+			}
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -187,11 +264,206 @@ $ exchaincli tx swap create-pair --token0 eth-355 --token1 btc-366 --fees 0.01ok
 
 	cmd.Flags().StringVar(&token0, flagToken0, "", "the base token name is required to create an AMM swap pair")
 	cmd.Flags().StringVar(&token1, flagToken1, "", "the quote token name is required to create an AMM swap pair")
+	cmd.Flags().StringVar(&feeRate, flagFeeRate, "", "the fee tier of the AMM swap pair, the default fee rate is used if it's not set")
+	cmd.Flags().StringVar(&poolType, flagPoolType, types.PoolTypeConstantProduct, "the type of the pool, \"constant_product\", \"concentrated\" or \"stable\"")
+	cmd.Flags().StringVar(&initialPrice, flagInitialPrice, "", "the initial price of the concentrated pool in quote token per base token")
+	cmd.Flags().Int64Var(&tickSpacing, flagTickSpacing, types.DefaultTickSpacing, "the tick spacing of the concentrated pool, which the position ticks must be multiples of")
+	cmd.Flags().StringSliceVar(&extraTokens, flagExtraTokens, nil, "the other token names of the stable pool, separated by commas")
+	cmd.Flags().Int64Var(&amplification, flagAmplification, 0, "the amplification coefficient of the stable pool")
 	cmd.MarkFlagRequired(flagToken0)
 	cmd.MarkFlagRequired(flagToken1)
 	return cmd
 }
 
+func getCmdAddPosition(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var positionID uint64
+	var lowerTick int64
+	var upperTick int64
+	var maxBaseAmount string
+	var maxQuoteAmount string
+	var minLiquidity string
+	var deadlineDuration string
+	cmd := &cobra.Command{
+		Use:   "add-position",
+		Short: "add liquidity to a concentrated position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`add liquidity to the concentrated pool within the tick range. A new position is opened if
+the position id is not set, otherwise the liquidity is added to the position owned by the sender.
+
+Example:
+$ exchaincli tx swap add-position --lower-tick -600 --upper-tick 600 --max-base-amount 10eth-355 --max-quote-amount 100btc-366 --min-liquidity 0.001
+$ exchaincli tx swap add-position --position-id 1 --lower-tick -600 --upper-tick 600 --max-base-amount 10eth-355 --max-quote-amount 100btc-366 --min-liquidity 0.001
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			minLiquidityDec, sdkErr := sdk.NewDecFromStr(minLiquidity)
+			if sdkErr != nil {
+				return sdkErr
+			}
+			maxBaseAmountDecCoin, err := sdk.ParseDecCoin(maxBaseAmount)
+			if err != nil {
+				return err
+			}
+			maxQuoteAmountDecCoin, err := sdk.ParseDecCoin(maxQuoteAmount)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(deadlineDuration)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(duration).Unix()
+			msg := types.NewMsgAddConcentratedLiquidity(positionID, lowerTick, upperTick, maxBaseAmountDecCoin,
+				maxQuoteAmountDecCoin, minLiquidityDec, deadline, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64Var(&positionID, flagPositionID, 0, "the id of the position owned by the sender, a new position is opened if it's not set")
+	cmd.Flags().Int64Var(&lowerTick, flagLowerTick, 0, "the lower tick of the price range")
+	cmd.Flags().Int64Var(&upperTick, flagUpperTick, 0, "the upper tick of the price range")
+	cmd.Flags().StringVarP(&maxBaseAmount, flagMaxBaseAmount, "", "", "Maximum number of base amount deposited. For example \"100xxb\"")
+	cmd.Flags().StringVarP(&maxQuoteAmount, flagMaxQuoteAmount, "", "", "Maximum number of quote amount deposited. For example \"100okb\"")
+	cmd.Flags().StringVarP(&minLiquidity, flagMinLiquidity, "l", "", "Minimum liquidity of the position added")
+	cmd.Flags().StringVarP(&deadlineDuration, flagDeadlineDuration, "d", "30s", "Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagLowerTick)
+	cmd.MarkFlagRequired(flagUpperTick)
+	cmd.MarkFlagRequired(flagMaxBaseAmount)
+	cmd.MarkFlagRequired(flagMaxQuoteAmount)
+	cmd.MarkFlagRequired(flagMinLiquidity)
+	return cmd
+}
+
+func getCmdRemovePosition(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var positionID uint64
+	var liquidity string
+	var minBaseAmount string
+	var minQuoteAmount string
+	var deadlineDuration string
+	cmd := &cobra.Command{
+		Use:   "remove-position",
+		Short: "remove liquidity from a concentrated position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`remove liquidity from the concentrated position, the fees earned by the position are
+withdrawn together. The position is closed once all of its liquidity is removed.
+
+Example:
+$ exchaincli tx swap remove-position --position-id 1 --liquidity 1 --min-base-amount 10eth-355 --min-quote-amount 1btc-366
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			liquidityDec, sdkErr := sdk.NewDecFromStr(liquidity)
+			if sdkErr != nil {
+				return sdkErr
+			}
+			minBaseAmountDecCoin, err := sdk.ParseDecCoin(minBaseAmount)
+			if err != nil {
+				return err
+			}
+			minQuoteAmountDecCoin, err := sdk.ParseDecCoin(minQuoteAmount)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(deadlineDuration)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(duration).Unix()
+			msg := types.NewMsgRemoveConcentratedLiquidity(positionID, liquidityDec, minBaseAmountDecCoin,
+				minQuoteAmountDecCoin, deadline, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64Var(&positionID, flagPositionID, 0, "the id of the position owned by the sender")
+	cmd.Flags().StringVarP(&liquidity, flagLiquidity, "l", "", "Liquidity of the position removed")
+	cmd.Flags().StringVarP(&minBaseAmount, flagMinBaseAmount, "", "", "Minimum number of base amount withdrawn")
+	cmd.Flags().StringVarP(&minQuoteAmount, flagMinQuoteAmount, "q", "", "Minimum number of quote amount withdrawn")
+	cmd.Flags().StringVarP(&deadlineDuration, flagDeadlineDuration, "d", "30s", "Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagPositionID)
+	cmd.MarkFlagRequired(flagLiquidity)
+	cmd.MarkFlagRequired(flagMinBaseAmount)
+	cmd.MarkFlagRequired(flagMinQuoteAmount)
+	return cmd
+}
+
+func getCmdCollectFees(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "collect-fees [position-id]",
+		Short: "collect the fees earned by a concentrated position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`collect the fees earned by the concentrated position owned by the sender.
+
+Example:
+$ exchaincli tx swap collect-fees 1
+
+`),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id %s: %s", args[0], err)
+			}
+			msg := types.NewMsgCollectConcentratedFees(positionID, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdTransferPosition(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-position [position-id] [recipient]",
+		Short: "transfer a concentrated position to the recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`transfer the concentrated position owned by the sender to the recipient, together with the
+fees it has earned.
+
+Example:
+$ exchaincli tx swap transfer-position 1 ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+
+`),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid position id %s: %s", args[0], err)
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferConcentratedPosition(positionID, recipient, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
 func getCmdTokenSwap(cdc *codec.Codec) *cobra.Command {
 	// flags
 	var soldTokenAmount string
@@ -257,3 +529,243 @@ $ exchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
 
 	return cmd
 }
+
+func getCmdTokenSwapByRoute(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var soldTokenAmount string
+	var minBoughtTokenAmount string
+	var route []string
+	var deadline string
+	var recipient string
+	cmd := &cobra.Command{
+		Use:   "token-by-route",
+		Short: "swap the exact amount of token through the swap token pairs of the route",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap the exact amount of token through the swap token pairs of the route.
+
+Example:
+$ exchaincli tx swap token-by-route --sell-amount 1eth-355 --min-buy-amount 60btc-366 --route okt,usdt-a2b
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			soldTokenAmount, err := sdk.ParseDecCoin(soldTokenAmount)
+			if err != nil {
+				return err
+			}
+			minBoughtTokenAmount, err := sdk.ParseDecCoin(minBoughtTokenAmount)
+			if err != nil {
+				return err
+			}
+			dur, err := time.ParseDuration(deadline)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(dur).Unix()
+			recip, err := getRecipient(cliCtx, recipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenToTokenByRoute(soldTokenAmount, minBoughtTokenAmount, route,
+				deadline, recip, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&soldTokenAmount, flagSellAmount, "", "",
+		"Amount expected to sell")
+	cmd.Flags().StringVarP(&minBoughtTokenAmount, flagMinBuyAmount, "", "",
+		"Minimum amount expected to buy")
+	cmd.Flags().StringSliceVarP(&route, flagRoute, "", nil,
+		"The intermediate tokens between the token to sell and the token to buy, separated by commas")
+	cmd.Flags().StringVarP(&recipient, flagRecipient, "", "",
+		"The address to receive the amount bought")
+	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "100s",
+		"Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagSellAmount)
+	cmd.MarkFlagRequired(flagMinBuyAmount)
+
+	return cmd
+}
+
+func getCmdTokenSwapExactOutput(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var maxSoldTokenAmount string
+	var boughtTokenAmount string
+	var route []string
+	var deadline string
+	var recipient string
+	cmd := &cobra.Command{
+		Use:   "exact-token",
+		Short: "swap token for the exact amount of token through the swap token pairs of the route",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap token for the exact amount of token through the swap token pairs of the route.
+
+Example:
+$ exchaincli tx swap exact-token --max-sell-amount 1eth-355 --buy-amount 60btc-366 --route okt
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			maxSoldTokenAmount, err := sdk.ParseDecCoin(maxSoldTokenAmount)
+			if err != nil {
+				return err
+			}
+			boughtTokenAmount, err := sdk.ParseDecCoin(boughtTokenAmount)
+			if err != nil {
+				return err
+			}
+			dur, err := time.ParseDuration(deadline)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(dur).Unix()
+			recip, err := getRecipient(cliCtx, recipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenToExactToken(maxSoldTokenAmount, boughtTokenAmount, route,
+				deadline, recip, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&maxSoldTokenAmount, flagMaxSellAmount, "", "",
+		"Maximum amount expected to sell")
+	cmd.Flags().StringVarP(&boughtTokenAmount, flagBuyAmount, "", "",
+		"Amount expected to buy")
+	cmd.Flags().StringSliceVarP(&route, flagRoute, "", nil,
+		"The intermediate tokens between the token to sell and the token to buy, separated by commas")
+	cmd.Flags().StringVarP(&recipient, flagRecipient, "", "",
+		"The address to receive the amount bought")
+	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "100s",
+		"Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagMaxSellAmount)
+	cmd.MarkFlagRequired(flagBuyAmount)
+
+	return cmd
+}
+
+// getRecipient returns the address of the recipient, which is the sender by default
+func getRecipient(cliCtx context.CLIContext, recipient string) (sdk.AccAddress, error) {
+	if recipient == "" {
+		return cliCtx.FromAddress, nil
+	}
+	return sdk.AccAddressFromBech32(recipient)
+}
+
+// GetCmdWithdrawProtocolFeeProposal implements a command handler for submitting a swap withdraw protocol fee
+// proposal transaction
+func GetCmdWithdrawProtocolFeeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-protocol-fee [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a withdraw protocol fee proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal withdrawing the protocol fees of the swaps along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal withdraw-protocol-fee <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "withdraw protocol fee",
+ "description": "withdraw the protocol fees of the swaps to the community",
+ "recipient": "ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02",
+ "amount": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ],
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := swaputils.ParseWithdrawProtocolFeeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewWithdrawProtocolFeeProposal(proposal.Title, proposal.Description, proposal.Recipient,
+				proposal.Amount)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRampAmplificationProposal implements a command handler for submitting a proposal ramping the amplification
+// coefficient of a stable pool
+func GetCmdRampAmplificationProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ramp-amplification [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal ramping the amplification coefficient of a stable pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal ramping the amplification coefficient of a stable pool linearly to the future
+one over the ramp duration in seconds, along with an initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal ramp-amplification <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "ramp amplification",
+ "description": "ramp the amplification coefficient of the stable pool to 200 in one day",
+ "pool_name": "dai-355_usdc-366_usdt-377",
+ "future_amplification": "200",
+ "ramp_duration": "86400",
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := swaputils.ParseRampAmplificationProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewRampAmplificationProposal(proposal.Title, proposal.Description, proposal.PoolName,
+				proposal.FutureAmplification, proposal.RampDuration)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
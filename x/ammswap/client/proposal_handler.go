@@ -0,0 +1,16 @@
+package client
+
+import (
+	"github.com/okex/exchain/x/ammswap/client/cli"
+	"github.com/okex/exchain/x/ammswap/client/rest"
+	govcli "github.com/okex/exchain/x/gov/client"
+)
+
+var (
+	// WithdrawProtocolFeeProposalHandler alias gov NewProposalHandler
+	WithdrawProtocolFeeProposalHandler = govcli.NewProposalHandler(cli.GetCmdWithdrawProtocolFeeProposal,
+		rest.WithdrawProtocolFeeProposalRESTHandler)
+	// RampAmplificationProposalHandler alias gov NewProposalHandler
+	RampAmplificationProposalHandler = govcli.NewProposalHandler(cli.GetCmdRampAmplificationProposal,
+		rest.RampAmplificationProposalRESTHandler)
+)
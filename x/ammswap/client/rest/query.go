@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
@@ -20,6 +21,13 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/liquidity/add_quote/{token}", swapAddQuoteHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/liquidity/remove_quote/{token_pair}", queryRedeemableAssetsHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/quote/{token}", swapQuoteHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/route/{token}", swapRouteHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/route_exact_output/{token}", swapRouteExactOutputHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/twap/{token_pair}", querySwapTWAPHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/concentrated_pool/{token_pair}", queryConcentratedHandler(cliCtx, types.QueryConcentratedPool, "token_pair")).Methods("GET")
+	r.HandleFunc("/position/{id}", queryConcentratedHandler(cliCtx, types.QueryConcentratedPosition, "id")).Methods("GET")
+	r.HandleFunc("/positions/{address}", queryConcentratedHandler(cliCtx, types.QueryConcentratedPositions, "address")).Methods("GET")
+	r.HandleFunc("/stable_pool/{pool_name}", queryConcentratedHandler(cliCtx, types.QueryStablePool, "pool_name")).Methods("GET")
 }
 
 func querySwapTokenPairHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
@@ -105,6 +113,90 @@ func swapQuoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func swapRouteHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		buyToken := vars["token"]
+		sellTokenAmount := r.URL.Query().Get("sell_token_amount")
+
+		params := types.NewQuerySwapBuyInfoParams(sellTokenAmount, buyToken)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwapRoute), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func swapRouteExactOutputHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		sellToken := vars["token"]
+		buyTokenAmount := r.URL.Query().Get("buy_token_amount")
+
+		params := types.NewQuerySwapSellInfoParams(buyTokenAmount, sellToken)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwapRouteExactOutput), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func querySwapTWAPHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tokenPair := vars["token_pair"]
+		startHeight, err := strconv.ParseInt(r.URL.Query().Get("start_height"), 10, 64)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeStrconvFailed, err.Error())
+			return
+		}
+		var endHeight int64
+		if endHeightStr := r.URL.Query().Get("end_height"); endHeightStr != "" {
+			endHeight, err = strconv.ParseInt(endHeightStr, 10, 64)
+			if err != nil {
+				common.HandleErrorMsg(w, cliCtx, common.CodeStrconvFailed, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQuerySwapTWAPParams(tokenPair, startHeight, endHeight)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwapTWAP), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func swapAddQuoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -128,3 +220,17 @@ func swapAddQuoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// queryConcentratedHandler queries the concentrated pool or positions, or the stable pool with the path variable of
+// the request
+func queryConcentratedHandler(cliContext context.CLIContext, query, varName string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliContext.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, query, mux.Vars(r)[varName]), nil)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliContext, sdkErr.Code, sdkErr.Message)
+			return
+		}
+		rest.PostProcessResponse(w, cliContext, res)
+	}
+}
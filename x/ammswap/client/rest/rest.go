@@ -2,6 +2,7 @@ package rest
 
 import (
 	"github.com/gorilla/mux"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 )
@@ -10,3 +11,13 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 }
+
+// WithdrawProtocolFeeProposalRESTHandler defines swap proposal handler
+func WithdrawProtocolFeeProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// RampAmplificationProposalRESTHandler defines swap proposal handler
+func RampAmplificationProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// WithdrawProtocolFeeProposalJSON defines a WithdrawProtocolFeeProposal with a deposit used to parse withdraw
+// protocol fee proposals from a JSON file.
+type WithdrawProtocolFeeProposalJSON struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.SysCoins   `json:"amount" yaml:"amount"`
+	Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+}
+
+// ParseWithdrawProtocolFeeProposalJSON parses json from proposal file to WithdrawProtocolFeeProposalJSON struct
+func ParseWithdrawProtocolFeeProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal WithdrawProtocolFeeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// RampAmplificationProposalJSON defines a RampAmplificationProposal with a deposit used to parse ramp amplification
+// proposals from a JSON file.
+type RampAmplificationProposalJSON struct {
+	Title               string       `json:"title" yaml:"title"`
+	Description         string       `json:"description" yaml:"description"`
+	PoolName            string       `json:"pool_name" yaml:"pool_name"`
+	FutureAmplification int64        `json:"future_amplification" yaml:"future_amplification"`
+	RampDuration        int64        `json:"ramp_duration" yaml:"ramp_duration"`
+	Deposit             sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseRampAmplificationProposalJSON parses json from proposal file to RampAmplificationProposalJSON struct
+func ParseRampAmplificationProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal RampAmplificationProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
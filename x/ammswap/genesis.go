@@ -12,6 +12,15 @@ import (
 type GenesisState struct {
 	Params               Params          `json:"params"`
 	SwapTokenPairRecords []SwapTokenPair `json:"swap_token_pair_records"`
+
+	ConcentratedPools          []types.ConcentratedPool     `json:"concentrated_pools"`
+	ConcentratedTicks          []types.ConcentratedTick     `json:"concentrated_ticks"`
+	ConcentratedPositions      []types.ConcentratedPosition `json:"concentrated_positions"`
+	NextConcentratedPositionID uint64                       `json:"next_concentrated_position_id"`
+
+	StablePools []types.StablePool `json:"stable_pools"`
+
+	SwapPriceObservations []types.SwapPriceObservationRecord `json:"swap_price_observations"`
 }
 
 // nolint
@@ -21,7 +30,9 @@ func NewGenesisState(swapTokenPairRecords []SwapTokenPair) GenesisState {
 
 // ValidateGenesis validates the format of the specified genesisState
 func ValidateGenesis(data GenesisState) error {
+	tokenPairs := make(map[string]struct{}, len(data.SwapTokenPairRecords))
 	for _, record := range data.SwapTokenPairRecords {
+		tokenPairs[record.TokenPairName()] = struct{}{}
 		if !record.QuotePooledCoin.IsValid() {
 			return fmt.Errorf("invalid SwapTokenPairRecord: QuotePooledCoin: %s", record.QuotePooledCoin.String())
 		}
@@ -32,14 +43,70 @@ func ValidateGenesis(data GenesisState) error {
 			return fmt.Errorf("invalid SwapTokenPairRecord: PoolToken: %s. Error: invalid PoolToken", record.PoolTokenName)
 		}
 	}
+
+	pools := make(map[string]types.ConcentratedPool, len(data.ConcentratedPools))
+	for _, pool := range data.ConcentratedPools {
+		if !pool.BasePooledCoin.IsValid() || !pool.QuotePooledCoin.IsValid() {
+			return fmt.Errorf("invalid ConcentratedPool: %s. Error: invalid pooled coins", pool.TokenPairName())
+		}
+		if pool.TickSpacing <= 0 || pool.TickSpacing > types.MaxTickSpacing {
+			return fmt.Errorf("invalid ConcentratedPool: %s. Error: invalid tick spacing %d", pool.TokenPairName(), pool.TickSpacing)
+		}
+		pools[pool.TokenPairName()] = pool
+	}
+	for _, tick := range data.ConcentratedTicks {
+		pool, ok := pools[tick.TokenPairName]
+		if !ok {
+			return fmt.Errorf("invalid ConcentratedTick: %d. Error: concentrated pool %s doesn't exist", tick.Index, tick.TokenPairName)
+		}
+		if tick.Index%pool.TickSpacing != 0 || tick.Index < types.MinTick || tick.Index > types.MaxTick {
+			return fmt.Errorf("invalid ConcentratedTick: %d. Error: invalid tick index", tick.Index)
+		}
+	}
+	for _, position := range data.ConcentratedPositions {
+		pool, ok := pools[position.TokenPairName]
+		if !ok {
+			return fmt.Errorf("invalid ConcentratedPosition: %d. Error: concentrated pool %s doesn't exist", position.ID, position.TokenPairName)
+		}
+		if err := pool.ValidateTickRange(position.LowerTick, position.UpperTick); err != nil {
+			return fmt.Errorf("invalid ConcentratedPosition: %d. Error: %s", position.ID, err.Error())
+		}
+		if position.ID >= data.NextConcentratedPositionID {
+			return fmt.Errorf("invalid ConcentratedPosition: %d. Error: the id isn't less than the next position id %d",
+				position.ID, data.NextConcentratedPositionID)
+		}
+	}
+	for _, record := range data.SwapPriceObservations {
+		if _, ok := tokenPairs[record.TokenPairName]; !ok {
+			return fmt.Errorf("invalid SwapPriceObservation: %d. Error: swap token pair %s doesn't exist",
+				record.Observation.BlockHeight, record.TokenPairName)
+		}
+		if record.Observation.BlockHeight <= 0 {
+			return fmt.Errorf("invalid SwapPriceObservation: %d. Error: invalid block height", record.Observation.BlockHeight)
+		}
+	}
+	for _, pool := range data.StablePools {
+		if err := types.ValidateStablePoolTokens(pool.Tokens()); err != nil {
+			return fmt.Errorf("invalid StablePool: %s. Error: %s", pool.Name(), err.Error())
+		}
+		for _, coin := range pool.PooledCoins {
+			if !coin.IsValid() {
+				return fmt.Errorf("invalid StablePool: %s. Error: invalid pooled coins", pool.Name())
+			}
+		}
+		if err := types.ValidateAmplificationChange(pool.FutureAmplification, pool.FutureAmplification); err != nil {
+			return fmt.Errorf("invalid StablePool: %s. Error: %s", pool.Name(), err.Error())
+		}
+	}
 	return nil
 }
 
 // nolint
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:               types.DefaultParams(),
-		SwapTokenPairRecords: nil,
+		Params:                     types.DefaultParams(),
+		SwapTokenPairRecords:       nil,
+		NextConcentratedPositionID: 1,
 	}
 }
 
@@ -47,7 +114,25 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 	for _, record := range data.SwapTokenPairRecords {
-		keeper.SetSwapTokenPair(ctx, record.TokenPairName(), record)
+		keeper.InitSwapTokenPair(ctx, record.TokenPairName(), record)
+	}
+	for _, record := range data.SwapPriceObservations {
+		keeper.SetSwapPriceObservation(ctx, record.TokenPairName, record.Observation)
+	}
+	for _, pool := range data.ConcentratedPools {
+		keeper.SetConcentratedPool(ctx, pool)
+	}
+	for _, tick := range data.ConcentratedTicks {
+		keeper.SetConcentratedTick(ctx, tick)
+	}
+	for _, position := range data.ConcentratedPositions {
+		keeper.SetConcentratedPosition(ctx, position)
+	}
+	if data.NextConcentratedPositionID > 0 {
+		keeper.SetNextConcentratedPositionID(ctx, data.NextConcentratedPositionID)
+	}
+	for _, pool := range data.StablePools {
+		keeper.SetStablePool(ctx, pool)
 	}
 }
 
@@ -62,5 +147,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	}
 	params := k.GetParams(ctx)
-	return GenesisState{SwapTokenPairRecords: records, Params: params}
+	return GenesisState{
+		SwapTokenPairRecords:       records,
+		Params:                     params,
+		ConcentratedPools:          k.GetConcentratedPools(ctx),
+		ConcentratedTicks:          k.GetConcentratedTicks(ctx),
+		ConcentratedPositions:      k.GetConcentratedPositions(ctx),
+		NextConcentratedPositionID: k.GetNextConcentratedPositionID(ctx),
+		StablePools:                k.GetStablePools(ctx),
+		SwapPriceObservations:      k.GetSwapPriceObservationRecords(ctx),
+	}
 }
@@ -57,6 +57,10 @@ func TestInitAndExportGenesis(t *testing.T) {
 	defaultGenesisState.SwapTokenPairRecords = []SwapTokenPair{
 		testSwapTokenPair,
 	}
+	defaultGenesisState.SwapPriceObservations = []types.SwapPriceObservationRecord{{
+		TokenPairName: testSwapTokenPair.TokenPairName(),
+		Observation:   types.NewSwapPriceObservation(5, sdk.OneDec(), sdk.OneDec(), testSwapTokenPair),
+	}}
 	InitGenesis(ctx, keeper, defaultGenesisState)
 	exportedGenesis := ExportGenesis(ctx, keeper)
 	require.Equal(t, defaultGenesisState, exportedGenesis)
@@ -36,6 +36,36 @@ func NewHandler(k Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenToToken(ctx, k, msg)
 			}
+		case types.MsgTokenToTokenByRoute:
+			name = "handleMsgTokenToTokenByRoute"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenToTokenByRoute(ctx, k, msg)
+			}
+		case types.MsgTokenToExactToken:
+			name = "handleMsgTokenToExactToken"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenToExactToken(ctx, k, msg)
+			}
+		case types.MsgAddConcentratedLiquidity:
+			name = "handleMsgAddConcentratedLiquidity"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgAddConcentratedLiquidity(ctx, k, msg)
+			}
+		case types.MsgRemoveConcentratedLiquidity:
+			name = "handleMsgRemoveConcentratedLiquidity"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgRemoveConcentratedLiquidity(ctx, k, msg)
+			}
+		case types.MsgCollectConcentratedFees:
+			name = "handleMsgCollectConcentratedFees"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCollectConcentratedFees(ctx, k, msg)
+			}
+		case types.MsgTransferConcentratedPosition:
+			name = "handleMsgTransferConcentratedPosition"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTransferConcentratedPosition(ctx, k, msg)
+			}
 		default:
 			return nil, types.ErrSwapUnknownMsgType()
 		}
@@ -49,6 +79,12 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgTokenToToken(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*sdk.Result, error) {
+	if pool, found := k.GetConcentratedPool(ctx, msg.GetSwapTokenPairName()); found {
+		return swapConcentratedToken(ctx, k, pool, msg)
+	}
+	if pool, found := k.GetStablePoolByTokenPair(ctx, msg.GetSwapTokenPairName()); found {
+		return swapStableToken(ctx, k, pool, msg)
+	}
 	_, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName())
 	if err != nil {
 		return swapTokenByRouter(ctx, k, msg)
@@ -71,28 +107,43 @@ func handleMsgCreateExchange(ctx sdk.Context, k Keeper, msg types.MsgCreateExcha
 		return nil, err
 	}
 
-	// 1. check if the token pair exists
+	// 1. check if the fee rate is one of the fee tiers, the pair follows the default fee rate if it's not set
+	feeRate := sdk.ZeroDec()
+	if !msg.FeeRate.IsNil() && !msg.FeeRate.IsZero() {
+		if !k.GetParams(ctx).IsFeeTier(msg.FeeRate) {
+			return types.ErrInvalidFeeRate(msg.FeeRate).Result()
+		}
+		feeRate = msg.FeeRate
+	}
+
+	// 2. check if the token pair exists, a token pair has only one pool of any type
 	tokenPairName := msg.GetSwapTokenPairName()
-	_, err = k.GetSwapTokenPair(ctx, tokenPairName)
-	if err == nil {
+	if k.IsTokenPairPooled(ctx, tokenPairName) {
 		return types.ErrSwapTokenPairExist().Result()
 	}
+	switch msg.PoolType {
+	case types.PoolTypeConcentrated:
+		return createConcentratedPool(ctx, k, msg, feeRate)
+	case types.PoolTypeStable:
+		return createStablePool(ctx, k, msg, feeRate)
+	}
 
-	// 2. check if the pool token exists
+	// 3. check if the pool token exists
 	poolTokenName := types.GetPoolTokenName(msg.Token0Name, msg.Token1Name)
 	_, err = k.GetPoolTokenInfo(ctx, poolTokenName)
 	if err == nil {
 		return types.ErrPoolTokenPairExist().Result()
 	}
 
-	// 3. create the pool token
+	// 4. create the pool token
 	k.NewPoolToken(ctx, poolTokenName)
 
-	// 4. create the token pair
+	// 5. create the token pair
 	swapTokenPair := types.NewSwapPair(msg.Token0Name, msg.Token1Name)
+	swapTokenPair.FeeRate = feeRate
 	k.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
 
-	// 5. notify backend module
+	// 6. notify backend module
 	k.OnCreateExchange(ctx, swapTokenPair)
 
 	event = event.AppendAttributes(sdk.NewAttribute("pool-token-name", poolTokenName))
@@ -106,6 +157,9 @@ func handleMsgAddLiquidity(ctx sdk.Context, k Keeper, msg types.MsgAddLiquidity)
 	if msg.Deadline < ctx.BlockTime().Unix() {
 		return types.ErrBlockTimeBigThanDeadline().Result()
 	}
+	if pool, found := k.GetStablePool(ctx, msg.GetStablePoolName()); found {
+		return addStableLiquidity(ctx, k, pool, msg)
+	}
 	swapTokenPair, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName())
 	if err != nil {
 		return nil, err
@@ -178,6 +232,9 @@ func handleMsgRemoveLiquidity(ctx sdk.Context, k Keeper, msg types.MsgRemoveLiqu
 	if msg.Deadline < ctx.BlockTime().Unix() {
 		return types.ErrMsgDeadlineLessThanBlockTime().Result()
 	}
+	if pool, found := k.GetStablePool(ctx, msg.GetStablePoolName()); found {
+		return removeStableLiquidity(ctx, k, pool, msg)
+	}
 	swapTokenPair, err := k.GetSwapTokenPair(ctx, msg.GetSwapTokenPairName())
 	if err != nil {
 		return nil, err
@@ -343,19 +400,113 @@ func swapTokenNativeToken(
 		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
 	}
 
+	// the protocol fee is taken out of the pool
+	protocolFee := swapTokenPair.GetProtocolFee(msg.SoldTokenAmount, k.GetParams(ctx))
+	if err := k.CollectProtocolFee(ctx, protocolFee); err != nil {
+		return nil, err
+	}
+	soldTokenToPool := msg.SoldTokenAmount.Sub(protocolFee)
+
 	// update swapTokenPair
 	if msg.MinBoughtTokenAmount.Denom < msg.SoldTokenAmount.Denom {
-		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(msg.SoldTokenAmount)
+		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(soldTokenToPool)
 		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(tokenBuy)
 	} else {
 		swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(tokenBuy)
-		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(msg.SoldTokenAmount)
+		swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(soldTokenToPool)
 	}
 	k.SetSwapTokenPair(ctx, msg.GetSwapTokenPairName(), swapTokenPair)
 	k.OnSwapToken(ctx, msg.Recipient, swapTokenPair, msg.SoldTokenAmount, tokenBuy)
 	return &sdk.Result{}, nil
 }
 
+func handleMsgTokenToTokenByRoute(ctx sdk.Context, k Keeper, msg types.MsgTokenToTokenByRoute) (*sdk.Result, error) {
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	path := msg.GetSwapPath()
+	amounts, err := k.GetSwapRouteAmountsOut(ctx, msg.SoldTokenAmount, path)
+	if err != nil {
+		return nil, err
+	}
+	if amounts[len(amounts)-1].Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+	return swapTokenByPath(ctx, k, path, amounts, msg.Sender, msg.Recipient)
+}
+
+func handleMsgTokenToExactToken(ctx sdk.Context, k Keeper, msg types.MsgTokenToExactToken) (*sdk.Result, error) {
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	path := msg.GetSwapPath()
+	amounts, err := k.GetSwapRouteAmountsIn(ctx, msg.BoughtTokenAmount, path)
+	if err != nil {
+		return nil, err
+	}
+	if amounts[0].Amount.GT(msg.MaxSoldTokenAmount.Amount) {
+		return types.ErrLessThan("max sold token amount", "token sell amount").Result()
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{amounts[0]}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	return swapTokenByPath(ctx, k, path, amounts, msg.Sender, msg.Recipient)
+}
+
+// swapTokenByPath swaps the tokens through the swap token pairs of the path by the amounts calculated, the first
+// amount is sold by the sender and the last one is bought by the recipient
+func swapTokenByPath(
+	ctx sdk.Context, k Keeper, path []string, amounts []sdk.SysCoin, sender, recipient sdk.AccAddress,
+) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	// transfer coins
+	soldToken, boughtToken := amounts[0], amounts[len(amounts)-1]
+	err := k.SendCoinsToPool(ctx, sdk.SysCoins{soldToken}, sender)
+	if err != nil {
+		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
+	}
+	err = k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{boughtToken}, recipient)
+	if err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+
+	// update the swapTokenPairs of the path, the protocol fee of each hop is taken out of the pool
+	params := k.GetParams(ctx)
+	for i := 1; i < len(path); i++ {
+		tokenPairName := types.GetSwapTokenPairName(path[i-1], path[i])
+		swapTokenPair, err := k.GetSwapTokenPair(ctx, tokenPairName)
+		if err != nil {
+			return nil, err
+		}
+		protocolFee := swapTokenPair.GetProtocolFee(amounts[i-1], params)
+		if err := k.CollectProtocolFee(ctx, protocolFee); err != nil {
+			return nil, err
+		}
+		soldTokenToPool := amounts[i-1].Sub(protocolFee)
+		if path[i] < path[i-1] {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(soldTokenToPool)
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(amounts[i])
+		} else {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(amounts[i])
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(soldTokenToPool)
+		}
+		k.SetSwapTokenPair(ctx, tokenPairName, swapTokenPair)
+		k.OnSwapToken(ctx, recipient, swapTokenPair, amounts[i-1], amounts[i])
+	}
+
+	event.AppendAttributes(sdk.NewAttribute("sold_token_amount", soldToken.String()))
+	event.AppendAttributes(sdk.NewAttribute("bought_token_amount", boughtToken.String()))
+	event.AppendAttributes(sdk.NewAttribute("recipient", recipient.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func coinSort(coins sdk.SysCoins) sdk.SysCoins {
 	var newCoins sdk.SysCoins
 	for _, coin := range coins {
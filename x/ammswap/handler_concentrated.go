@@ -0,0 +1,236 @@
+package ammswap
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/common"
+)
+
+func createConcentratedPool(ctx sdk.Context, k Keeper, msg types.MsgCreateExchange, feeRate sdk.Dec) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	pool := types.NewConcentratedPool(msg.Token0Name, msg.Token1Name, feeRate, msg.GetTickSpacing(), msg.InitialPrice)
+	k.SetConcentratedPool(ctx, pool)
+
+	event = event.AppendAttributes(sdk.NewAttribute("pool-type", types.PoolTypeConcentrated))
+	event = event.AppendAttributes(sdk.NewAttribute("token-pair", pool.TokenPairName()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAddConcentratedLiquidity(ctx sdk.Context, k Keeper, msg types.MsgAddConcentratedLiquidity) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	tokenPairName := msg.GetSwapTokenPairName()
+	pool, found := k.GetConcentratedPool(ctx, tokenPairName)
+	if !found {
+		return types.ErrNonExistSwapTokenPair(tokenPairName).Result()
+	}
+
+	// 1. get the position to add liquidity to, or create a new one
+	var position types.ConcentratedPosition
+	if msg.PositionID == 0 {
+		if err := pool.ValidateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+			return nil, err
+		}
+		position = types.NewConcentratedPosition(k.GetNextConcentratedPositionID(ctx), msg.Sender, tokenPairName,
+			msg.LowerTick, msg.UpperTick)
+		k.SetNextConcentratedPositionID(ctx, position.ID+1)
+	} else {
+		position, found = k.GetConcentratedPosition(ctx, msg.PositionID)
+		if !found {
+			return types.ErrNonExistConcentratedPosition(msg.PositionID).Result()
+		}
+		if !position.Owner.Equals(msg.Sender) {
+			return types.ErrNotConcentratedPositionOwner(msg.PositionID, msg.Sender).Result()
+		}
+		if position.TokenPairName != tokenPairName {
+			return types.ErrInvalidTokenPair(tokenPairName).Result()
+		}
+		if position.LowerTick != msg.LowerTick || position.UpperTick != msg.UpperTick {
+			return types.ErrInvalidTickRange(msg.LowerTick, msg.UpperTick, pool.TickSpacing).Result()
+		}
+	}
+
+	// 2. add the max liquidity the amounts could provide at the current price
+	liquidity := types.GetLiquidityForAmounts(pool.SqrtPrice, types.TickToSqrtPrice(position.LowerTick),
+		types.TickToSqrtPrice(position.UpperTick), msg.MaxBaseAmount.Amount, msg.MaxQuoteAmount.Amount)
+	if !liquidity.IsPositive() {
+		return types.ErrIsZeroValue("liquidity").Result()
+	}
+	if liquidity.LT(msg.MinLiquidity) {
+		return types.ErrLessThan("liquidity", "min liquidity").Result()
+	}
+	baseAmount, quoteAmount := k.ModifyConcentratedPosition(ctx, &pool, &position, liquidity)
+	if baseAmount.GT(msg.MaxBaseAmount.Amount) || quoteAmount.GT(msg.MaxQuoteAmount.Amount) {
+		return types.ErrBaseTokensAmountBiggerThanMax().Result()
+	}
+
+	// 3. transfer coins
+	baseTokens := sdk.NewDecCoinFromDec(pool.BasePooledCoin.Denom, baseAmount)
+	quoteTokens := sdk.NewDecCoinFromDec(pool.QuotePooledCoin.Denom, quoteAmount)
+	// the position out of range is provided with one token only
+	if err := k.SendCoinsToPool(ctx, sdk.NewDecCoins(baseTokens, quoteTokens), msg.Sender); err != nil {
+		return types.ErrSendCoinsFailed(err).Result()
+	}
+	k.SetConcentratedPosition(ctx, position)
+
+	event = event.AppendAttributes(sdk.NewAttribute("position_id", strconv.FormatUint(position.ID, 10)))
+	event = event.AppendAttributes(sdk.NewAttribute("liquidity", liquidity.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("baseAmount", baseTokens.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("quoteAmount", quoteTokens.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveConcentratedLiquidity(ctx sdk.Context, k Keeper, msg types.MsgRemoveConcentratedLiquidity) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrMsgDeadlineLessThanBlockTime().Result()
+	}
+	position, pool, err := getOwnedConcentratedPosition(ctx, k, msg.PositionID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if tokenPairName := types.GetSwapTokenPairName(msg.MinBaseAmount.Denom, msg.MinQuoteAmount.Denom); tokenPairName != position.TokenPairName {
+		return types.ErrInvalidTokenPair(tokenPairName).Result()
+	}
+	if position.Liquidity.LT(msg.Liquidity) {
+		return types.ErrLessThan("position liquidity", "liquidity").Result()
+	}
+
+	// 1. withdraw the liquidity, and check the amounts withdrawn
+	baseAmount, quoteAmount := k.ModifyConcentratedPosition(ctx, &pool, &position, msg.Liquidity.Neg())
+	baseTokens := sdk.NewDecCoinFromDec(pool.BasePooledCoin.Denom, baseAmount)
+	quoteTokens := sdk.NewDecCoinFromDec(pool.QuotePooledCoin.Denom, quoteAmount)
+	if baseTokens.IsLT(msg.MinBaseAmount) {
+		return types.ErrLessThan("base amount", "min base amount").Result()
+	}
+	if quoteTokens.IsLT(msg.MinQuoteAmount) {
+		return types.ErrLessThan("quote amount", "min quote amount").Result()
+	}
+
+	// 2. transfer the coins withdrawn with the fees accrued
+	baseFees, quoteFees := position.TokensOwedBase, position.TokensOwedQuote
+	coins := sdk.NewDecCoins(baseTokens.Add(sdk.NewDecCoinFromDec(baseTokens.Denom, baseFees)),
+		quoteTokens.Add(sdk.NewDecCoinFromDec(quoteTokens.Denom, quoteFees)))
+	if err := k.SendCoinsFromPoolToAccount(ctx, coins, msg.Sender); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+	position.TokensOwedBase, position.TokensOwedQuote = sdk.ZeroDec(), sdk.ZeroDec()
+
+	// 3. the position is closed once all of its liquidity is removed
+	if position.Liquidity.IsZero() {
+		k.DeleteConcentratedPosition(ctx, position.ID)
+	} else {
+		k.SetConcentratedPosition(ctx, position)
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute("position_id", strconv.FormatUint(position.ID, 10)))
+	event = event.AppendAttributes(sdk.NewAttribute("baseAmount", baseTokens.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("quoteAmount", quoteTokens.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("fees", sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(baseTokens.Denom, baseFees), sdk.NewDecCoinFromDec(quoteTokens.Denom, quoteFees)).String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCollectConcentratedFees(ctx sdk.Context, k Keeper, msg types.MsgCollectConcentratedFees) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	position, pool, err := getOwnedConcentratedPosition(ctx, k, msg.PositionID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// accrue the fees earned till now without changing the liquidity
+	k.ModifyConcentratedPosition(ctx, &pool, &position, sdk.ZeroDec())
+	fees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(pool.BasePooledCoin.Denom, position.TokensOwedBase),
+		sdk.NewDecCoinFromDec(pool.QuotePooledCoin.Denom, position.TokensOwedQuote),
+	)
+	if err := k.SendCoinsFromPoolToAccount(ctx, fees, msg.Sender); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+	position.TokensOwedBase, position.TokensOwedQuote = sdk.ZeroDec(), sdk.ZeroDec()
+	k.SetConcentratedPosition(ctx, position)
+
+	event = event.AppendAttributes(sdk.NewAttribute("position_id", strconv.FormatUint(position.ID, 10)))
+	event = event.AppendAttributes(sdk.NewAttribute("fees", fees.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferConcentratedPosition(ctx sdk.Context, k Keeper, msg types.MsgTransferConcentratedPosition) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	position, _, err := getOwnedConcentratedPosition(ctx, k, msg.PositionID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	position.Owner = msg.Recipient
+	k.SetConcentratedPosition(ctx, position)
+
+	event = event.AppendAttributes(sdk.NewAttribute("position_id", strconv.FormatUint(position.ID, 10)))
+	event = event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func swapConcentratedToken(ctx sdk.Context, k Keeper, pool types.ConcentratedPool, msg types.MsgTokenToToken) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	result, err := k.CalculateConcentratedSwap(ctx, pool, msg.SoldTokenAmount, k.GetParams(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if result.TokenBuy.IsZero() {
+		return types.ErrIsZeroValue("token buy").Result()
+	}
+	if result.TokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+
+	// transfer coins, the protocol fee is taken out of the pool
+	if err := k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender); err != nil {
+		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
+	}
+	if err := k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{result.TokenBuy}, msg.Recipient); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+	if err := k.CollectProtocolFee(ctx, result.ProtocolFee); err != nil {
+		return nil, err
+	}
+	k.ApplyConcentratedSwap(ctx, result)
+
+	event = event.AppendAttributes(sdk.NewAttribute("bought_token_amount", result.TokenBuy.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// getOwnedConcentratedPosition returns the concentrated position owned by the address and its pool
+func getOwnedConcentratedPosition(ctx sdk.Context, k Keeper, id uint64, owner sdk.AccAddress) (
+	position types.ConcentratedPosition, pool types.ConcentratedPool, err error) {
+	position, found := k.GetConcentratedPosition(ctx, id)
+	if !found {
+		return position, pool, types.ErrNonExistConcentratedPosition(id)
+	}
+	if !position.Owner.Equals(owner) {
+		return position, pool, types.ErrNotConcentratedPositionOwner(id, owner)
+	}
+	pool, found = k.GetConcentratedPool(ctx, position.TokenPairName)
+	if !found {
+		return position, pool, types.ErrNonExistSwapTokenPair(position.TokenPairName)
+	}
+	return position, pool, nil
+}
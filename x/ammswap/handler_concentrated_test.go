@@ -0,0 +1,127 @@
+package ammswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleConcentratedLiquidity(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 2, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr, addr2 := addrKeysSlice[0].Address, addrKeysSlice[1].Address
+	deadline := time.Now().Add(time.Minute).Unix()
+	base, quote := types.TestBasePooledToken, types.TestQuotePooledToken
+	tokenPairName := types.GetSwapTokenPairName(base, quote)
+	for _, symbol := range []string{base, quote} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+
+	// 1. create the concentrated pool at the price 1, and the constant product pool can't be created for the pair
+	msgCreate := types.NewMsgCreateConcentratedExchange(base, quote, sdk.ZeroDec(), sdk.OneDec(), 60, addr)
+	_, err := handler(ctx, msgCreate)
+	require.Nil(t, err)
+	_, err = handler(ctx, msgCreate)
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgCreateExchange(base, quote, addr))
+	require.NotNil(t, err)
+	pool, found := keeper.GetConcentratedPool(ctx, tokenPairName)
+	require.True(t, found)
+	require.Equal(t, int64(0), pool.CurrentTick)
+	require.True(t, pool.Liquidity.IsZero())
+
+	// 2. open a position in range, and another one above the price which only holds the base token
+	maxBase := sdk.NewDecCoinFromDec(base, sdk.NewDec(1000))
+	maxQuote := sdk.NewDecCoinFromDec(quote, sdk.NewDec(1000))
+	_, err = handler(ctx, types.NewMsgAddConcentratedLiquidity(0, -50, 600, maxBase, maxQuote, sdk.ZeroDec(), deadline, addr))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgAddConcentratedLiquidity(0, -600, 600, maxBase, maxQuote, sdk.ZeroDec(), deadline, addr))
+	require.Nil(t, err)
+	balances := mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	_, err = handler(ctx, types.NewMsgAddConcentratedLiquidity(0, 600, 1200, maxBase, maxQuote, sdk.ZeroDec(), deadline, addr2))
+	require.Nil(t, err)
+	coins := mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	require.Equal(t, balances.AmountOf(quote), coins.AmountOf(quote))
+	require.True(t, coins.AmountOf(base).LT(balances.AmountOf(base)))
+
+	position, found := keeper.GetConcentratedPosition(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, addr, position.Owner)
+	pool, _ = keeper.GetConcentratedPool(ctx, tokenPairName)
+	require.Equal(t, position.Liquidity, pool.Liquidity)
+	require.Equal(t, 2, len(keeper.GetConcentratedPositions(ctx)))
+	require.Equal(t, uint64(3), keeper.GetNextConcentratedPositionID(ctx))
+
+	// 3. only the owner adds liquidity to the position
+	_, err = handler(ctx, types.NewMsgAddConcentratedLiquidity(1, -600, 600, maxBase, maxQuote, sdk.ZeroDec(), deadline, addr2))
+	require.NotNil(t, err)
+
+	// 4. swap the quote token for the base token, the price goes up
+	balances = mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	soldToken := sdk.NewDecCoinFromDec(quote, sdk.NewDec(10))
+	minBuyToken := sdk.NewDecCoinFromDec(base, sdk.NewDec(9))
+	_, err = handler(ctx, types.NewMsgTokenToToken(soldToken, minBuyToken, deadline, addr2, addr2))
+	require.Nil(t, err)
+	coins = mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	require.Equal(t, balances.AmountOf(quote).Sub(soldToken.Amount), coins.AmountOf(quote))
+	require.True(t, coins.AmountOf(base).Sub(balances.AmountOf(base)).GTE(minBuyToken.Amount))
+	poolAfterSwap, _ := keeper.GetConcentratedPool(ctx, tokenPairName)
+	require.True(t, poolAfterSwap.SqrtPrice.GT(pool.SqrtPrice))
+	require.True(t, poolAfterSwap.FeeGrowthGlobalQuote.IsPositive())
+
+	// 5. the fees are earned by the position in range only
+	balances = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	_, err = handler(ctx, types.NewMsgCollectConcentratedFees(1, addr))
+	require.Nil(t, err)
+	coins = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.True(t, coins.AmountOf(quote).GT(balances.AmountOf(quote)))
+	position, _ = keeper.GetConcentratedPosition(ctx, 1)
+	require.True(t, position.TokensOwedQuote.IsZero())
+	position2, _ := keeper.GetConcentratedPosition(ctx, 2)
+	_, err = handler(ctx, types.NewMsgCollectConcentratedFees(2, addr2))
+	require.Nil(t, err)
+	require.True(t, position2.TokensOwedQuote.IsZero())
+
+	// 6. transfer the position, and the previous owner can't manage it any more
+	_, err = handler(ctx, types.NewMsgTransferConcentratedPosition(1, addr2, addr))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgCollectConcentratedFees(1, addr))
+	require.NotNil(t, err)
+	require.Equal(t, 2, len(keeper.GetConcentratedPositionsByOwner(ctx, addr2)))
+
+	// 7. remove all the liquidity of the position, and the position is closed
+	minBase, minQuote := sdk.NewDecCoinFromDec(base, sdk.ZeroDec()), sdk.NewDecCoinFromDec(quote, sdk.ZeroDec())
+	_, err = handler(ctx, types.NewMsgRemoveConcentratedLiquidity(1, position.Liquidity.Add(sdk.OneDec()), minBase, minQuote, deadline, addr2))
+	require.NotNil(t, err)
+	balances = mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	_, err = handler(ctx, types.NewMsgRemoveConcentratedLiquidity(1, position.Liquidity, minBase, minQuote, deadline, addr2))
+	require.Nil(t, err)
+	coins = mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	require.True(t, coins.AmountOf(base).GT(balances.AmountOf(base)))
+	require.True(t, coins.AmountOf(quote).GT(balances.AmountOf(quote)))
+	_, found = keeper.GetConcentratedPosition(ctx, 1)
+	require.False(t, found)
+	pool, _ = keeper.GetConcentratedPool(ctx, tokenPairName)
+	require.True(t, pool.Liquidity.IsZero())
+	_, found = keeper.GetConcentratedTick(ctx, tokenPairName, -600)
+	require.False(t, found)
+
+	// 8. the concentrated state is exported and imported with the genesis
+	genesis := ExportGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Equal(t, 1, len(genesis.ConcentratedPools))
+	require.Equal(t, 1, len(genesis.ConcentratedPositions))
+	require.Equal(t, 2, len(genesis.ConcentratedTicks))
+	require.Equal(t, uint64(3), genesis.NextConcentratedPositionID)
+}
@@ -0,0 +1,162 @@
+package ammswap
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/common"
+)
+
+func createStablePool(ctx sdk.Context, k Keeper, msg types.MsgCreateExchange, feeRate sdk.Dec) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	// 1. check if the extra tokens exist, and none of the token pairs in the pool has been pooled
+	for _, token := range msg.ExtraTokenNames {
+		if err := k.IsTokenExist(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+	pool := types.NewStablePool(msg.GetTokenNames(), feeRate, msg.Amplification)
+	for _, tokenPairName := range pool.TokenPairNames() {
+		if k.IsTokenPairPooled(ctx, tokenPairName) {
+			return types.ErrSwapTokenPairExist().Result()
+		}
+	}
+
+	// 2. create the pool token and the pool
+	if _, err := k.GetPoolTokenInfo(ctx, pool.PoolTokenName); err == nil {
+		return types.ErrPoolTokenPairExist().Result()
+	}
+	k.NewPoolToken(ctx, pool.PoolTokenName)
+	k.SetStablePool(ctx, pool)
+
+	event = event.AppendAttributes(sdk.NewAttribute("pool-type", types.PoolTypeStable))
+	event = event.AppendAttributes(sdk.NewAttribute("pool-token-name", pool.PoolTokenName))
+	event = event.AppendAttributes(sdk.NewAttribute("amplification", strconv.FormatInt(msg.Amplification, 10)))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func addStableLiquidity(ctx sdk.Context, k Keeper, pool types.StablePool, msg types.MsgAddLiquidity) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	// 1. all the tokens of the pool are deposited in the amounts, which are not required to be balanced
+	deposits := sdk.NewDecCoins(msg.GetAmounts()...)
+	amounts := make([]sdk.Dec, len(pool.PooledCoins))
+	for i, coin := range pool.PooledCoins {
+		amounts[i] = deposits.AmountOf(coin.Denom)
+	}
+	params := k.GetParams(ctx)
+	totalSupply := k.GetPoolTokenAmount(ctx, pool.PoolTokenName)
+	liquidity := types.CalculateStableLiquidityToMint(pool, amounts, totalSupply,
+		pool.GetAmplification(ctx.BlockTime().Unix()), pool.GetFeeRate(params))
+	if !liquidity.IsPositive() {
+		return types.ErrIsZeroValue("liquidity").Result()
+	}
+	if liquidity.LT(msg.MinLiquidity) {
+		return types.ErrLessThan("liquidity", "min liquidity").Result()
+	}
+
+	// 2. transfer coins
+	if err := k.SendCoinsToPool(ctx, deposits, msg.Sender); err != nil {
+		return types.ErrSendCoinsFailed(err).Result()
+	}
+	for i := range pool.PooledCoins {
+		pool.PooledCoins[i].Amount = pool.PooledCoins[i].Amount.Add(amounts[i])
+	}
+	k.SetStablePool(ctx, pool)
+
+	// 3. mint the pool token
+	poolCoins := sdk.NewDecCoinFromDec(pool.PoolTokenName, liquidity)
+	if err := k.MintPoolCoinsToUser(ctx, sdk.SysCoins{poolCoins}, msg.Sender); err != nil {
+		return types.ErrMintPoolTokenFailed(err).Result()
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute("liquidity", liquidity.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("amounts", deposits.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func removeStableLiquidity(ctx sdk.Context, k Keeper, pool types.StablePool, msg types.MsgRemoveLiquidity) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	liquidity := msg.Liquidity
+	poolTokenAmount := k.GetPoolTokenAmount(ctx, pool.PoolTokenName)
+	if poolTokenAmount.LT(liquidity) {
+		return types.ErrLessThan("pool token amount", "liquidity").Result()
+	}
+
+	// 1. the tokens are withdrawn in proportion to the pool
+	minAmounts := sdk.NewDecCoins(msg.GetMinAmounts()...)
+	withdrawals := make(sdk.SysCoins, len(pool.PooledCoins))
+	for i, coin := range pool.PooledCoins {
+		withdrawals[i] = sdk.NewDecCoinFromDec(coin.Denom, common.MulAndQuo(coin.Amount, liquidity, poolTokenAmount))
+		if withdrawals[i].Amount.LT(minAmounts.AmountOf(coin.Denom)) {
+			return types.ErrLessThan(coin.Denom+" amount", "min "+coin.Denom+" amount").Result()
+		}
+	}
+
+	// 2. transfer coins
+	if err := k.SendCoinsFromPoolToAccount(ctx, sdk.NewDecCoins(withdrawals...), msg.Sender); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+	for i := range pool.PooledCoins {
+		pool.PooledCoins[i].Amount = pool.PooledCoins[i].Amount.Sub(withdrawals[i].Amount)
+	}
+	k.SetStablePool(ctx, pool)
+
+	// 3. burn the pool token
+	poolCoins := sdk.NewDecCoinFromDec(pool.PoolTokenName, liquidity)
+	if err := k.BurnPoolCoinsFromUser(ctx, sdk.SysCoins{poolCoins}, msg.Sender); err != nil {
+		return types.ErrBurnPoolTokenFailed(err).Result()
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute("amounts", withdrawals.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func swapStableToken(ctx sdk.Context, k Keeper, pool types.StablePool, msg types.MsgTokenToToken) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	if !pool.HasLiquidity() {
+		return types.ErrIsZeroValue("pooled coins of the stable pool").Result()
+	}
+	tokenBuy, protocolFee := k.CalculateStableTokenToBuy(ctx, pool, msg.SoldTokenAmount, msg.MinBoughtTokenAmount.Denom,
+		k.GetParams(ctx))
+	if tokenBuy.IsZero() {
+		return types.ErrIsZeroValue("token buy").Result()
+	}
+	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+
+	// transfer coins, the protocol fee is taken out of the pool
+	if err := k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender); err != nil {
+		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
+	}
+	if err := k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{tokenBuy}, msg.Recipient); err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+	if err := k.CollectProtocolFee(ctx, protocolFee); err != nil {
+		return nil, err
+	}
+	i, j := pool.TokenIndex(msg.SoldTokenAmount.Denom), pool.TokenIndex(tokenBuy.Denom)
+	pool.PooledCoins[i].Amount = pool.PooledCoins[i].Amount.Add(msg.SoldTokenAmount.Amount)
+	pool.PooledCoins[j].Amount = pool.PooledCoins[j].Amount.Sub(tokenBuy.Amount).Sub(protocolFee.Amount)
+	k.SetStablePool(ctx, pool)
+
+	event = event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
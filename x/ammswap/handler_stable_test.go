@@ -0,0 +1,147 @@
+package ammswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	govTypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleStableSwap(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 2, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	params := types.DefaultParams()
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(2, 1)
+	mapp.swapKeeper.SetParams(ctx, params)
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr, addr2 := addrKeysSlice[0].Address, addrKeysSlice[1].Address
+	deadline := time.Now().Add(time.Minute).Unix()
+	tokens := []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestQuotePooledToken}
+	for _, symbol := range tokens {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	poolName := types.GetStablePoolName(tokens)
+
+	// 1. create the stable pool of 3 tokens, and no other pool can be created for the token pairs in it
+	_, err := handler(ctx, types.NewMsgCreateStableExchange(tokens, sdk.ZeroDec(), 100, addr))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgCreateExchange(types.TestBasePooledToken2, types.TestQuotePooledToken, addr))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgCreateStableExchange(tokens[:2], sdk.ZeroDec(), 100, addr))
+	require.NotNil(t, err)
+	pool, found := keeper.GetStablePool(ctx, poolName)
+	require.True(t, found)
+	require.False(t, pool.HasLiquidity())
+	_, found = keeper.GetStablePoolByTokenPair(ctx, types.GetSwapTokenPairName(types.TestBasePooledToken, types.TestQuotePooledToken))
+	require.True(t, found)
+
+	// 2. add liquidity with all the tokens by the add liquidity message
+	msgAdd := types.NewMsgAddLiquidity(sdk.ZeroDec(), sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000)), deadline, addr)
+	_, err = handler(ctx, msgAdd)
+	require.NotNil(t, err)
+	msgAdd.ExtraAmounts = sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(10000))}
+	_, err = handler(ctx, msgAdd)
+	require.Nil(t, err)
+	coins := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, sdk.NewDec(30000), coins.AmountOf(pool.PoolTokenName))
+	require.Equal(t, sdk.NewDec(90000), coins.AmountOf(types.TestBasePooledToken2))
+
+	// an imbalanced deposit mints less than the balanced one, and the min liquidity is checked
+	msgAdd.Sender = addr2
+	msgAdd.MinLiquidity = sdk.NewDec(21000)
+	msgAdd.ExtraAmounts = sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(1000))}
+	_, err = handler(ctx, msgAdd)
+	require.NotNil(t, err)
+	msgAdd.MinLiquidity = sdk.NewDec(29999)
+	msgAdd.ExtraAmounts = sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(10000))}
+	_, err = handler(ctx, msgAdd)
+	require.Nil(t, err)
+
+	// 3. swap between two of the tokens with the price close to 1
+	balances := mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	soldToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(100))
+	minBuyToken := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(99))
+	queryBuyAmount := types.QueryBuyAmountParams{SoldToken: soldToken, TokenToBuy: types.TestQuotePooledToken}
+	querier := NewQuerier(keeper)
+	_, err = querier(ctx, []string{types.QueryBuyAmount}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(queryBuyAmount)})
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgTokenToToken(soldToken, minBuyToken, deadline, addr2, addr2))
+	require.Nil(t, err)
+	coins = mapp.AccountKeeper.GetAccount(ctx, addr2).GetCoins()
+	require.Equal(t, balances.AmountOf(types.TestBasePooledToken2).Sub(soldToken.Amount), coins.AmountOf(types.TestBasePooledToken2))
+	require.True(t, coins.AmountOf(types.TestQuotePooledToken).Sub(balances.AmountOf(types.TestQuotePooledToken)).GTE(minBuyToken.Amount))
+	require.True(t, keeper.GetProtocolFees(ctx).AmountOf(types.TestQuotePooledToken).IsPositive())
+	pool, _ = keeper.GetStablePool(ctx, poolName)
+	require.Equal(t, sdk.NewDec(20100), pool.PooledCoins[pool.TokenIndex(types.TestBasePooledToken2)].Amount)
+
+	// 4. remove the liquidity in proportion by the remove liquidity message
+	minBase := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(1000))
+	minQuote := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(1000))
+	msgRemove := types.NewMsgRemoveLiquidity(sdk.NewDec(6000), minBase, minQuote, deadline, addr)
+	msgRemove.ExtraMinAmounts = sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(3000))}
+	_, err = handler(ctx, msgRemove)
+	require.NotNil(t, err)
+	balances = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	msgRemove.ExtraMinAmounts = sdk.SysCoins{sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(1000))}
+	_, err = handler(ctx, msgRemove)
+	require.Nil(t, err)
+	coins = mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+	require.Equal(t, balances.AmountOf(pool.PoolTokenName).Sub(sdk.NewDec(6000)), coins.AmountOf(pool.PoolTokenName))
+	require.True(t, coins.AmountOf(types.TestBasePooledToken2).Sub(balances.AmountOf(types.TestBasePooledToken2)).GT(sdk.NewDec(2000)))
+
+	// 5. the stable pool is exported and imported with the genesis
+	genesis := ExportGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Equal(t, 1, len(genesis.StablePools))
+}
+
+func TestRampAmplificationProposal(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	now := time.Now()
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(now)
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	handler := NewHandler(keeper)
+	proposalHandler := NewProposalHandler(&keeper)
+	tokens := []string{types.TestBasePooledToken, types.TestQuotePooledToken}
+	for _, symbol := range tokens {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	_, err := handler(ctx, types.NewMsgCreateStableExchange(tokens, sdk.ZeroDec(), 100, addrKeysSlice[0].Address))
+	require.Nil(t, err)
+	poolName := types.GetStablePoolName(tokens)
+
+	// the amplification can't be changed by more than 10 times
+	proposal := types.NewRampAmplificationProposal("title", "description", poolName, 1001, 86400)
+	msg := govTypes.NewMsgSubmitProposal(proposal, nil, addrKeysSlice[0].Address)
+	require.NotNil(t, keeper.CheckMsgSubmitProposal(ctx, msg))
+	proposal = types.NewRampAmplificationProposal("title", "description", "aab_ccb", 200, 86400)
+	msg = govTypes.NewMsgSubmitProposal(proposal, nil, addrKeysSlice[0].Address)
+	require.NotNil(t, keeper.CheckMsgSubmitProposal(ctx, msg))
+
+	// the amplification can't be ramped in less than a day
+	proposal = types.NewRampAmplificationProposal("title", "description", poolName, 200, 3600)
+	require.NotNil(t, proposal.ValidateBasic())
+	require.NotNil(t, proposalHandler(ctx, &govTypes.Proposal{Content: proposal}))
+
+	// ramp the amplification to 200 in a day
+	proposal = types.NewRampAmplificationProposal("title", "description", poolName, 200, 86400)
+	msg = govTypes.NewMsgSubmitProposal(proposal, nil, addrKeysSlice[0].Address)
+	require.Nil(t, keeper.CheckMsgSubmitProposal(ctx, msg))
+	require.Nil(t, proposalHandler(ctx, &govTypes.Proposal{Content: proposal}))
+	pool, _ := keeper.GetStablePool(ctx, poolName)
+	require.Equal(t, int64(100), pool.GetAmplification(now.Unix()))
+	require.Equal(t, int64(150), pool.GetAmplification(now.Unix()+43200))
+	require.Equal(t, int64(200), pool.GetAmplification(now.Unix()+86400))
+}
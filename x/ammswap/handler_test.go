@@ -87,6 +87,41 @@ func TestHandleMsgCreateExchange(t *testing.T) {
 	}
 }
 
+func TestHandleMsgCreateExchangeWithFeeRate(t *testing.T) {
+	mapp, addrKeysSlice := getMockApp(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+
+	for _, symbol := range []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestQuotePooledToken} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+
+	// the fee rate must be one of the fee tiers
+	msg := types.NewMsgCreateExchangeWithFeeRate(types.TestBasePooledToken, types.TestQuotePooledToken, sdk.NewDecWithPrec(2, 3), addr)
+	_, err := handler(ctx, msg)
+	require.NotNil(t, err)
+
+	stableFeeRate := sdk.NewDecWithPrec(5, 4)
+	msg = types.NewMsgCreateExchangeWithFeeRate(types.TestBasePooledToken, types.TestQuotePooledToken, stableFeeRate, addr)
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	swapTokenPair, err := keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(types.TestBasePooledToken, types.TestQuotePooledToken))
+	require.Nil(t, err)
+	require.Equal(t, stableFeeRate, swapTokenPair.GetFeeRate(keeper.GetParams(ctx)))
+
+	// the pair created without the fee rate follows the default fee rate
+	_, err = handler(ctx, types.NewMsgCreateExchange(types.TestBasePooledToken2, types.TestQuotePooledToken, addr))
+	require.Nil(t, err)
+	swapTokenPair, err = keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(types.TestBasePooledToken2, types.TestQuotePooledToken))
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams().FeeRate, swapTokenPair.GetFeeRate(keeper.GetParams(ctx)))
+}
+
 func TestHandleMsgAddLiquidity(t *testing.T) {
 	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
 	keeper := mapp.swapKeeper
@@ -454,6 +489,138 @@ func TestHandleMsgTokenToTokenDirectly(t *testing.T) {
 	}
 }
 
+func TestHandleMsgTokenToTokenByRoute(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	deadLine := time.Now().Unix()
+
+	// aab_okt, ccb_okt and ccb_ddb
+	pools := [][2]string{
+		{types.TestBasePooledToken, types.TestQuotePooledToken},
+		{types.TestBasePooledToken2, types.TestQuotePooledToken},
+		{types.TestBasePooledToken2, types.TestBasePooledToken3},
+	}
+	for _, symbol := range []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestBasePooledToken3, types.TestQuotePooledToken} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	for _, pool := range pools {
+		_, err := handler(ctx, types.NewMsgCreateExchange(pool[0], pool[1], addr))
+		require.Nil(t, err)
+		maxBaseAmount := sdk.NewDecCoinFromDec(pool[0], sdk.NewDec(10000))
+		quoteAmount := sdk.NewDecCoinFromDec(pool[1], sdk.NewDec(10000))
+		_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), maxBaseAmount, quoteAmount, deadLine, addr))
+		require.Nil(t, err)
+	}
+
+	route := []string{types.TestQuotePooledToken, types.TestBasePooledToken2}
+	path := []string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2, types.TestBasePooledToken3}
+	soldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10))
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken3, sdk.NewDec(1))
+	insufficientSoldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(1000000))
+	invalidMinBoughtTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken3, sdk.NewDec(10))
+	tests := []struct {
+		testCase             string
+		soldTokenAmount      sdk.SysCoin
+		minBoughtTokenAmount sdk.SysCoin
+		route                []string
+		deadLine             int64
+		exceptResultCode     uint32
+	}{
+		{"blockTime exceeded deadline", soldTokenAmount, minBoughtTokenAmount, route, 0, sdk.CodeInternal},
+		{"insufficient SoldTokenAmount", insufficientSoldTokenAmount, minBoughtTokenAmount, route, deadLine, sdk.CodeInsufficientCoins},
+		{"unknown swapTokenPair", soldTokenAmount, minBoughtTokenAmount, []string{types.TestQuotePooledToken}, deadLine, sdk.CodeInternal},
+		{"The available BoughtTokenAmount are less than minBoughtTokenAmount", soldTokenAmount, invalidMinBoughtTokenAmount, route, deadLine, sdk.CodeInternal},
+		{"success", soldTokenAmount, minBoughtTokenAmount, route, deadLine, sdk.CodeOK},
+	}
+
+	for _, testCase := range tests {
+		fmt.Println(testCase.testCase)
+		amounts, _ := keeper.GetSwapRouteAmountsOut(ctx, testCase.soldTokenAmount, path)
+		balances := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+		msg := types.NewMsgTokenToTokenByRoute(testCase.soldTokenAmount, testCase.minBoughtTokenAmount, testCase.route, testCase.deadLine, addr, addr)
+		_, err := handler(ctx, msg)
+		testCode(t, err, testCase.exceptResultCode)
+		if err == nil {
+			coins := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+			require.Equal(t, balances.AmountOf(types.TestBasePooledToken).Sub(soldTokenAmount.Amount), coins.AmountOf(types.TestBasePooledToken))
+			require.Equal(t, balances.AmountOf(types.TestBasePooledToken3).Add(amounts[3].Amount), coins.AmountOf(types.TestBasePooledToken3))
+			require.Equal(t, balances.AmountOf(types.TestQuotePooledToken), coins.AmountOf(types.TestQuotePooledToken))
+			require.Equal(t, balances.AmountOf(types.TestBasePooledToken2), coins.AmountOf(types.TestBasePooledToken2))
+			for i := 1; i < len(path); i++ {
+				swapTokenPair, err := keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(path[i-1], path[i]))
+				require.Nil(t, err)
+				require.Equal(t, sdk.NewDec(10000).Add(amounts[i-1].Amount), swapTokenPair.PooledCoin(path[i-1]).Amount)
+				require.Equal(t, sdk.NewDec(10000).Sub(amounts[i].Amount), swapTokenPair.PooledCoin(path[i]).Amount)
+			}
+		}
+	}
+}
+
+func TestHandleMsgTokenToExactToken(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 1, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	mapp.swapKeeper.SetParams(ctx, types.DefaultParams())
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	deadLine := time.Now().Unix()
+
+	// aab_okt and ccb_okt
+	for _, symbol := range []string{types.TestBasePooledToken, types.TestBasePooledToken2, types.TestQuotePooledToken} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	for _, base := range []string{types.TestBasePooledToken, types.TestBasePooledToken2} {
+		_, err := handler(ctx, types.NewMsgCreateExchange(base, types.TestQuotePooledToken, addr))
+		require.Nil(t, err)
+		maxBaseAmount := sdk.NewDecCoinFromDec(base, sdk.NewDec(10000))
+		quoteAmount := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000))
+		_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), maxBaseAmount, quoteAmount, deadLine, addr))
+		require.Nil(t, err)
+	}
+
+	route := []string{types.TestQuotePooledToken}
+	path := []string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2}
+	maxSoldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(20))
+	boughtTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(10))
+	invalidMaxSoldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10))
+	insufficientBoughtTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(10000))
+	tests := []struct {
+		testCase           string
+		maxSoldTokenAmount sdk.SysCoin
+		boughtTokenAmount  sdk.SysCoin
+		deadLine           int64
+		exceptResultCode   uint32
+	}{
+		{"blockTime exceeded deadline", maxSoldTokenAmount, boughtTokenAmount, 0, sdk.CodeInternal},
+		{"The required SoldTokenAmount are greater than maxSoldTokenAmount", invalidMaxSoldTokenAmount, boughtTokenAmount, deadLine, sdk.CodeInternal},
+		{"insufficient pool liquidity", maxSoldTokenAmount, insufficientBoughtTokenAmount, deadLine, sdk.CodeInternal},
+		{"success", maxSoldTokenAmount, boughtTokenAmount, deadLine, sdk.CodeOK},
+	}
+
+	for _, testCase := range tests {
+		fmt.Println(testCase.testCase)
+		amounts, _ := keeper.GetSwapRouteAmountsIn(ctx, testCase.boughtTokenAmount, path)
+		balances := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+		msg := types.NewMsgTokenToExactToken(testCase.maxSoldTokenAmount, testCase.boughtTokenAmount, route, testCase.deadLine, addr, addr)
+		_, err := handler(ctx, msg)
+		testCode(t, err, testCase.exceptResultCode)
+		if err == nil {
+			coins := mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+			require.Equal(t, balances.AmountOf(types.TestBasePooledToken).Sub(amounts[0].Amount), coins.AmountOf(types.TestBasePooledToken))
+			require.Equal(t, balances.AmountOf(types.TestBasePooledToken2).Add(boughtTokenAmount.Amount), coins.AmountOf(types.TestBasePooledToken2))
+			require.Equal(t, balances.AmountOf(types.TestQuotePooledToken), coins.AmountOf(types.TestQuotePooledToken))
+		}
+	}
+}
+
 func TestGetInputPrice(t *testing.T) {
 	tests := []struct {
 		testCase           string
@@ -67,9 +67,10 @@ func getTestInputWithBalance(t *testing.T, numGenAccs int, balance int64) (mockA
 		blacklistedAddrs)
 
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName: nil,
-		token.ModuleName:      {supply.Minter, supply.Burner},
-		types.ModuleName:      {supply.Minter, supply.Burner},
+		auth.FeeCollectorName:    nil,
+		token.ModuleName:         {supply.Minter, supply.Burner},
+		types.ModuleName:         {supply.Minter, supply.Burner},
+		types.ProtocolFeeAccount: nil,
 	}
 	mockApp.supplyKeeper = supply.NewKeeper(mockApp.Cdc, mockApp.keySupply, mockApp.AccountKeeper,
 		mockApp.bankKeeper, maccPerms)
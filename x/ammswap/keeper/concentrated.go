@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// GetConcentratedPool gets the concentrated pool of the token pair
+func (k Keeper) GetConcentratedPool(ctx sdk.Context, tokenPairName string) (pool types.ConcentratedPool, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetConcentratedPoolKey(tokenPairName))
+	if bz == nil {
+		return pool, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pool)
+	return pool, true
+}
+
+// SetConcentratedPool sets the concentrated pool of the token pair
+func (k Keeper) SetConcentratedPool(ctx sdk.Context, pool types.ConcentratedPool) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pool)
+	ctx.KVStore(k.storeKey).Set(types.GetConcentratedPoolKey(pool.TokenPairName()), bz)
+}
+
+// GetConcentratedPools returns all the concentrated pools
+func (k Keeper) GetConcentratedPools(ctx sdk.Context) (pools []types.ConcentratedPool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConcentratedPoolPrefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.ConcentratedPool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// GetConcentratedTick gets the initialized tick of the concentrated pool
+func (k Keeper) GetConcentratedTick(ctx sdk.Context, tokenPairName string, index int64) (tick types.ConcentratedTick, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetConcentratedTickKey(tokenPairName, index))
+	if bz == nil {
+		return types.NewConcentratedTick(tokenPairName, index), false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tick)
+	return tick, true
+}
+
+// SetConcentratedTick sets the tick of the concentrated pool, the tick is deleted if no position is bounded by it
+func (k Keeper) SetConcentratedTick(ctx sdk.Context, tick types.ConcentratedTick) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetConcentratedTickKey(tick.TokenPairName, tick.Index)
+	if tick.LiquidityGross.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(tick))
+}
+
+// GetConcentratedTicks returns all the initialized ticks of all the concentrated pools
+func (k Keeper) GetConcentratedTicks(ctx sdk.Context) (ticks []types.ConcentratedTick) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConcentratedTickPrefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var tick types.ConcentratedTick
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tick)
+		ticks = append(ticks, tick)
+	}
+	return ticks
+}
+
+// nextInitializedTick returns the nearest initialized tick not greater than the tick if it searches downward,
+// otherwise the nearest initialized tick greater than the tick
+func (k Keeper) nextInitializedTick(ctx sdk.Context, tokenPairName string, tick int64, downward bool) (
+	next types.ConcentratedTick, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetConcentratedTickPrefix(tokenPairName))
+	var iterator sdk.Iterator
+	if downward {
+		iterator = store.ReverseIterator(nil, types.TickToBytes(tick+1))
+	} else {
+		iterator = store.Iterator(types.TickToBytes(tick+1), nil)
+	}
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return next, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &next)
+	return next, true
+}
+
+// GetConcentratedPosition gets the concentrated position by id
+func (k Keeper) GetConcentratedPosition(ctx sdk.Context, id uint64) (position types.ConcentratedPosition, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetConcentratedPositionKey(id))
+	if bz == nil {
+		return position, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &position)
+	return position, true
+}
+
+// SetConcentratedPosition sets the concentrated position
+func (k Keeper) SetConcentratedPosition(ctx sdk.Context, position types.ConcentratedPosition) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(position)
+	ctx.KVStore(k.storeKey).Set(types.GetConcentratedPositionKey(position.ID), bz)
+}
+
+// DeleteConcentratedPosition deletes the concentrated position
+func (k Keeper) DeleteConcentratedPosition(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetConcentratedPositionKey(id))
+}
+
+// IterateConcentratedPositions iterates over all the concentrated positions in the order of the ids
+func (k Keeper) IterateConcentratedPositions(ctx sdk.Context, cb func(position types.ConcentratedPosition) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConcentratedPositionPrefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var position types.ConcentratedPosition
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &position)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// GetConcentratedPositions returns all the concentrated positions
+func (k Keeper) GetConcentratedPositions(ctx sdk.Context) (positions []types.ConcentratedPosition) {
+	k.IterateConcentratedPositions(ctx, func(position types.ConcentratedPosition) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return positions
+}
+
+// GetConcentratedPositionsByOwner returns the concentrated positions owned by the address
+func (k Keeper) GetConcentratedPositionsByOwner(ctx sdk.Context, owner sdk.AccAddress) (positions []types.ConcentratedPosition) {
+	k.IterateConcentratedPositions(ctx, func(position types.ConcentratedPosition) bool {
+		if position.Owner.Equals(owner) {
+			positions = append(positions, position)
+		}
+		return false
+	})
+	return positions
+}
+
+// GetNextConcentratedPositionID returns the id of the next concentrated position, which starts from 1
+func (k Keeper) GetNextConcentratedPositionID(ctx sdk.Context) (id uint64) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextConcentratedPositionIDKey)
+	if bz == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// SetNextConcentratedPositionID sets the id of the next concentrated position
+func (k Keeper) SetNextConcentratedPositionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextConcentratedPositionIDKey, k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
+
+// ModifyConcentratedPosition adds the liquidity delta to the position, and returns the amounts of the tokens deposited
+// into the pool if the delta is positive or withdrawn from the pool if it's negative. The fees earned by the position
+// are accrued to its tokens owed, and the pool, the ticks and the position are updated in the store.
+func (k Keeper) ModifyConcentratedPosition(ctx sdk.Context, pool *types.ConcentratedPool,
+	position *types.ConcentratedPosition, liquidityDelta sdk.Dec) (base, quote sdk.Dec) {
+	lower := k.updateConcentratedTick(ctx, *pool, position.LowerTick, liquidityDelta, false)
+	upper := k.updateConcentratedTick(ctx, *pool, position.UpperTick, liquidityDelta, true)
+
+	feeGrowthInsideBase, feeGrowthInsideQuote := pool.FeeGrowthInside(lower, upper)
+	position.AccrueFees(feeGrowthInsideBase, feeGrowthInsideQuote)
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+
+	k.SetConcentratedTick(ctx, lower)
+	k.SetConcentratedTick(ctx, upper)
+
+	// the liquidity is deposited with the amounts rounded up, and withdrawn with the amounts truncated
+	roundUp := liquidityDelta.IsPositive()
+	base, quote = types.GetAmountsForLiquidity(pool.SqrtPrice, types.TickToSqrtPrice(position.LowerTick),
+		types.TickToSqrtPrice(position.UpperTick), liquidityDelta.Abs(), roundUp)
+	if pool.CurrentTick >= position.LowerTick && pool.CurrentTick < position.UpperTick {
+		pool.Liquidity = pool.Liquidity.Add(liquidityDelta)
+	}
+	if roundUp {
+		pool.BasePooledCoin.Amount = pool.BasePooledCoin.Amount.Add(base)
+		pool.QuotePooledCoin.Amount = pool.QuotePooledCoin.Amount.Add(quote)
+	} else {
+		pool.BasePooledCoin.Amount = pool.BasePooledCoin.Amount.Sub(base)
+		pool.QuotePooledCoin.Amount = pool.QuotePooledCoin.Amount.Sub(quote)
+	}
+	k.SetConcentratedPool(ctx, *pool)
+	return base, quote
+}
+
+// updateConcentratedTick adds the liquidity delta of the position bounded by the tick. The fee growths outside are
+// initialized once the tick is initialized, by assuming all the fees were earned below the tick.
+func (k Keeper) updateConcentratedTick(ctx sdk.Context, pool types.ConcentratedPool, index int64,
+	liquidityDelta sdk.Dec, upper bool) types.ConcentratedTick {
+	tick, found := k.GetConcentratedTick(ctx, pool.TokenPairName(), index)
+	if !found && index <= pool.CurrentTick {
+		tick.FeeGrowthOutsideBase = pool.FeeGrowthGlobalBase
+		tick.FeeGrowthOutsideQuote = pool.FeeGrowthGlobalQuote
+	}
+	tick.LiquidityGross = tick.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tick.LiquidityNet = tick.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tick.LiquidityNet = tick.LiquidityNet.Add(liquidityDelta)
+	}
+	return tick
+}
+
+// ConcentratedSwapResult is the result of a swap in the concentrated pool, which isn't written into the store
+type ConcentratedSwapResult struct {
+	Pool         types.ConcentratedPool
+	CrossedTicks []types.ConcentratedTick
+	TokenBuy     sdk.SysCoin
+	ProtocolFee  sdk.SysCoin
+}
+
+// CalculateConcentratedSwap swaps the token sold in the concentrated pool tick by tick, until the amount sold is used
+// up. The fees of each step are shared by the liquidity in range, after the protocol fee is taken out of them.
+func (k Keeper) CalculateConcentratedSwap(ctx sdk.Context, pool types.ConcentratedPool, sellToken sdk.SysCoin,
+	params types.Params) (result ConcentratedSwapResult, err sdk.Error) {
+	sellBase := sellToken.Denom == pool.BasePooledCoin.Denom
+	if !sellBase && sellToken.Denom != pool.QuotePooledCoin.Denom {
+		return result, types.ErrInvalidCoins()
+	}
+	feeRate := pool.GetFeeRate(params)
+	amountRemaining := sellToken.Amount
+	amountOut, protocolFee := sdk.ZeroDec(), sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		next, found := k.nextInitializedTick(ctx, pool.TokenPairName(), pool.CurrentTick, sellBase)
+		if !found {
+			// the price moves to the bound of the ticks with no liquidity
+			break
+		}
+		step := types.ComputeSwapStep(pool.SqrtPrice, types.TickToSqrtPrice(next.Index), pool.Liquidity,
+			amountRemaining, feeRate)
+		amountRemaining = amountRemaining.Sub(step.AmountIn).Sub(step.FeeAmount)
+		amountOut = amountOut.Add(step.AmountOut)
+
+		// the fee growth is accrued to the liquidity in range
+		stepProtocolFee := step.FeeAmount.MulTruncate(params.ProtocolFeeRate)
+		protocolFee = protocolFee.Add(stepProtocolFee)
+		if pool.Liquidity.IsPositive() {
+			feeGrowth := step.FeeAmount.Sub(stepProtocolFee).QuoTruncate(pool.Liquidity)
+			if sellBase {
+				pool.FeeGrowthGlobalBase = pool.FeeGrowthGlobalBase.Add(feeGrowth)
+			} else {
+				pool.FeeGrowthGlobalQuote = pool.FeeGrowthGlobalQuote.Add(feeGrowth)
+			}
+		}
+		if sellBase {
+			pool.BasePooledCoin.Amount = pool.BasePooledCoin.Amount.Add(step.AmountIn)
+			pool.QuotePooledCoin.Amount = pool.QuotePooledCoin.Amount.Sub(step.AmountOut)
+		} else {
+			pool.QuotePooledCoin.Amount = pool.QuotePooledCoin.Amount.Add(step.AmountIn)
+			pool.BasePooledCoin.Amount = pool.BasePooledCoin.Amount.Sub(step.AmountOut)
+		}
+		pool.SqrtPrice = step.SqrtPriceNext
+
+		if !step.SqrtPriceNext.Equal(types.TickToSqrtPrice(next.Index)) {
+			pool.CurrentTick = types.SqrtPriceToTick(pool.SqrtPrice)
+			continue
+		}
+		// cross the tick, whose fee growths outside are flipped to the other side
+		next.FeeGrowthOutsideBase = pool.FeeGrowthGlobalBase.Sub(next.FeeGrowthOutsideBase)
+		next.FeeGrowthOutsideQuote = pool.FeeGrowthGlobalQuote.Sub(next.FeeGrowthOutsideQuote)
+		result.CrossedTicks = append(result.CrossedTicks, next)
+		if sellBase {
+			pool.Liquidity = pool.Liquidity.Sub(next.LiquidityNet)
+			pool.CurrentTick = next.Index - 1
+		} else {
+			pool.Liquidity = pool.Liquidity.Add(next.LiquidityNet)
+			pool.CurrentTick = next.Index
+		}
+	}
+	if amountRemaining.IsPositive() || pool.BasePooledCoin.IsNegative() || pool.QuotePooledCoin.IsNegative() {
+		return result, types.ErrInsufficientPoolLiquidity(pool.TokenPairName())
+	}
+
+	buyDenom := pool.QuotePooledCoin.Denom
+	if !sellBase {
+		buyDenom = pool.BasePooledCoin.Denom
+	}
+	result.Pool = pool
+	result.TokenBuy = sdk.NewDecCoinFromDec(buyDenom, amountOut)
+	result.ProtocolFee = sdk.NewDecCoinFromDec(sellToken.Denom, protocolFee)
+	return result, nil
+}
+
+// ApplyConcentratedSwap writes the result of the swap into the store
+func (k Keeper) ApplyConcentratedSwap(ctx sdk.Context, result ConcentratedSwapResult) {
+	for _, tick := range result.CrossedTicks {
+		k.SetConcentratedTick(ctx, tick)
+	}
+	k.SetConcentratedPool(ctx, result.Pool)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// CollectProtocolFee moves the protocol fee of a swap from the pool to the protocol fee account
+func (k Keeper) CollectProtocolFee(ctx sdk.Context, protocolFee sdk.SysCoin) error {
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ProtocolFeeAccount,
+		sdk.SysCoins{protocolFee})
+}
+
+// GetProtocolFees returns the protocol fees collected by the protocol fee account
+func (k Keeper) GetProtocolFees(ctx sdk.Context) sdk.SysCoins {
+	return k.tokenKeeper.GetCoins(ctx, supply.NewModuleAddress(types.ProtocolFeeAccount))
+}
+
+// WithdrawProtocolFee sends the collected protocol fees to the recipient
+func (k Keeper) WithdrawProtocolFee(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.SysCoins) sdk.Error {
+	if err := k.CheckWithdrawProtocolFeeProposal(ctx, types.WithdrawProtocolFeeProposal{Amount: amount}); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ProtocolFeeAccount, recipient, amount); err != nil {
+		return types.ErrWithdrawProtocolFeeFailed(err.Error())
+	}
+	return nil
+}
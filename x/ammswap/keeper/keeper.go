@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/okex/exchain/x/common"
 
@@ -21,6 +22,7 @@ type Keeper struct {
 	storeKey       sdk.StoreKey
 	cdc            *codec.Codec
 	paramSpace     types.ParamSubspace
+	govKeeper      types.GovKeeper
 	ObserverKeeper []types.BackendKeeper
 }
 
@@ -58,8 +60,17 @@ func (k Keeper) GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (types.S
 	return item, nil
 }
 
-// SetSwapTokenPair sets the entire SwapTokenPair data struct for a quote token name
+// SetSwapTokenPair sets the entire SwapTokenPair data struct for a quote token name, and records its prices
 func (k Keeper) SetSwapTokenPair(ctx sdk.Context, tokenPairName string, swapTokenPair types.SwapTokenPair) {
+	k.updateSwapPriceObservation(ctx, tokenPairName, swapTokenPair)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(swapTokenPair)
+	store.Set(types.GetTokenPairKey(tokenPairName), bz)
+}
+
+// InitSwapTokenPair sets the SwapTokenPair from the genesis state, whose prices are recorded by the price
+// observations of the genesis state
+func (k Keeper) InitSwapTokenPair(ctx sdk.Context, tokenPairName string, swapTokenPair types.SwapTokenPair) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(swapTokenPair)
 	store.Set(types.GetTokenPairKey(tokenPairName), bz)
@@ -128,11 +139,18 @@ func (k Keeper) BurnPoolCoinsFromUser(ctx sdk.Context, coins sdk.SysCoins, addr
 
 // SendCoinsToPool sends coins from user account to module account
 func (k Keeper) SendCoinsToPool(ctx sdk.Context, coins sdk.SysCoins, addr sdk.AccAddress) error {
+	if err := k.tokenKeeper.CheckCoinsTransferable(ctx, addr, coins); err != nil {
+		return err
+	}
 	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins)
 }
 
-// SendCoinsFromPoolToAccount sends coins from module account to user account
+// SendCoinsFromPoolToAccount sends coins from module account to user account, the coins of a paused token or to a
+// frozen address are not paid out
 func (k Keeper) SendCoinsFromPoolToAccount(ctx sdk.Context, coins sdk.SysCoins, addr sdk.AccAddress) error {
+	if err := k.tokenKeeper.CheckCoinsTransferable(ctx, addr, coins); err != nil {
+		return err
+	}
 	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
 }
 
@@ -143,7 +161,8 @@ func (k Keeper) GetTokenKeeper() types.TokenKeeper {
 
 // GetParams gets inflation params from the global param store
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	common.GetParamSetWithDefaults(ctx, k.paramSpace, &params, types.OptionalParamKeys...)
 	return params
 }
 
@@ -184,7 +203,7 @@ func CalculateTokenToBuy(swapTokenPair types.SwapTokenPair, sellToken sdk.SysCoi
 		inputReserve = swapTokenPair.BasePooledCoin.Amount
 		outputReserve = swapTokenPair.QuotePooledCoin.Amount
 	}
-	tokenBuyAmt := GetInputPrice(sellToken.Amount, inputReserve, outputReserve, params.FeeRate)
+	tokenBuyAmt := GetInputPrice(sellToken.Amount, inputReserve, outputReserve, swapTokenPair.GetFeeRate(params))
 	tokenBuy := sdk.NewDecCoinFromDec(buyTokenDenom, tokenBuyAmt)
 
 	return tokenBuy
@@ -196,6 +215,42 @@ func GetInputPrice(inputAmount, inputReserve, outputReserve, feeRate sdk.Dec) sd
 	return common.MulAndQuo(inputAmountWithFee, outputReserve, denominator)
 }
 
+// CalculateTokenToSell calculates the amount of the token to sell for buying the amount of the other token of the
+// swap token pair, the amount to buy must be less than the pooled amount of the token
+func CalculateTokenToSell(swapTokenPair types.SwapTokenPair, buyToken sdk.SysCoin, sellTokenDenom string, params types.Params) sdk.SysCoin {
+	var inputReserve, outputReserve sdk.Dec
+	if buyToken.Denom < sellTokenDenom {
+		inputReserve = swapTokenPair.QuotePooledCoin.Amount
+		outputReserve = swapTokenPair.BasePooledCoin.Amount
+	} else {
+		inputReserve = swapTokenPair.BasePooledCoin.Amount
+		outputReserve = swapTokenPair.QuotePooledCoin.Amount
+	}
+	tokenSellAmt := GetOutputPrice(buyToken.Amount, inputReserve, outputReserve, swapTokenPair.GetFeeRate(params))
+	return sdk.NewDecCoinFromDec(sellTokenDenom, tokenSellAmt)
+}
+
+// GetOutputPrice returns the input amount to buy the output amount, which is rounded up so that GetInputPrice of
+// it is not less than the output amount
+func GetOutputPrice(outputAmount, inputReserve, outputReserve, feeRate sdk.Dec) sdk.Dec {
+	inputAmountWithFee := mulAndQuoRoundUp(outputAmount, inputReserve.MulTruncate(sdk.NewDec(1000)), outputReserve.Sub(outputAmount))
+	return mulAndQuoRoundUp(inputAmountWithFee, sdk.OneDec(), sdk.OneDec().Sub(feeRate).MulTruncate(sdk.NewDec(1000)))
+}
+
+// mulAndQuoRoundUp returns a * b / c rounded up in the precision of sdk.Dec
+func mulAndQuoRoundUp(a, b, c sdk.Dec) sdk.Dec {
+	quo, rem := new(big.Int).QuoRem(new(big.Int).Mul(a.BigInt(), b.BigInt()), c.BigInt(), new(big.Int))
+	if rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdk.NewDecFromBigIntWithPrec(quo, sdk.Precision)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
 func (k *Keeper) SetObserverKeeper(bk types.BackendKeeper) {
 	k.ObserverKeeper = append(k.ObserverKeeper, bk)
 }
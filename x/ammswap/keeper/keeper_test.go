@@ -6,6 +6,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
 	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/params"
 	"github.com/stretchr/testify/require"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 )
@@ -41,6 +42,24 @@ func TestKeeper_GetPoolTokenInfo(t *testing.T) {
 	require.NotNil(t, balance)
 }
 
+func TestKeeper_GetParams(t *testing.T) {
+	mapp, _ := GetTestInput(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+
+	// the params added after the genesis are the defaults until set
+	feeRate := sdk.NewDecWithPrec(2, 3)
+	keeper.paramSpace.(params.Subspace).Set(ctx, types.KeyFeeRate, feeRate)
+	expected := types.DefaultParams()
+	expected.FeeRate = feeRate
+	require.Equal(t, expected, keeper.GetParams(ctx))
+
+	expected.ProtocolFeeRate = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, expected)
+	require.Equal(t, expected, keeper.GetParams(ctx))
+}
+
 func TestKeeper_GetSwapTokenPairs(t *testing.T) {
 	mapp, _ := GetTestInput(t, 1)
 	keeper := mapp.swapKeeper
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	sdkGov "github.com/okex/exchain/x/gov"
+	govKeeper "github.com/okex/exchain/x/gov/keeper"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	switch content.(type) {
+	case types.WithdrawProtocolFeeProposal, types.RampAmplificationProposal:
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	switch content.(type) {
+	case types.WithdrawProtocolFeeProposal, types.RampAmplificationProposal:
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	switch content.(type) {
+	case types.WithdrawProtocolFeeProposal, types.RampAmplificationProposal:
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.WithdrawProtocolFeeProposal:
+		return k.CheckWithdrawProtocolFeeProposal(ctx, content)
+	case types.RampAmplificationProposal:
+		return k.CheckRampAmplificationProposal(ctx, content)
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized swap proposal content type: %T", content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// CheckWithdrawProtocolFeeProposal checks that the protocol fees to withdraw have been collected
+func (k Keeper) CheckWithdrawProtocolFeeProposal(ctx sdk.Context, proposal types.WithdrawProtocolFeeProposal) sdk.Error {
+	if !k.GetProtocolFees(ctx).IsAllGTE(proposal.Amount) {
+		return types.ErrWithdrawProtocolFeeFailed("insufficient protocol fees")
+	}
+	return nil
+}
+
+// CheckRampAmplificationProposal checks that the stable pool exists and the amplification change is acceptable
+func (k Keeper) CheckRampAmplificationProposal(ctx sdk.Context, proposal types.RampAmplificationProposal) sdk.Error {
+	pool, found := k.GetStablePool(ctx, proposal.PoolName)
+	if !found {
+		return types.ErrNonExistStablePool(proposal.PoolName)
+	}
+	return types.ValidateAmplificationChange(pool.GetAmplification(ctx.BlockTime().Unix()), proposal.FutureAmplification)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/okex/exchain/x/common"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
@@ -28,6 +29,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			res, err = querySwapQuoteInfo(ctx, req, k)
 		case types.QuerySwapAddLiquidityQuote:
 			res, err = querySwapAddLiquidityQuote(ctx, req, k)
+		case types.QuerySwapRoute:
+			res, err = querySwapRoute(ctx, req, k)
+		case types.QuerySwapRouteExactOutput:
+			res, err = querySwapRouteExactOutput(ctx, req, k)
+		case types.QuerySwapTWAP:
+			res, err = querySwapTWAP(ctx, req, k)
+		case types.QueryProtocolFees:
+			res, err = queryProtocolFees(ctx, k)
+		case types.QueryConcentratedPool:
+			res, err = queryConcentratedPool(ctx, path[1:], k)
+		case types.QueryConcentratedPosition:
+			res, err = queryConcentratedPosition(ctx, path[1:], k)
+		case types.QueryConcentratedPositions:
+			res, err = queryConcentratedPositions(ctx, path[1:], k)
+		case types.QueryStablePool:
+			res, err = queryStablePool(ctx, path[1:], k)
 
 		default:
 			return nil, types.ErrSwapUnknownQueryType()
@@ -88,7 +105,19 @@ func queryBuyAmount(
 	var buyAmount sdk.Dec
 	swapTokenPair := types.GetSwapTokenPairName(queryParams.SoldToken.Denom, queryParams.TokenToBuy)
 	tokenPair, errTokenPair := keeper.GetSwapTokenPair(ctx, swapTokenPair)
-	if errTokenPair == nil {
+	if pool, found := keeper.GetConcentratedPool(ctx, swapTokenPair); found {
+		result, err := keeper.CalculateConcentratedSwap(ctx, pool, queryParams.SoldToken, params)
+		if err != nil {
+			return nil, err
+		}
+		buyAmount = result.TokenBuy.Amount
+	} else if pool, found := keeper.GetStablePoolByTokenPair(ctx, swapTokenPair); found {
+		if !pool.HasLiquidity() {
+			return nil, types.ErrIsZeroValue("pooled coins of the stable pool")
+		}
+		tokenBuy, _ := keeper.CalculateStableTokenToBuy(ctx, pool, queryParams.SoldToken, queryParams.TokenToBuy, params)
+		buyAmount = tokenBuy.Amount
+	} else if errTokenPair == nil {
 		if tokenPair.BasePooledCoin.IsZero() || tokenPair.QuotePooledCoin.IsZero() {
 			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
 		}
@@ -192,7 +221,7 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 			marketPrice = tokenPair.BasePooledCoin.Amount.Quo(tokenPair.QuotePooledCoin.Amount)
 		}
 		// calculate fee
-		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
+		fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(tokenPair.GetFeeRate(swapParams)))
 	} else {
 		tokenPairName1 := types.GetSwapTokenPairName(sellAmount.Denom, common.NativeToken)
 		tokenPair1, err := keeper.GetSwapTokenPair(ctx, tokenPairName1)
@@ -231,8 +260,8 @@ func querySwapQuoteInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		}
 
 		// calculate fee
-		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(swapParams.FeeRate))
-		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(swapParams.FeeRate))
+		fee1 := sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(tokenPair1.GetFeeRate(swapParams)))
+		routeTokenFee := sdk.NewDecCoinFromDec(common.NativeToken, nativeToken.Amount.Mul(tokenPair2.GetFeeRate(swapParams)))
 		fee2 := CalculateTokenToBuy(tokenPair1, routeTokenFee, sellAmount.Denom, swapParams)
 		fee = fee1.Add(fee2)
 
@@ -328,3 +357,179 @@ func querySwapAddLiquidityQuote(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 
 }
+
+// querySwapRoute returns the best swap route to sell the exact amount of token
+func querySwapRoute(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QuerySwapBuyInfoParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if queryParams.SellTokenAmount == "" || queryParams.BuyToken == "" {
+		return nil, types.ErrSellAmountOrBuyTokenIsEmpty()
+	}
+
+	sellAmount, err := sdk.ParseDecCoin(queryParams.SellTokenAmount)
+	if err != nil {
+		return nil, types.ErrConvertSellTokenAmount(queryParams.SellTokenAmount, err)
+	}
+	if sellAmount.Denom == queryParams.BuyToken {
+		return nil, types.ErrSellAmountEqualBuyToken()
+	}
+
+	swapRoute, err := keeper.GetBestSwapRoute(ctx, sellAmount, queryParams.BuyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	response := common.GetBaseResponse(swapRoute)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// querySwapRouteExactOutput returns the best swap route to buy the exact amount of token
+func querySwapRouteExactOutput(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QuerySwapSellInfoParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if queryParams.BuyTokenAmount == "" || queryParams.SellToken == "" {
+		return nil, types.ErrSellAmountOrBuyTokenIsEmpty()
+	}
+
+	buyAmount, err := sdk.ParseDecCoin(queryParams.BuyTokenAmount)
+	if err != nil {
+		return nil, types.ErrConvertSellTokenAmount(queryParams.BuyTokenAmount, err)
+	}
+	if buyAmount.Denom == queryParams.SellToken {
+		return nil, types.ErrSellAmountEqualBuyToken()
+	}
+
+	swapRoute, err := keeper.GetBestSwapRouteExactOutput(ctx, buyAmount, queryParams.SellToken)
+	if err != nil {
+		return nil, err
+	}
+
+	response := common.GetBaseResponse(swapRoute)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// querySwapTWAP returns the time-weighted average prices of the swap token pair over the block window
+func querySwapTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QuerySwapTWAPParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	// the window ends at the latest block by default
+	if queryParams.EndHeight == 0 {
+		queryParams.EndHeight = ctx.BlockHeight()
+	}
+
+	twap, err := keeper.GetSwapTokenPairTWAP(ctx, queryParams.TokenPairName, queryParams.StartHeight, queryParams.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	response := common.GetBaseResponse(twap)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryProtocolFees returns the protocol fees collected from the swaps
+func queryProtocolFees(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	response := common.GetBaseResponse(keeper.GetProtocolFees(ctx))
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryConcentratedPool returns the concentrated pool of the token pair
+func queryConcentratedPool(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrQueryParamsBaseTokenIsEmpty()
+	}
+	var response *common.BaseResponse
+	if pool, found := keeper.GetConcentratedPool(ctx, path[0]); found {
+		response = common.GetBaseResponse(pool)
+	} else {
+		response = common.GetBaseResponse(nil)
+	}
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryConcentratedPosition returns the concentrated position of the id
+func queryConcentratedPosition(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrNonExistConcentratedPosition(0)
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, common.ErrInvalidParam(err.Error())
+	}
+	position, found := keeper.GetConcentratedPosition(ctx, id)
+	if !found {
+		return nil, types.ErrNonExistConcentratedPosition(id)
+	}
+	bz, err := json.Marshal(common.GetBaseResponse(position))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryConcentratedPositions returns the concentrated positions owned by the address
+func queryConcentratedPositions(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrQueryParamsAddressIsEmpty()
+	}
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, common.ErrCreateAddrFromBech32Failed(path[0], err.Error())
+	}
+	positions := keeper.GetConcentratedPositionsByOwner(ctx, owner)
+	if positions == nil {
+		positions = []types.ConcentratedPosition{}
+	}
+	bz, err := json.Marshal(common.GetBaseResponse(positions))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+// queryStablePool returns the stable pool of the name, which is the tokens in it joined by "_"
+func queryStablePool(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrQueryParamsBaseTokenIsEmpty()
+	}
+	pool, found := keeper.GetStablePool(ctx, path[0])
+	if !found {
+		return nil, types.ErrNonExistStablePool(path[0])
+	}
+	bz, err := json.Marshal(common.GetBaseResponse(struct {
+		types.StablePool
+		Amplification int64 `json:"amplification"`
+	}{pool, pool.GetAmplification(ctx.BlockTime().Unix())}))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
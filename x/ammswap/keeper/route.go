@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// swapTokenPairGetter gets the swap token pair by its name
+type swapTokenPairGetter func(tokenPairName string) (types.SwapTokenPair, error)
+
+// GetSwapRouteAmountsOut calculates the amounts of the tokens of the swap path by selling the amount of the first
+// token, the last amount is the amount of the last token bought
+func (k Keeper) GetSwapRouteAmountsOut(ctx sdk.Context, soldToken sdk.SysCoin, path []string) ([]sdk.SysCoin, error) {
+	getPair := func(tokenPairName string) (types.SwapTokenPair, error) {
+		return k.GetSwapTokenPair(ctx, tokenPairName)
+	}
+	return calculateAmountsOut(getPair, soldToken, path, k.GetParams(ctx))
+}
+
+// GetSwapRouteAmountsIn calculates the amounts of the tokens of the swap path by buying the amount of the last
+// token, the first amount is the amount of the first token sold
+func (k Keeper) GetSwapRouteAmountsIn(ctx sdk.Context, boughtToken sdk.SysCoin, path []string) ([]sdk.SysCoin, error) {
+	getPair := func(tokenPairName string) (types.SwapTokenPair, error) {
+		return k.GetSwapTokenPair(ctx, tokenPairName)
+	}
+	return calculateAmountsIn(getPair, boughtToken, path, k.GetParams(ctx))
+}
+
+// GetBestSwapRoute returns the swap route through all the swap token pairs by which the most amount of the token is
+// bought by selling the amount of the sold token
+func (k Keeper) GetBestSwapRoute(ctx sdk.Context, soldToken sdk.SysCoin, boughtToken string) (types.SwapRoute, error) {
+	params := k.GetParams(ctx)
+	getPair, paths := k.findSwapPaths(ctx, soldToken.Denom, boughtToken)
+
+	var best []sdk.SysCoin
+	var bestPath []string
+	for _, path := range paths {
+		amounts, err := calculateAmountsOut(getPair, soldToken, path, params)
+		if err != nil {
+			continue
+		}
+		if best == nil || amounts[len(amounts)-1].Amount.GT(best[len(best)-1].Amount) {
+			best, bestPath = amounts, path
+		}
+	}
+	if best == nil {
+		return types.SwapRoute{}, types.ErrSwapRouteNotFound(soldToken.Denom, boughtToken)
+	}
+	return newSwapRoute(bestPath, best), nil
+}
+
+// GetBestSwapRouteExactOutput returns the swap route through all the swap token pairs by which the least amount of
+// the sold token is sold for buying the amount of the bought token
+func (k Keeper) GetBestSwapRouteExactOutput(ctx sdk.Context, boughtToken sdk.SysCoin, soldToken string) (types.SwapRoute, error) {
+	params := k.GetParams(ctx)
+	getPair, paths := k.findSwapPaths(ctx, soldToken, boughtToken.Denom)
+
+	var best []sdk.SysCoin
+	var bestPath []string
+	for _, path := range paths {
+		amounts, err := calculateAmountsIn(getPair, boughtToken, path, params)
+		if err != nil {
+			continue
+		}
+		if best == nil || amounts[0].Amount.LT(best[0].Amount) {
+			best, bestPath = amounts, path
+		}
+	}
+	if best == nil {
+		return types.SwapRoute{}, types.ErrSwapRouteNotFound(soldToken, boughtToken.Denom)
+	}
+	return newSwapRoute(bestPath, best), nil
+}
+
+// findSwapPaths returns all the swap paths from the sold token to the bought token through the swap token pairs
+// with liquidity, the shorter paths are in front of the longer ones
+func (k Keeper) findSwapPaths(ctx sdk.Context, soldToken, boughtToken string) (swapTokenPairGetter, [][]string) {
+	pairs := make(map[string]types.SwapTokenPair)
+	neighbors := make(map[string][]string)
+	for _, pair := range k.GetSwapTokenPairs(ctx) {
+		if pair.BasePooledCoin.IsZero() || pair.QuotePooledCoin.IsZero() {
+			continue
+		}
+		base, quote := pair.BasePooledCoin.Denom, pair.QuotePooledCoin.Denom
+		pairs[pair.TokenPairName()] = pair
+		neighbors[base] = append(neighbors[base], quote)
+		neighbors[quote] = append(neighbors[quote], base)
+	}
+	for _, tokens := range neighbors {
+		sort.Strings(tokens)
+	}
+
+	var paths [][]string
+	visited := map[string]bool{soldToken: true}
+	var walk func(path []string)
+	walk = func(path []string) {
+		last := path[len(path)-1]
+		if last == boughtToken {
+			paths = append(paths, append([]string(nil), path...))
+			return
+		}
+		if len(path) > types.MaxSwapRouteHops {
+			return
+		}
+		for _, next := range neighbors[last] {
+			if !visited[next] {
+				visited[next] = true
+				walk(append(path, next))
+				visited[next] = false
+			}
+		}
+	}
+	walk([]string{soldToken})
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) < len(paths[j])
+	})
+
+	getPair := func(tokenPairName string) (types.SwapTokenPair, error) {
+		pair, ok := pairs[tokenPairName]
+		if !ok {
+			return pair, types.ErrNonExistSwapTokenPair(tokenPairName)
+		}
+		return pair, nil
+	}
+	return getPair, paths
+}
+
+func newSwapRoute(path []string, amounts []sdk.SysCoin) types.SwapRoute {
+	return types.SwapRoute{
+		Route:             path[1 : len(path)-1],
+		SoldTokenAmount:   amounts[0],
+		BoughtTokenAmount: amounts[len(amounts)-1],
+	}
+}
+
+func calculateAmountsOut(getPair swapTokenPairGetter, soldToken sdk.SysCoin, path []string, params types.Params) (
+	[]sdk.SysCoin, error) {
+	amounts := make([]sdk.SysCoin, len(path))
+	amounts[0] = soldToken
+	for i := 1; i < len(path); i++ {
+		swapTokenPair, err := getLiquidSwapTokenPair(getPair, path[i-1], path[i])
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = CalculateTokenToBuy(swapTokenPair, amounts[i-1], path[i], params)
+		if !amounts[i].IsPositive() {
+			return nil, types.ErrIsZeroValue("token buy")
+		}
+	}
+	return amounts, nil
+}
+
+func calculateAmountsIn(getPair swapTokenPairGetter, boughtToken sdk.SysCoin, path []string, params types.Params) (
+	[]sdk.SysCoin, error) {
+	amounts := make([]sdk.SysCoin, len(path))
+	amounts[len(path)-1] = boughtToken
+	for i := len(path) - 1; i > 0; i-- {
+		swapTokenPair, err := getLiquidSwapTokenPair(getPair, path[i-1], path[i])
+		if err != nil {
+			return nil, err
+		}
+		if amounts[i].Amount.GTE(swapTokenPair.PooledCoin(path[i]).Amount) {
+			return nil, types.ErrInsufficientPoolLiquidity(swapTokenPair.TokenPairName())
+		}
+		amounts[i-1] = CalculateTokenToSell(swapTokenPair, amounts[i], path[i-1], params)
+	}
+	return amounts, nil
+}
+
+func getLiquidSwapTokenPair(getPair swapTokenPairGetter, token0, token1 string) (types.SwapTokenPair, error) {
+	swapTokenPair, err := getPair(types.GetSwapTokenPairName(token0, token1))
+	if err != nil {
+		return swapTokenPair, err
+	}
+	if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+		return swapTokenPair, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
+	}
+	return swapTokenPair, nil
+}
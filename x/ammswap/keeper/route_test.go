@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestSwapTokenPair(ctx sdk.Context, keeper Keeper, token0 string, amount0 int64, token1 string, amount1 int64) {
+	swapTokenPair := types.NewSwapPair(token0, token1)
+	if swapTokenPair.BasePooledCoin.Denom == token0 {
+		swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount = sdk.NewDec(amount0), sdk.NewDec(amount1)
+	} else {
+		swapTokenPair.BasePooledCoin.Amount, swapTokenPair.QuotePooledCoin.Amount = sdk.NewDec(amount1), sdk.NewDec(amount0)
+	}
+	keeper.SetSwapTokenPair(ctx, swapTokenPair.TokenPairName(), swapTokenPair)
+}
+
+func TestGetOutputPrice(t *testing.T) {
+	feeRate := types.DefaultParams().FeeRate
+	inputReserve, outputReserve := sdk.NewDec(1000), sdk.MustNewDecFromStr("3000.123456789012345678")
+	for _, outputAmount := range []sdk.Dec{
+		sdk.NewDecWithPrec(1, sdk.Precision),
+		sdk.MustNewDecFromStr("0.333333333333333333"),
+		sdk.NewDec(10),
+		sdk.NewDec(2999),
+	} {
+		inputAmount := GetOutputPrice(outputAmount, inputReserve, outputReserve, feeRate)
+		require.True(t, GetInputPrice(inputAmount, inputReserve, outputReserve, feeRate).GTE(outputAmount))
+		lessInputAmount := inputAmount.Sub(sdk.NewDecWithPrec(1, sdk.Precision))
+		require.True(t, GetInputPrice(lessInputAmount, inputReserve, outputReserve, feeRate).LTE(outputAmount))
+	}
+}
+
+func TestKeeper_GetBestSwapRoute(t *testing.T) {
+	mapp, _ := GetTestInput(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	// the direct pair is shallow, so swapping through the native token is better
+	setTestSwapTokenPair(ctx, keeper, types.TestBasePooledToken, 100, types.TestBasePooledToken2, 100)
+	setTestSwapTokenPair(ctx, keeper, types.TestBasePooledToken, 10000, types.TestQuotePooledToken, 10000)
+	setTestSwapTokenPair(ctx, keeper, types.TestBasePooledToken2, 10000, types.TestQuotePooledToken, 10000)
+	// the pair without liquidity is skipped
+	keeper.SetSwapTokenPair(ctx, types.GetSwapTokenPairName(types.TestBasePooledToken2, types.TestBasePooledToken3),
+		types.NewSwapPair(types.TestBasePooledToken2, types.TestBasePooledToken3))
+
+	soldToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(50))
+	swapRoute, err := keeper.GetBestSwapRoute(ctx, soldToken, types.TestBasePooledToken2)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.TestQuotePooledToken}, swapRoute.Route)
+	require.Equal(t, soldToken, swapRoute.SoldTokenAmount)
+	amounts, err := keeper.GetSwapRouteAmountsOut(ctx, soldToken,
+		[]string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2})
+	require.Nil(t, err)
+	require.Equal(t, amounts[2], swapRoute.BoughtTokenAmount)
+	directAmounts, err := keeper.GetSwapRouteAmountsOut(ctx, soldToken,
+		[]string{types.TestBasePooledToken, types.TestBasePooledToken2})
+	require.Nil(t, err)
+	require.True(t, directAmounts[1].Amount.LT(swapRoute.BoughtTokenAmount.Amount))
+
+	// a tiny amount is swapped directly
+	soldToken = sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDecWithPrec(1, 6))
+	swapRoute, err = keeper.GetBestSwapRoute(ctx, soldToken, types.TestBasePooledToken2)
+	require.Nil(t, err)
+	require.Empty(t, swapRoute.Route)
+
+	boughtToken := sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(50))
+	swapRoute, err = keeper.GetBestSwapRouteExactOutput(ctx, boughtToken, types.TestBasePooledToken)
+	require.Nil(t, err)
+	require.Equal(t, []string{types.TestQuotePooledToken}, swapRoute.Route)
+	require.Equal(t, boughtToken, swapRoute.BoughtTokenAmount)
+	amounts, err = keeper.GetSwapRouteAmountsIn(ctx, boughtToken,
+		[]string{types.TestBasePooledToken, types.TestQuotePooledToken, types.TestBasePooledToken2})
+	require.Nil(t, err)
+	require.Equal(t, amounts[0], swapRoute.SoldTokenAmount)
+
+	// the direct pair can't provide the amount
+	_, err = keeper.GetSwapRouteAmountsIn(ctx, sdk.NewDecCoinFromDec(types.TestBasePooledToken2, sdk.NewDec(100)),
+		[]string{types.TestBasePooledToken, types.TestBasePooledToken2})
+	require.NotNil(t, err)
+
+	_, err = keeper.GetBestSwapRoute(ctx, soldToken, types.TestBasePooledToken3)
+	require.NotNil(t, err)
+	_, err = keeper.GetBestSwapRouteExactOutput(ctx, boughtToken, types.TestBasePooledToken3)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// GetStablePool gets the stable pool by name
+func (k Keeper) GetStablePool(ctx sdk.Context, name string) (pool types.StablePool, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStablePoolKey(name))
+	if bz == nil {
+		return pool, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pool)
+	return pool, true
+}
+
+// SetStablePool sets the stable pool, and indexes it by all the token pairs in it
+func (k Keeper) SetStablePool(ctx sdk.Context, pool types.StablePool) {
+	store := ctx.KVStore(k.storeKey)
+	name := pool.Name()
+	store.Set(types.GetStablePoolKey(name), k.cdc.MustMarshalBinaryLengthPrefixed(pool))
+	for _, tokenPairName := range pool.TokenPairNames() {
+		store.Set(types.GetStablePoolTokenPairKey(tokenPairName), []byte(name))
+	}
+}
+
+// GetStablePools returns all the stable pools
+func (k Keeper) GetStablePools(ctx sdk.Context) (pools []types.StablePool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StablePoolPrefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.StablePool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// GetStablePoolByTokenPair gets the stable pool which the token pair is swapped in
+func (k Keeper) GetStablePoolByTokenPair(ctx sdk.Context, tokenPairName string) (pool types.StablePool, found bool) {
+	name := ctx.KVStore(k.storeKey).Get(types.GetStablePoolTokenPairKey(tokenPairName))
+	if name == nil {
+		return pool, false
+	}
+	return k.GetStablePool(ctx, string(name))
+}
+
+// IsTokenPairPooled returns true if the token pair is swapped in any type of pool, a token pair has only one pool
+func (k Keeper) IsTokenPairPooled(ctx sdk.Context, tokenPairName string) bool {
+	if _, err := k.GetSwapTokenPair(ctx, tokenPairName); err == nil {
+		return true
+	}
+	if _, found := k.GetConcentratedPool(ctx, tokenPairName); found {
+		return true
+	}
+	_, found := k.GetStablePoolByTokenPair(ctx, tokenPairName)
+	return found
+}
+
+// CalculateStableTokenToBuy returns the amount of the token bought by selling the token in the stable pool, and the
+// protocol fee taken out of the pool in the token bought
+func (k Keeper) CalculateStableTokenToBuy(ctx sdk.Context, pool types.StablePool, sellToken sdk.SysCoin,
+	buyTokenDenom string, params types.Params) (tokenBuy, protocolFee sdk.SysCoin) {
+	tokenBuy, fee := types.CalculateStableTokenToBuy(pool, sellToken, buyTokenDenom,
+		pool.GetAmplification(ctx.BlockTime().Unix()), pool.GetFeeRate(params))
+	return tokenBuy, sdk.NewDecCoinFromDec(fee.Denom, fee.Amount.MulTruncate(params.ProtocolFeeRate))
+}
+
+// RampStableAmplification ramps the amplification coefficient of the stable pool from the current one to the future
+// one over the duration in seconds
+func (k Keeper) RampStableAmplification(ctx sdk.Context, name string, futureAmplification, rampDuration int64) sdk.Error {
+	pool, found := k.GetStablePool(ctx, name)
+	if !found {
+		return types.ErrNonExistStablePool(name)
+	}
+	if err := types.ValidateRampDuration(rampDuration); err != nil {
+		return err
+	}
+	now := ctx.BlockTime().Unix()
+	if err := types.ValidateAmplificationChange(pool.GetAmplification(now), futureAmplification); err != nil {
+		return err
+	}
+	pool.RampAmplification(now, futureAmplification, now+rampDuration)
+	k.SetStablePool(ctx, pool)
+	return nil
+}
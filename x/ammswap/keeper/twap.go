@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// updateSwapPriceObservation records the prices of the swap token pair after it's updated in the current block,
+// the cumulative prices are accumulated by the prices recorded at the end of the blocks before
+func (k Keeper) updateSwapPriceObservation(ctx sdk.Context, tokenPairName string, swapTokenPair types.SwapTokenPair) {
+	blockHeight := ctx.BlockHeight()
+	baseCumulativePrice, quoteCumulativePrice := sdk.ZeroDec(), sdk.ZeroDec()
+	if latest, found := k.GetSwapPriceObservation(ctx, tokenPairName, blockHeight); found {
+		if latest.BlockHeight == blockHeight {
+			baseCumulativePrice, quoteCumulativePrice = latest.BaseCumulativePrice, latest.QuoteCumulativePrice
+		} else {
+			baseCumulativePrice, quoteCumulativePrice = latest.CumulativePricesAt(blockHeight)
+		}
+	}
+	observation := types.NewSwapPriceObservation(blockHeight, baseCumulativePrice, quoteCumulativePrice, swapTokenPair)
+	k.SetSwapPriceObservation(ctx, tokenPairName, observation)
+	// the observations are pruned along with the updates, so only a few of them are out of the window
+	k.PruneSwapPriceObservations(ctx, tokenPairName, blockHeight-types.MaxSwapTWAPWindow)
+}
+
+// SetSwapPriceObservation sets the price observation of the swap token pair at its block height
+func (k Keeper) SetSwapPriceObservation(ctx sdk.Context, tokenPairName string, observation types.SwapPriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSwapPriceObservationKey(tokenPairName, observation.BlockHeight),
+		k.cdc.MustMarshalBinaryLengthPrefixed(observation))
+}
+
+// GetSwapPriceObservationRecords gets the price observations of all the swap token pairs
+func (k Keeper) GetSwapPriceObservationRecords(ctx sdk.Context) []types.SwapPriceObservationRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SwapPriceObservationPrefixKey)
+	defer iterator.Close()
+
+	var records []types.SwapPriceObservationRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.SwapPriceObservationRecord{TokenPairName: types.SplitSwapPriceObservationKey(iterator.Key())}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record.Observation)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetSwapPriceObservation gets the latest price observation of the swap token pair at or before the block height
+func (k Keeper) GetSwapPriceObservation(ctx sdk.Context, tokenPairName string, blockHeight int64) (
+	observation types.SwapPriceObservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetSwapPriceObservationPrefix(tokenPairName),
+		types.GetSwapPriceObservationKey(tokenPairName, blockHeight+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return observation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &observation)
+	return observation, true
+}
+
+// GetSwapTokenPairTWAP returns the time-weighted average prices of the swap token pair over the blocks from the
+// start height to the one before the end height, which are resistant to the manipulation within a block
+func (k Keeper) GetSwapTokenPairTWAP(ctx sdk.Context, tokenPairName string, startHeight, endHeight int64) (
+	types.SwapTWAP, error) {
+	if startHeight <= 0 || startHeight >= endHeight || endHeight > ctx.BlockHeight() {
+		return types.SwapTWAP{}, types.ErrInvalidTWAPWindow(startHeight, endHeight, ctx.BlockHeight())
+	}
+	if _, err := k.GetSwapTokenPair(ctx, tokenPairName); err != nil {
+		return types.SwapTWAP{}, err
+	}
+
+	startObservation, found := k.GetSwapPriceObservation(ctx, tokenPairName, startHeight)
+	if !found {
+		return types.SwapTWAP{}, types.ErrSwapPriceObservationNotFound(tokenPairName, startHeight)
+	}
+	endObservation, _ := k.GetSwapPriceObservation(ctx, tokenPairName, endHeight)
+	startBaseCumulativePrice, startQuoteCumulativePrice := startObservation.CumulativePricesAt(startHeight)
+	endBaseCumulativePrice, endQuoteCumulativePrice := endObservation.CumulativePricesAt(endHeight)
+
+	blocks := sdk.NewDec(endHeight - startHeight)
+	return types.SwapTWAP{
+		TokenPairName: tokenPairName,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		BasePrice:     endBaseCumulativePrice.Sub(startBaseCumulativePrice).Quo(blocks),
+		QuotePrice:    endQuoteCumulativePrice.Sub(startQuoteCumulativePrice).Quo(blocks),
+	}, nil
+}
+
+// PruneSwapPriceObservations deletes the price observations of the swap token pair which are no longer needed
+// by the TWAP queries over the blocks since the block height
+func (k Keeper) PruneSwapPriceObservations(ctx sdk.Context, tokenPairName string, blockHeight int64) {
+	if blockHeight <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	// the latest observation before the block height is kept to get the cumulative prices at the height
+	iterator := store.Iterator(types.GetSwapPriceObservationPrefix(tokenPairName),
+		types.GetSwapPriceObservationKey(tokenPairName, blockHeight))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
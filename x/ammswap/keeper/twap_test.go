@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_GetSwapTokenPairTWAP(t *testing.T) {
+	mapp, _ := GetTestInput(t, 1)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+
+	base, quote := types.TestBasePooledToken, types.TestQuotePooledToken
+	tokenPairName := types.GetSwapTokenPairName(base, quote)
+	setTestSwapTokenPair(ctx, keeper, base, 100, quote, 100)
+	// the price manipulated within a block is not counted
+	setTestSwapTokenPair(ctx.WithBlockHeight(20), keeper, base, 100, quote, 400)
+	setTestSwapTokenPair(ctx.WithBlockHeight(20), keeper, base, 100, quote, 100)
+	setTestSwapTokenPair(ctx.WithBlockHeight(30), keeper, base, 100, quote, 200)
+	ctx = ctx.WithBlockHeight(40)
+
+	twap, err := keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 10, 40)
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.333333333333333333"), twap.BasePrice)
+	require.Equal(t, sdk.MustNewDecFromStr("0.833333333333333333"), twap.QuotePrice)
+
+	twap, err = keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 15, 25)
+	require.Nil(t, err)
+	require.Equal(t, sdk.OneDec(), twap.BasePrice)
+	require.Equal(t, sdk.OneDec(), twap.QuotePrice)
+
+	_, err = keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 5, 40)
+	require.NotNil(t, err)
+	_, err = keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 20, 20)
+	require.NotNil(t, err)
+	_, err = keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 20, 41)
+	require.NotNil(t, err)
+	_, err = keeper.GetSwapTokenPairTWAP(ctx, types.GetSwapTokenPairName(base, types.TestBasePooledToken2), 20, 40)
+	require.NotNil(t, err)
+
+	// the observation at height 20 is kept for the window since height 25
+	keeper.PruneSwapPriceObservations(ctx, tokenPairName, 25)
+	_, found := keeper.GetSwapPriceObservation(ctx, tokenPairName, 15)
+	require.False(t, found)
+	observation, found := keeper.GetSwapPriceObservation(ctx, tokenPairName, 25)
+	require.True(t, found)
+	require.Equal(t, int64(20), observation.BlockHeight)
+	twap, err = keeper.GetSwapTokenPairTWAP(ctx, tokenPairName, 25, 40)
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.666666666666666667"), twap.BasePrice)
+
+	// the observations out of the window are pruned by the update of the swap token pair
+	setTestSwapTokenPair(ctx.WithBlockHeight(31+types.MaxSwapTWAPWindow), keeper, base, 100, quote, 100)
+	records := keeper.GetSwapPriceObservationRecords(ctx)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, tokenPairName, records[0].TokenPairName)
+	require.Equal(t, int64(30), records[0].Observation.BlockHeight)
+	require.Equal(t, 31+types.MaxSwapTWAPWindow, records[1].Observation.BlockHeight)
+}
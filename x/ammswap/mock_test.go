@@ -70,6 +70,7 @@ func getMockAppWithBalance(t *testing.T, numGenAccs int, balance int64) (mockApp
 		auth.FeeCollectorName: nil,
 		token.ModuleName:      {supply.Minter, supply.Burner},
 		ModuleName:            {supply.Minter, supply.Burner},
+		ProtocolFeeAccount:    nil,
 	}
 	mockApp.supplyKeeper = supply.NewKeeper(mockApp.Cdc, mockApp.keySupply, mockApp.AccountKeeper,
 		mockApp.bankKeeper, maccPerms)
@@ -0,0 +1,30 @@
+package ammswap
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/common"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewProposalHandler handles "gov" type message in "swap"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.WithdrawProtocolFeeProposal:
+			return handleWithdrawProtocolFeeProposal(ctx, k, content)
+		case types.RampAmplificationProposal:
+			return handleRampAmplificationProposal(ctx, k, content)
+		default:
+			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleWithdrawProtocolFeeProposal(ctx sdk.Context, k *Keeper, proposal types.WithdrawProtocolFeeProposal) sdk.Error {
+	return k.WithdrawProtocolFee(ctx, proposal.Recipient, proposal.Amount)
+}
+
+func handleRampAmplificationProposal(ctx sdk.Context, k *Keeper, proposal types.RampAmplificationProposal) sdk.Error {
+	return k.RampStableAmplification(ctx, proposal.PoolName, proposal.FutureAmplification, proposal.RampDuration)
+}
@@ -0,0 +1,64 @@
+package ammswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/ammswap/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawProtocolFeeProposal(t *testing.T) {
+	mapp, addrKeysSlice := getMockAppWithBalance(t, 2, 100000)
+	keeper := mapp.swapKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(time.Now())
+	params := types.DefaultParams()
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(5, 1)
+	mapp.swapKeeper.SetParams(ctx, params)
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+	handler := NewHandler(keeper)
+	addr, recipient := addrKeysSlice[0].Address, addrKeysSlice[1].Address
+	deadLine := time.Now().Unix()
+
+	for _, symbol := range []string{types.TestBasePooledToken, types.TestQuotePooledToken} {
+		mapp.tokenKeeper.NewToken(ctx, token.InitTestToken(symbol))
+	}
+	_, err := handler(ctx, types.NewMsgCreateExchange(types.TestBasePooledToken, types.TestQuotePooledToken, addr))
+	require.Nil(t, err)
+	maxBaseAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(10000))
+	quoteAmount := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(10000))
+	_, err = handler(ctx, types.NewMsgAddLiquidity(sdk.NewDec(1), maxBaseAmount, quoteAmount, deadLine, addr))
+	require.Nil(t, err)
+
+	// half of the swap fee is collected by the protocol
+	soldTokenAmount := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDec(1000))
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(types.TestQuotePooledToken, sdk.NewDec(1))
+	_, err = handler(ctx, types.NewMsgTokenToToken(soldTokenAmount, minBoughtTokenAmount, deadLine, addr, addr))
+	require.Nil(t, err)
+	protocolFee := sdk.NewDecCoinFromDec(types.TestBasePooledToken, sdk.NewDecWithPrec(15, 1))
+	require.Equal(t, sdk.SysCoins{protocolFee}, keeper.GetProtocolFees(ctx))
+	swapTokenPair, err := keeper.GetSwapTokenPair(ctx, types.GetSwapTokenPairName(types.TestBasePooledToken, types.TestQuotePooledToken))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(11000).Sub(protocolFee.Amount), swapTokenPair.BasePooledCoin.Amount)
+
+	proposalHandler := NewProposalHandler(&keeper)
+
+	// insufficient protocol fees
+	proposal := govtypes.Proposal{Content: types.NewWithdrawProtocolFeeProposal("Test", "description", recipient,
+		sdk.SysCoins{protocolFee.Add(protocolFee)})}
+	require.NotNil(t, proposalHandler(ctx, &proposal))
+
+	balance := mapp.AccountKeeper.GetAccount(ctx, recipient).GetCoins().AmountOf(types.TestBasePooledToken)
+	proposal = govtypes.Proposal{Content: types.NewWithdrawProtocolFeeProposal("Test", "description", recipient,
+		sdk.SysCoins{protocolFee})}
+	require.Nil(t, proposalHandler(ctx, &proposal))
+	require.True(t, keeper.GetProtocolFees(ctx).IsZero())
+	require.Equal(t, balance.Add(protocolFee.Amount),
+		mapp.AccountKeeper.GetAccount(ctx, recipient).GetCoins().AmountOf(types.TestBasePooledToken))
+}
@@ -10,6 +10,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRemoveLiquidity{}, "okexchain/ammswap/MsgRemoveLiquidity", nil)
 	cdc.RegisterConcrete(MsgCreateExchange{}, "okexchain/ammswap/MsgCreateExchange", nil)
 	cdc.RegisterConcrete(MsgTokenToToken{}, "okexchain/ammswap/MsgSwapToken", nil)
+	cdc.RegisterConcrete(MsgTokenToTokenByRoute{}, "okexchain/ammswap/MsgSwapTokenByRoute", nil)
+	cdc.RegisterConcrete(MsgTokenToExactToken{}, "okexchain/ammswap/MsgSwapExactToken", nil)
+	cdc.RegisterConcrete(MsgAddConcentratedLiquidity{}, "okexchain/ammswap/MsgAddConcentratedLiquidity", nil)
+	cdc.RegisterConcrete(MsgRemoveConcentratedLiquidity{}, "okexchain/ammswap/MsgRemoveConcentratedLiquidity", nil)
+	cdc.RegisterConcrete(MsgCollectConcentratedFees{}, "okexchain/ammswap/MsgCollectConcentratedFees", nil)
+	cdc.RegisterConcrete(MsgTransferConcentratedPosition{}, "okexchain/ammswap/MsgTransferConcentratedPosition", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -0,0 +1,347 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// the types of the pools which could be created with MsgCreateExchange
+const (
+	// PoolTypeConstantProduct is the pool of SwapTokenPair, whose liquidity is spread over the full price range
+	PoolTypeConstantProduct = "constant_product"
+	// PoolTypeConcentrated is the pool of ConcentratedPool, whose liquidity is provided within price ranges
+	PoolTypeConcentrated = "concentrated"
+)
+
+const (
+	// DefaultTickSpacing is the tick spacing of the concentrated pools created without tick spacing
+	DefaultTickSpacing int64 = 60
+	// MaxTickSpacing is the max tick spacing of the concentrated pools
+	MaxTickSpacing int64 = 1000
+	// MaxTick is the max tick of the concentrated pools, whose price is about 1e12. The range of the ticks is limited
+	// so that the square root of the prices keep enough significant digits in the precision of sdk.Dec
+	MaxTick int64 = 276300
+	// MinTick is the min tick of the concentrated pools, whose price is about 1e-12
+	MinTick = -MaxTick
+)
+
+var (
+	// tickBase is the square root of the price ratio of two adjacent ticks, which is sqrt(1.0001)
+	tickBase = mustApproxSqrt(sdk.NewDecWithPrec(10001, 4))
+)
+
+// IsValidPoolType returns true if the pool type could be created, an empty type means the constant product one
+func IsValidPoolType(poolType string) bool {
+	return poolType == "" || poolType == PoolTypeConstantProduct || poolType == PoolTypeConcentrated ||
+		poolType == PoolTypeStable
+}
+
+// ConcentratedPool defines the token pair exchange whose liquidity is provided by the positions within price ranges.
+// The price is the amount of the quote token per base token, and the pool keeps its square root as the tick math does.
+type ConcentratedPool struct {
+	BasePooledCoin       sdk.SysCoin `json:"base_pooled_coin"`        // The volume of base token provided by the positions, the fees not included
+	QuotePooledCoin      sdk.SysCoin `json:"quote_pooled_coin"`       // The volume of quote token provided by the positions, the fees not included
+	FeeRate              sdk.Dec     `json:"fee_rate"`                // The fee rate of the swaps, the default fee rate of params is used if it's zero
+	TickSpacing          int64       `json:"tick_spacing"`            // The ticks of the positions must be multiples of the tick spacing
+	SqrtPrice            sdk.Dec     `json:"sqrt_price"`              // The square root of the current price
+	CurrentTick          int64       `json:"current_tick"`            // The tick of the current price
+	Liquidity            sdk.Dec     `json:"liquidity"`               // The liquidity of the positions in range
+	FeeGrowthGlobalBase  sdk.Dec     `json:"fee_growth_global_base"`  // The base token fees earned per unit of liquidity
+	FeeGrowthGlobalQuote sdk.Dec     `json:"fee_growth_global_quote"` // The quote token fees earned per unit of liquidity
+}
+
+// NewConcentratedPool creates a concentrated pool without liquidity at the initial price
+func NewConcentratedPool(token0, token1 string, feeRate sdk.Dec, tickSpacing int64, initialPrice sdk.Dec) ConcentratedPool {
+	base, quote := GetBaseQuoteTokenName(token0, token1)
+	sqrtPrice := mustApproxSqrt(initialPrice)
+	return ConcentratedPool{
+		BasePooledCoin:       sdk.NewDecCoinFromDec(base, sdk.ZeroDec()),
+		QuotePooledCoin:      sdk.NewDecCoinFromDec(quote, sdk.ZeroDec()),
+		FeeRate:              feeRate,
+		TickSpacing:          tickSpacing,
+		SqrtPrice:            sqrtPrice,
+		CurrentTick:          SqrtPriceToTick(sqrtPrice),
+		Liquidity:            sdk.ZeroDec(),
+		FeeGrowthGlobalBase:  sdk.ZeroDec(),
+		FeeGrowthGlobalQuote: sdk.ZeroDec(),
+	}
+}
+
+// String implement fmt.Stringer
+func (p ConcentratedPool) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`BasePooledCoin: %s
+QuotePooledCoin: %s
+FeeRate: %s
+TickSpacing: %d
+Price: %s
+CurrentTick: %d
+Liquidity: %s`, p.BasePooledCoin, p.QuotePooledCoin, p.FeeRate, p.TickSpacing, p.Price(), p.CurrentTick, p.Liquidity))
+}
+
+// TokenPairName defines token pair
+func (p ConcentratedPool) TokenPairName() string {
+	return p.BasePooledCoin.Denom + "_" + p.QuotePooledCoin.Denom
+}
+
+// Price returns the amount of the quote token per base token
+func (p ConcentratedPool) Price() sdk.Dec {
+	return p.SqrtPrice.Mul(p.SqrtPrice)
+}
+
+// GetFeeRate returns the fee rate of the swaps in the pool
+func (p ConcentratedPool) GetFeeRate(params Params) sdk.Dec {
+	if p.FeeRate.IsNil() || p.FeeRate.IsZero() {
+		return params.FeeRate
+	}
+	return p.FeeRate
+}
+
+// ValidateTickRange checks the ticks of a position are in order, in the range of the ticks, and multiples of the tick
+// spacing of the pool
+func (p ConcentratedPool) ValidateTickRange(lowerTick, upperTick int64) sdk.Error {
+	if err := ValidateTickRange(lowerTick, upperTick); err != nil {
+		return err
+	}
+	if lowerTick%p.TickSpacing != 0 || upperTick%p.TickSpacing != 0 {
+		return ErrInvalidTickRange(lowerTick, upperTick, p.TickSpacing)
+	}
+	return nil
+}
+
+// FeeGrowthInside returns the fees earned per unit of liquidity within the ticks, whose fee growths outside have been
+// initialized. The fee growths could be negative, but the differences of them between two moments are right.
+func (p ConcentratedPool) FeeGrowthInside(lower, upper ConcentratedTick) (base, quote sdk.Dec) {
+	belowBase, belowQuote := lower.FeeGrowthOutsideBase, lower.FeeGrowthOutsideQuote
+	if p.CurrentTick < lower.Index {
+		belowBase, belowQuote = p.FeeGrowthGlobalBase.Sub(belowBase), p.FeeGrowthGlobalQuote.Sub(belowQuote)
+	}
+	aboveBase, aboveQuote := upper.FeeGrowthOutsideBase, upper.FeeGrowthOutsideQuote
+	if p.CurrentTick >= upper.Index {
+		aboveBase, aboveQuote = p.FeeGrowthGlobalBase.Sub(aboveBase), p.FeeGrowthGlobalQuote.Sub(aboveQuote)
+	}
+	return p.FeeGrowthGlobalBase.Sub(belowBase).Sub(aboveBase), p.FeeGrowthGlobalQuote.Sub(belowQuote).Sub(aboveQuote)
+}
+
+// ConcentratedTick is an initialized tick of a concentrated pool, which is the boundary of some positions
+type ConcentratedTick struct {
+	TokenPairName         string  `json:"token_pair_name"`
+	Index                 int64   `json:"index"`
+	LiquidityGross        sdk.Dec `json:"liquidity_gross"`          // The liquidity of the positions bounded by the tick
+	LiquidityNet          sdk.Dec `json:"liquidity_net"`            // The liquidity added to the pool once the price crosses the tick upward
+	FeeGrowthOutsideBase  sdk.Dec `json:"fee_growth_outside_base"`  // The base token fees earned per unit of liquidity on the other side of the current tick
+	FeeGrowthOutsideQuote sdk.Dec `json:"fee_growth_outside_quote"` // The quote token fees earned per unit of liquidity on the other side of the current tick
+}
+
+// NewConcentratedTick creates an uninitialized tick
+func NewConcentratedTick(tokenPairName string, index int64) ConcentratedTick {
+	return ConcentratedTick{
+		TokenPairName:         tokenPairName,
+		Index:                 index,
+		LiquidityGross:        sdk.ZeroDec(),
+		LiquidityNet:          sdk.ZeroDec(),
+		FeeGrowthOutsideBase:  sdk.ZeroDec(),
+		FeeGrowthOutsideQuote: sdk.ZeroDec(),
+	}
+}
+
+// ConcentratedPosition is the non-fungible record of the liquidity provided to a concentrated pool within the ticks
+type ConcentratedPosition struct {
+	ID                       uint64         `json:"id"`
+	Owner                    sdk.AccAddress `json:"owner"`
+	TokenPairName            string         `json:"token_pair_name"`
+	LowerTick                int64          `json:"lower_tick"`
+	UpperTick                int64          `json:"upper_tick"`
+	Liquidity                sdk.Dec        `json:"liquidity"`
+	FeeGrowthInsideBaseLast  sdk.Dec        `json:"fee_growth_inside_base_last"`  // The base token fee growth inside the ticks when the fees are accrued last time
+	FeeGrowthInsideQuoteLast sdk.Dec        `json:"fee_growth_inside_quote_last"` // The quote token fee growth inside the ticks when the fees are accrued last time
+	TokensOwedBase           sdk.Dec        `json:"tokens_owed_base"`             // The base token fees accrued and not collected
+	TokensOwedQuote          sdk.Dec        `json:"tokens_owed_quote"`            // The quote token fees accrued and not collected
+}
+
+// NewConcentratedPosition creates a position without liquidity
+func NewConcentratedPosition(id uint64, owner sdk.AccAddress, tokenPairName string, lowerTick, upperTick int64) ConcentratedPosition {
+	return ConcentratedPosition{
+		ID:                       id,
+		Owner:                    owner,
+		TokenPairName:            tokenPairName,
+		LowerTick:                lowerTick,
+		UpperTick:                upperTick,
+		Liquidity:                sdk.ZeroDec(),
+		FeeGrowthInsideBaseLast:  sdk.ZeroDec(),
+		FeeGrowthInsideQuoteLast: sdk.ZeroDec(),
+		TokensOwedBase:           sdk.ZeroDec(),
+		TokensOwedQuote:          sdk.ZeroDec(),
+	}
+}
+
+// String implement fmt.Stringer
+func (p ConcentratedPosition) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID: %d
+Owner: %s
+TokenPairName: %s
+Ticks: [%d, %d)
+Liquidity: %s
+TokensOwed: %s %s`, p.ID, p.Owner, p.TokenPairName, p.LowerTick, p.UpperTick, p.Liquidity,
+		p.TokensOwedBase, p.TokensOwedQuote))
+}
+
+// AccrueFees adds the fees earned since the last accrual to the tokens owed
+func (p *ConcentratedPosition) AccrueFees(feeGrowthInsideBase, feeGrowthInsideQuote sdk.Dec) {
+	p.TokensOwedBase = p.TokensOwedBase.Add(p.Liquidity.MulTruncate(feeGrowthInsideBase.Sub(p.FeeGrowthInsideBaseLast)))
+	p.TokensOwedQuote = p.TokensOwedQuote.Add(p.Liquidity.MulTruncate(feeGrowthInsideQuote.Sub(p.FeeGrowthInsideQuoteLast)))
+	p.FeeGrowthInsideBaseLast = feeGrowthInsideBase
+	p.FeeGrowthInsideQuoteLast = feeGrowthInsideQuote
+}
+
+// ValidateTickRange checks the ticks are in order and in the range of the ticks
+func ValidateTickRange(lowerTick, upperTick int64) sdk.Error {
+	if lowerTick >= upperTick || lowerTick < MinTick || upperTick > MaxTick {
+		return ErrInvalidTickRange(lowerTick, upperTick, 1)
+	}
+	return nil
+}
+
+// TickToSqrtPrice returns the square root of the price at the tick, which is sqrt(1.0001)^tick
+func TickToSqrtPrice(tick int64) sdk.Dec {
+	if tick < 0 {
+		return sdk.OneDec().Quo(tickBase.Power(uint64(-tick)))
+	}
+	return tickBase.Power(uint64(tick))
+}
+
+// SqrtPriceToTick returns the greatest tick whose square root price is not greater than the square root price
+func SqrtPriceToTick(sqrtPrice sdk.Dec) int64 {
+	low, high := MinTick, MaxTick
+	if sqrtPrice.LT(TickToSqrtPrice(low)) {
+		return low
+	}
+	for low < high {
+		mid := low + (high-low+1)/2
+		if TickToSqrtPrice(mid).LTE(sqrtPrice) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
+
+// GetAmountsForLiquidity returns the amounts of the tokens of the liquidity within the square root prices at the
+// current square root price
+func GetAmountsForLiquidity(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity sdk.Dec, roundUp bool) (base, quote sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return GetBaseAmountDelta(sqrtPriceLower, sqrtPriceUpper, liquidity, roundUp), sdk.ZeroDec()
+	case sqrtPrice.LT(sqrtPriceUpper):
+		return GetBaseAmountDelta(sqrtPrice, sqrtPriceUpper, liquidity, roundUp),
+			GetQuoteAmountDelta(sqrtPriceLower, sqrtPrice, liquidity, roundUp)
+	default:
+		return sdk.ZeroDec(), GetQuoteAmountDelta(sqrtPriceLower, sqrtPriceUpper, liquidity, roundUp)
+	}
+}
+
+// GetLiquidityForAmounts returns the max liquidity within the square root prices which could be provided with the
+// amounts of the tokens at the current square root price
+func GetLiquidityForAmounts(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, base, quote sdk.Dec) sdk.Dec {
+	liquidityForBase := func(sqrtA sdk.Dec) sdk.Dec {
+		return mulDiv(mulDiv(base, sqrtA, sdk.OneDec(), false), sqrtPriceUpper, sqrtPriceUpper.Sub(sqrtA), false)
+	}
+	liquidityForQuote := func(sqrtB sdk.Dec) sdk.Dec {
+		return mulDiv(quote, sdk.OneDec(), sqrtB.Sub(sqrtPriceLower), false)
+	}
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return liquidityForBase(sqrtPriceLower)
+	case sqrtPrice.LT(sqrtPriceUpper):
+		return sdk.MinDec(liquidityForBase(sqrtPrice), liquidityForQuote(sqrtPrice))
+	default:
+		return liquidityForQuote(sqrtPriceUpper)
+	}
+}
+
+// GetBaseAmountDelta returns the amount of the base token between the square root prices a < b of the liquidity,
+// which is liquidity * (b - a) / (a * b)
+func GetBaseAmountDelta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	return mulDiv(mulDiv(liquidity, sqrtPriceB.Sub(sqrtPriceA), sqrtPriceB, roundUp), sdk.OneDec(), sqrtPriceA, roundUp)
+}
+
+// GetQuoteAmountDelta returns the amount of the quote token between the square root prices a < b of the liquidity,
+// which is liquidity * (b - a)
+func GetQuoteAmountDelta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	return mulDiv(liquidity, sqrtPriceB.Sub(sqrtPriceA), sdk.OneDec(), roundUp)
+}
+
+// ConcentratedSwapStep is the result of a swap within the liquidity between two initialized ticks
+type ConcentratedSwapStep struct {
+	SqrtPriceNext sdk.Dec
+	AmountIn      sdk.Dec
+	AmountOut     sdk.Dec
+	FeeAmount     sdk.Dec
+}
+
+// ComputeSwapStep swaps the amount of the token in, the fee included, toward the target square root price with the
+// liquidity. The base token is sold if the target is lower than the current square root price, otherwise the quote
+// token is sold. The price stops at the target if the amount is enough to reach it.
+func ComputeSwapStep(sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, feeRate sdk.Dec) ConcentratedSwapStep {
+	sellBase := sqrtPriceTarget.LT(sqrtPrice)
+	amountInToTarget := func(sqrtPriceNext sdk.Dec) sdk.Dec {
+		if sellBase {
+			return GetBaseAmountDelta(sqrtPriceNext, sqrtPrice, liquidity, true)
+		}
+		return GetQuoteAmountDelta(sqrtPrice, sqrtPriceNext, liquidity, true)
+	}
+
+	step := ConcentratedSwapStep{SqrtPriceNext: sqrtPriceTarget}
+	amountRemainingLessFee := amountRemaining.MulTruncate(sdk.OneDec().Sub(feeRate))
+	step.AmountIn = amountInToTarget(sqrtPriceTarget)
+	reachTarget := amountRemainingLessFee.GTE(step.AmountIn)
+	if !reachTarget {
+		if sellBase {
+			// sqrt price next = liquidity * sqrt price / (liquidity + amount in * sqrt price)
+			step.SqrtPriceNext = mulDiv(liquidity, sqrtPrice,
+				liquidity.Add(mulDiv(amountRemainingLessFee, sqrtPrice, sdk.OneDec(), true)), true)
+		} else {
+			// sqrt price next = sqrt price + amount in / liquidity
+			step.SqrtPriceNext = sqrtPrice.Add(mulDiv(amountRemainingLessFee, sdk.OneDec(), liquidity, false))
+		}
+		step.AmountIn = amountInToTarget(step.SqrtPriceNext)
+	}
+
+	if sellBase {
+		step.AmountOut = GetQuoteAmountDelta(step.SqrtPriceNext, sqrtPrice, liquidity, false)
+	} else {
+		step.AmountOut = GetBaseAmountDelta(sqrtPrice, step.SqrtPriceNext, liquidity, false)
+	}
+
+	if step.AmountIn.GT(amountRemaining) {
+		step.AmountIn = amountRemaining
+	}
+	if reachTarget {
+		step.FeeAmount = sdk.MinDec(mulDiv(step.AmountIn, feeRate, sdk.OneDec().Sub(feeRate), true),
+			amountRemaining.Sub(step.AmountIn))
+	} else {
+		// the remainder of the amount goes to the fee
+		step.FeeAmount = amountRemaining.Sub(step.AmountIn)
+	}
+	return step
+}
+
+// mulDiv returns a * b / c in the precision of sdk.Dec, which is rounded up or truncated
+func mulDiv(a, b, c sdk.Dec, roundUp bool) sdk.Dec {
+	quo, rem := new(big.Int).QuoRem(new(big.Int).Mul(a.BigInt(), b.BigInt()), c.BigInt(), new(big.Int))
+	if roundUp && rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdk.NewDecFromBigIntWithPrec(quo, sdk.Precision)
+}
+
+func mustApproxSqrt(d sdk.Dec) sdk.Dec {
+	sqrt, err := d.ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+	return sqrt
+}
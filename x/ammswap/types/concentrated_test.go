@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), TickToSqrtPrice(0))
+	// 1.0001^6932 is about 2
+	price := TickToSqrtPrice(6932)
+	require.True(t, price.Mul(price).Sub(sdk.NewDec(2)).Abs().LT(sdk.NewDecWithPrec(1, 3)))
+	price = TickToSqrtPrice(-6932)
+	require.True(t, price.Mul(price).Sub(sdk.NewDecWithPrec(5, 1)).Abs().LT(sdk.NewDecWithPrec(1, 3)))
+
+	for _, tick := range []int64{MinTick, -6932, -1, 0, 1, 60, 6932, MaxTick} {
+		require.Equal(t, tick, SqrtPriceToTick(TickToSqrtPrice(tick)))
+	}
+	// the tick is rounded down to the one whose price isn't greater than the price
+	require.Equal(t, int64(6931), SqrtPriceToTick(TickToSqrtPrice(6932).Sub(sdk.SmallestDec())))
+}
+
+func TestGetAmountsForLiquidity(t *testing.T) {
+	sqrtLower, sqrtUpper := TickToSqrtPrice(-600), TickToSqrtPrice(600)
+	base, quote := sdk.NewDec(1000), sdk.NewDec(1000)
+
+	// in range, the liquidity is limited by both of the tokens
+	liquidity := GetLiquidityForAmounts(sdk.OneDec(), sqrtLower, sqrtUpper, base, quote)
+	baseAmount, quoteAmount := GetAmountsForLiquidity(sdk.OneDec(), sqrtLower, sqrtUpper, liquidity, true)
+	require.True(t, baseAmount.LTE(base))
+	require.True(t, quoteAmount.LTE(quote))
+	require.True(t, base.Sub(baseAmount).LT(sdk.NewDecWithPrec(1, 6)))
+
+	// below the range, only the base token is provided
+	baseAmount, quoteAmount = GetAmountsForLiquidity(sqrtLower.Sub(sdk.SmallestDec()), sqrtLower, sqrtUpper, liquidity, true)
+	require.True(t, baseAmount.IsPositive())
+	require.True(t, quoteAmount.IsZero())
+
+	// above the range, only the quote token is provided
+	baseAmount, quoteAmount = GetAmountsForLiquidity(sqrtUpper, sqrtLower, sqrtUpper, liquidity, true)
+	require.True(t, baseAmount.IsZero())
+	require.True(t, quoteAmount.IsPositive())
+
+	// the amounts withdrawn are never greater than the ones deposited
+	depositBase, depositQuote := GetAmountsForLiquidity(sdk.OneDec(), sqrtLower, sqrtUpper, liquidity, true)
+	withdrawBase, withdrawQuote := GetAmountsForLiquidity(sdk.OneDec(), sqrtLower, sqrtUpper, liquidity, false)
+	require.True(t, withdrawBase.LTE(depositBase))
+	require.True(t, withdrawQuote.LTE(depositQuote))
+}
+
+func TestComputeSwapStep(t *testing.T) {
+	liquidity := sdk.NewDec(10000)
+	feeRate := sdk.NewDecWithPrec(3, 3)
+
+	// sell the quote token without reaching the target
+	step := ComputeSwapStep(sdk.OneDec(), TickToSqrtPrice(600), liquidity, sdk.NewDec(10), feeRate)
+	require.True(t, step.SqrtPriceNext.GT(sdk.OneDec()))
+	require.True(t, step.SqrtPriceNext.LT(TickToSqrtPrice(600)))
+	require.Equal(t, sdk.NewDec(10), step.AmountIn.Add(step.FeeAmount))
+	require.True(t, step.AmountOut.LT(sdk.NewDec(10)))
+	require.True(t, step.AmountOut.GT(sdk.NewDecWithPrec(99, 1)))
+
+	// sell the base token reaching the target, the amount left is not used up
+	target := TickToSqrtPrice(-60)
+	step = ComputeSwapStep(sdk.OneDec(), target, liquidity, sdk.NewDec(1000), feeRate)
+	require.Equal(t, target, step.SqrtPriceNext)
+	require.True(t, step.AmountIn.Add(step.FeeAmount).LT(sdk.NewDec(1000)))
+	require.Equal(t, GetQuoteAmountDelta(target, sdk.OneDec(), liquidity, false), step.AmountOut)
+}
+
+func TestConcentratedPoolValidateTickRange(t *testing.T) {
+	pool := NewConcentratedPool(TestBasePooledToken, TestQuotePooledToken, sdk.ZeroDec(), DefaultTickSpacing, sdk.OneDec())
+	require.Equal(t, TestSwapTokenPairName, pool.TokenPairName())
+	require.Equal(t, int64(0), pool.CurrentTick)
+
+	require.Nil(t, pool.ValidateTickRange(-600, 600))
+	require.NotNil(t, pool.ValidateTickRange(600, -600))
+	require.NotNil(t, pool.ValidateTickRange(-50, 600))
+	require.NotNil(t, pool.ValidateTickRange(-600, MaxTick+60))
+}
+
+func TestMsgCreateConcentratedExchange(t *testing.T) {
+	addr, err := sdk.AccAddressFromHex(addrStr)
+	require.Nil(t, err)
+
+	tests := []struct {
+		testCase     string
+		msg          MsgCreateExchange
+		expectedCode uint32
+	}{
+		{"success", NewMsgCreateConcentratedExchange(TestBasePooledToken, TestQuotePooledToken, sdk.ZeroDec(), sdk.OneDec(), 0, addr), sdk.CodeOK},
+		{"invalid pool type", MsgCreateExchange{Token0Name: TestBasePooledToken, Token1Name: TestQuotePooledToken, PoolType: "x", Sender: addr}, CodeInvalidPoolType},
+		{"no initial price", NewMsgCreateConcentratedExchange(TestBasePooledToken, TestQuotePooledToken, sdk.ZeroDec(), sdk.ZeroDec(), 0, addr), CodeInvalidInitialPrice},
+		{"invalid tick spacing", NewMsgCreateConcentratedExchange(TestBasePooledToken, TestQuotePooledToken, sdk.ZeroDec(), sdk.OneDec(), MaxTickSpacing+1, addr), CodeInvalidTickRange},
+	}
+	for _, testCase := range tests {
+		err := testCase.msg.ValidateBasic()
+		testCode(t, err, testCase.expectedCode)
+	}
+	require.Equal(t, DefaultTickSpacing, tests[0].msg.GetTickSpacing())
+}
@@ -56,6 +56,23 @@ const (
 	CodeIsSwapTokenPairExist                 uint32 = 65043
 	CodeIsPoolTokenPairExist                 uint32 = 65044
 	CodeInternalError                        uint32 = 65045
+	CodeInvalidSwapRoute                     uint32 = 65046
+	CodeInsufficientPoolLiquidity            uint32 = 65047
+	CodeBoughtTokenAmount                    uint32 = 65048
+	CodeMaxSoldTokenAmount                   uint32 = 65049
+	CodeSwapRouteNotFound                    uint32 = 65050
+	CodeInvalidTWAPWindow                    uint32 = 65051
+	CodeSwapPriceObservationNotFound         uint32 = 65052
+	CodeInvalidFeeRate                       uint32 = 65053
+	CodeWithdrawProtocolFeeFailed            uint32 = 65054
+	CodeInvalidPoolType                      uint32 = 65055
+	CodeInvalidTickRange                     uint32 = 65056
+	CodeInvalidInitialPrice                  uint32 = 65057
+	CodeNonExistConcentratedPosition         uint32 = 65058
+	CodeNotConcentratedPositionOwner         uint32 = 65059
+	CodeInvalidStablePoolTokens              uint32 = 65060
+	CodeInvalidAmplification                 uint32 = 65061
+	CodeNonExistStablePool                   uint32 = 65062
 )
 
 func ErrNonExistSwapTokenPair(tokenPairName string) sdk.EnvelopedErr {
@@ -239,3 +256,71 @@ func ErrSwapTokenPairExist() sdk.EnvelopedErr {
 func ErrPoolTokenPairExist() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeIsPoolTokenPairExist, "the pool token pair already exists")}
 }
+
+func ErrInvalidSwapRoute(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidSwapRoute, fmt.Sprintf("invalid swap route: %s", msg))}
+}
+
+func ErrInsufficientPoolLiquidity(tokenPairName string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientPoolLiquidity, fmt.Sprintf("insufficient liquidity of swap token pair: %s", tokenPairName))}
+}
+
+func ErrBoughtTokenAmount() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeBoughtTokenAmount, "bought token amount is not positive or not validate denom")}
+}
+
+func ErrMaxSoldTokenAmount() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeMaxSoldTokenAmount, "max sold token amount is negative or not validate denom")}
+}
+
+func ErrSwapRouteNotFound(soldToken, boughtToken string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSwapRouteNotFound, fmt.Sprintf("no swap route from %s to %s", soldToken, boughtToken))}
+}
+
+func ErrInvalidTWAPWindow(startHeight, endHeight, blockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTWAPWindow, fmt.Sprintf("invalid twap window [%d, %d) at block height %d", startHeight, endHeight, blockHeight))}
+}
+
+func ErrSwapPriceObservationNotFound(tokenPairName string, blockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSwapPriceObservationNotFound, fmt.Sprintf("no price observation of swap token pair %s at block height %d", tokenPairName, blockHeight))}
+}
+
+func ErrInvalidFeeRate(feeRate sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFeeRate, fmt.Sprintf("fee rate %s is not one of the fee tiers", feeRate))}
+}
+
+func ErrWithdrawProtocolFeeFailed(reason string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeWithdrawProtocolFeeFailed, fmt.Sprintf("failed to withdraw protocol fee: %s", reason))}
+}
+
+func ErrInvalidPoolType(poolType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidPoolType, fmt.Sprintf("invalid pool type: %s", poolType))}
+}
+
+func ErrInvalidTickRange(lowerTick, upperTick, tickSpacing int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTickRange, fmt.Sprintf("invalid tick range [%d, %d), the ticks should be in order, in [%d, %d] and multiples of tick spacing %d", lowerTick, upperTick, MinTick, MaxTick, tickSpacing))}
+}
+
+func ErrInvalidInitialPrice(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidInitialPrice, fmt.Sprintf("invalid initial price of the concentrated pool: %s", msg))}
+}
+
+func ErrNonExistConcentratedPosition(id uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNonExistConcentratedPosition, fmt.Sprintf("concentrated position %d does not exist", id))}
+}
+
+func ErrNotConcentratedPositionOwner(id uint64, addr sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotConcentratedPositionOwner, fmt.Sprintf("%s is not the owner of concentrated position %d", addr, id))}
+}
+
+func ErrInvalidStablePoolTokens(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidStablePoolTokens, fmt.Sprintf("invalid tokens of the stable pool: %s", msg))}
+}
+
+func ErrInvalidAmplification(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAmplification, fmt.Sprintf("invalid amplification of the stable pool: %s", msg))}
+}
+
+func ErrNonExistStablePool(name string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNonExistStablePool, fmt.Sprintf("stable pool %s does not exist", name))}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
 	"github.com/okex/exchain/x/params"
 	token "github.com/okex/exchain/x/token/types"
 )
@@ -10,6 +11,7 @@ import (
 type ParamSubspace interface {
 	WithKeyTable(table params.KeyTable) params.Subspace
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
 }
@@ -27,6 +29,7 @@ type SupplyKeeper interface {
 		recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress,
 		recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
 }
@@ -39,8 +42,14 @@ type TokenKeeper interface {
 	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.SysCoins
 	TokenExist(ctx sdk.Context, symbol string) bool
 	GetTokensInfo(ctx sdk.Context) (tokens []token.Token)
+	CheckCoinsTransferable(ctx sdk.Context, from sdk.AccAddress, coins sdk.SysCoins) error
 }
 
+// GovKeeper defines the expected gov Keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
 
 type BackendKeeper interface {
 	OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin)
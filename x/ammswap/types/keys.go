@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "ammswap"
@@ -13,6 +17,9 @@ const (
 	// QuerierRoute to be used for querier msgs
 	QuerierRoute = ModuleName
 
+	// ProtocolFeeAccount is the module account collecting the protocol fees of the swaps
+	ProtocolFeeAccount = "swap_protocol_fee_account"
+
 	// QuerySwapTokenPair query endpoints supported by the swap Querier
 	QuerySwapTokenPair         = "swapTokenPair"
 	QuerySwapTokenPairs        = "swapTokenPairs"
@@ -21,14 +28,90 @@ const (
 	QueryBuyAmount             = "buy"
 	QuerySwapQuoteInfo         = "swapQuoteInfo"
 	QuerySwapAddLiquidityQuote = "swapAddLiquidityQuote"
+	QuerySwapRoute             = "swapRoute"
+	QuerySwapRouteExactOutput  = "swapRouteExactOutput"
+	QuerySwapTWAP              = "swapTWAP"
+	QueryProtocolFees          = "protocolFees"
+	QueryConcentratedPool      = "concentratedPool"
+	QueryConcentratedPosition  = "concentratedPosition"
+	QueryConcentratedPositions = "concentratedPositions"
+	QueryStablePool            = "stablePool"
 )
 
 var (
 	// TokenPairPrefixKey to be used for KVStore
 	TokenPairPrefixKey = []byte{0x01}
+	// SwapPriceObservationPrefixKey to be used for KVStore
+	SwapPriceObservationPrefixKey = []byte{0x02}
+	// ConcentratedPoolPrefixKey to be used for KVStore
+	ConcentratedPoolPrefixKey = []byte{0x03}
+	// ConcentratedTickPrefixKey to be used for KVStore
+	ConcentratedTickPrefixKey = []byte{0x04}
+	// ConcentratedPositionPrefixKey to be used for KVStore
+	ConcentratedPositionPrefixKey = []byte{0x05}
+	// NextConcentratedPositionIDKey to be used for KVStore
+	NextConcentratedPositionIDKey = []byte{0x06}
+	// StablePoolPrefixKey to be used for KVStore
+	StablePoolPrefixKey = []byte{0x07}
+	// StablePoolTokenPairPrefixKey to be used for KVStore, which indexes the stable pools by the token pairs in them
+	StablePoolTokenPairPrefixKey = []byte{0x08}
 )
 
 // nolint
 func GetTokenPairKey(key string) []byte {
 	return append(TokenPairPrefixKey, []byte(key)...)
 }
+
+// GetSwapPriceObservationPrefix returns the key prefix of the price observations of the swap token pair
+func GetSwapPriceObservationPrefix(tokenPairName string) []byte {
+	prefix := append(SwapPriceObservationPrefixKey, byte(len(tokenPairName)))
+	return append(prefix, []byte(tokenPairName)...)
+}
+
+// SplitSwapPriceObservationKey returns the name of the swap token pair of the price observation key
+func SplitSwapPriceObservationKey(key []byte) string {
+	nameLen := int(key[len(SwapPriceObservationPrefixKey)])
+	nameStart := len(SwapPriceObservationPrefixKey) + 1
+	return string(key[nameStart : nameStart+nameLen])
+}
+
+// GetSwapPriceObservationKey returns the key of the price observation of the swap token pair at the block height
+func GetSwapPriceObservationKey(tokenPairName string, blockHeight int64) []byte {
+	return append(GetSwapPriceObservationPrefix(tokenPairName), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetConcentratedPoolKey returns the key of the concentrated pool of the token pair
+func GetConcentratedPoolKey(tokenPairName string) []byte {
+	return append(ConcentratedPoolPrefixKey, []byte(tokenPairName)...)
+}
+
+// GetConcentratedTickPrefix returns the key prefix of the initialized ticks of the concentrated pool
+func GetConcentratedTickPrefix(tokenPairName string) []byte {
+	prefix := append(ConcentratedTickPrefixKey, byte(len(tokenPairName)))
+	return append(prefix, []byte(tokenPairName)...)
+}
+
+// GetConcentratedTickKey returns the key of the tick of the concentrated pool
+func GetConcentratedTickKey(tokenPairName string, tick int64) []byte {
+	return append(GetConcentratedTickPrefix(tokenPairName), TickToBytes(tick)...)
+}
+
+// TickToBytes encodes the tick in the big endian with the sign bit flipped, so that the ticks are iterated in order
+func TickToBytes(tick int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(tick) ^ (1 << 63))
+}
+
+// GetConcentratedPositionKey returns the key of the concentrated position
+func GetConcentratedPositionKey(id uint64) []byte {
+	return append(ConcentratedPositionPrefixKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetStablePoolKey returns the key of the stable pool
+func GetStablePoolKey(name string) []byte {
+	return append(StablePoolPrefixKey, []byte(name)...)
+}
+
+// GetStablePoolTokenPairKey returns the key of the stable pool index of the token pair
+func GetStablePoolTokenPairKey(tokenPairName string) []byte {
+	return append(StablePoolTokenPairPrefixKey, []byte(tokenPairName)...)
+}
@@ -32,6 +32,8 @@ func TestMsgCreateExchange(t *testing.T) {
 	require.Equal(t, "create_exchange", msg.Type())
 
 	bytesMsg := msg.GetSignBytes()
+	// the sign bytes are the same as the ones before the fee tiers
+	require.NotContains(t, string(bytesMsg), "fee_rate")
 	resMsg := &MsgCreateExchange{}
 	err = json.Unmarshal(bytesMsg, resMsg)
 	require.Nil(t, err)
@@ -69,6 +71,26 @@ func TestMsgCreateExchangeInvalid(t *testing.T) {
 	}
 }
 
+func TestMsgCreateExchangeWithFeeRate(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	tests := []struct {
+		testCase         string
+		feeRate          sdk.Dec
+		exceptResultCode uint32
+	}{
+		{"success", sdk.NewDecWithPrec(5, 4), sdk.CodeOK},
+		{"default fee rate", sdk.ZeroDec(), sdk.CodeOK},
+		{"negative fee rate", sdk.NewDecWithPrec(-5, 4), CodeInvalidFeeRate},
+		{"fee rate too large", sdk.OneDec(), CodeInvalidFeeRate},
+	}
+	for _, testCase := range tests {
+		msg := NewMsgCreateExchangeWithFeeRate("aaa", common.NativeToken, testCase.feeRate, addr)
+		err := msg.ValidateBasic()
+		testCode(t, err, testCase.exceptResultCode)
+	}
+}
+
 func TestMsgAddLiquidity(t *testing.T) {
 	addr, err := hex.DecodeString(addrStr)
 	require.Nil(t, err)
@@ -264,3 +286,86 @@ func TestMsgTokenToTokenInvalid(t *testing.T) {
 		testCode(t, err, testCase.exceptResultCode)
 	}
 }
+
+func TestMsgTokenToTokenByRoute(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken2, sdk.NewDec(1))
+	deadLine := time.Now().Unix()
+	soldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(2))
+	msg := NewMsgTokenToTokenByRoute(soldTokenAmount, minBoughtTokenAmount, []string{TestQuotePooledToken}, deadLine, addr, addr)
+
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgTokenSwapByRoute, msg.Type())
+	require.Equal(t, []string{TestBasePooledToken, TestQuotePooledToken, TestBasePooledToken2}, msg.GetSwapPath())
+
+	bytesMsg := msg.GetSignBytes()
+	resMsg := &MsgTokenToTokenByRoute{}
+	err = json.Unmarshal(bytesMsg, resMsg)
+	require.Nil(t, err)
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	zeroSoldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(0))
+	tests := []struct {
+		testCase         string
+		soldTokenAmount  sdk.SysCoin
+		route            []string
+		recipient        sdk.AccAddress
+		addr             sdk.AccAddress
+		exceptResultCode uint32
+	}{
+		{"success", soldTokenAmount, []string{TestQuotePooledToken}, addr, addr, sdk.CodeOK},
+		{"success(direct)", soldTokenAmount, nil, addr, addr, sdk.CodeOK},
+		{"empty sender", soldTokenAmount, nil, addr, nil, sdk.CodeInvalidAddress},
+		{"empty recipient", soldTokenAmount, nil, nil, addr, sdk.CodeInvalidAddress},
+		{"invalid SoldTokenAmount(zero)", zeroSoldTokenAmount, nil, addr, addr, sdk.CodeUnknownRequest},
+		{"repeated token", soldTokenAmount, []string{TestQuotePooledToken, TestBasePooledToken}, addr, addr, sdk.CodeUnknownRequest},
+		{"invalid route token", soldTokenAmount, []string{"1aaa"}, addr, addr, sdk.CodeUnknownRequest},
+		{"too many hops", soldTokenAmount, []string{"eeb", "ffb", "ggb", "hhb"}, addr, addr, sdk.CodeUnknownRequest},
+	}
+	for _, testCase := range tests {
+		msg := NewMsgTokenToTokenByRoute(testCase.soldTokenAmount, minBoughtTokenAmount, testCase.route, deadLine, testCase.recipient, testCase.addr)
+		testCode(t, msg.ValidateBasic(), testCase.exceptResultCode)
+	}
+}
+
+func TestMsgTokenToExactToken(t *testing.T) {
+	addr, err := hex.DecodeString(addrStr)
+	require.Nil(t, err)
+	boughtTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken2, sdk.NewDec(1))
+	deadLine := time.Now().Unix()
+	maxSoldTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(2))
+	msg := NewMsgTokenToExactToken(maxSoldTokenAmount, boughtTokenAmount, []string{TestQuotePooledToken}, deadLine, addr, addr)
+
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgTokenSwapExactOutput, msg.Type())
+	require.Equal(t, []string{TestBasePooledToken, TestQuotePooledToken, TestBasePooledToken2}, msg.GetSwapPath())
+
+	bytesMsg := msg.GetSignBytes()
+	resMsg := &MsgTokenToExactToken{}
+	err = json.Unmarshal(bytesMsg, resMsg)
+	require.Nil(t, err)
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	zeroBoughtTokenAmount := sdk.NewDecCoinFromDec(TestBasePooledToken2, sdk.NewDec(0))
+	tests := []struct {
+		testCase          string
+		boughtTokenAmount sdk.SysCoin
+		route             []string
+		recipient         sdk.AccAddress
+		addr              sdk.AccAddress
+		exceptResultCode  uint32
+	}{
+		{"success", boughtTokenAmount, []string{TestQuotePooledToken}, addr, addr, sdk.CodeOK},
+		{"empty sender", boughtTokenAmount, nil, addr, nil, sdk.CodeInvalidAddress},
+		{"empty recipient", boughtTokenAmount, nil, nil, addr, sdk.CodeInvalidAddress},
+		{"invalid BoughtTokenAmount(zero)", zeroBoughtTokenAmount, nil, addr, addr, sdk.CodeUnknownRequest},
+		{"same token", sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(1)), nil, addr, addr, sdk.CodeUnknownRequest},
+	}
+	for _, testCase := range tests {
+		msg := NewMsgTokenToExactToken(maxSoldTokenAmount, testCase.boughtTokenAmount, testCase.route, deadLine, testCase.recipient, testCase.addr)
+		testCode(t, msg.ValidateBasic(), testCase.exceptResultCode)
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
@@ -8,15 +10,24 @@ import (
 const (
 	TypeMsgAddLiquidity = "add_liquidity"
 	TypeMsgTokenSwap    = "token_swap"
+
+	TypeMsgTokenSwapByRoute     = "token_swap_by_route"
+	TypeMsgTokenSwapExactOutput = "token_swap_exact_output"
+
+	TypeMsgAddConcentratedLiquidity     = "add_concentrated_liquidity"
+	TypeMsgRemoveConcentratedLiquidity  = "remove_concentrated_liquidity"
+	TypeMsgCollectConcentratedFees      = "collect_concentrated_fees"
+	TypeMsgTransferConcentratedPosition = "transfer_concentrated_position"
 )
 
 // MsgAddLiquidity Deposit quote_amount and base_amount at current ratio to mint pool tokens.
 type MsgAddLiquidity struct {
-	MinLiquidity  sdk.Dec        `json:"min_liquidity"`   // Minimum number of sender will mint if total pool token supply is greater than 0.
-	MaxBaseAmount sdk.SysCoin    `json:"max_base_amount"` // Maximum number of tokens deposited. Deposits max amount if total pool token supply is 0.
-	QuoteAmount   sdk.SysCoin    `json:"quote_amount"`    // Quote token amount
-	Deadline      int64          `json:"deadline"`        // Time after which this transaction can no longer be executed.
-	Sender        sdk.AccAddress `json:"sender"`          // Sender
+	MinLiquidity  sdk.Dec        `json:"min_liquidity"`           // Minimum number of sender will mint if total pool token supply is greater than 0.
+	MaxBaseAmount sdk.SysCoin    `json:"max_base_amount"`         // Maximum number of tokens deposited. Deposits max amount if total pool token supply is 0.
+	QuoteAmount   sdk.SysCoin    `json:"quote_amount"`            // Quote token amount
+	Deadline      int64          `json:"deadline"`                // Time after which this transaction can no longer be executed.
+	Sender        sdk.AccAddress `json:"sender"`                  // Sender
+	ExtraAmounts  sdk.SysCoins   `json:"extra_amounts,omitempty"` // The amounts of the other tokens deposited into the stable pool of more than two tokens
 }
 
 // NewMsgAddLiquidity is a constructor function for MsgAddLiquidity
@@ -57,6 +68,14 @@ func (msg MsgAddLiquidity) ValidateBasic() sdk.Error {
 	if err != nil {
 		return err
 	}
+	if len(msg.ExtraAmounts) > 0 {
+		for _, amount := range msg.ExtraAmounts {
+			if !amount.IsValid() || !amount.IsPositive() {
+				return ErrMaxBaseAmountOrQuoteAmountIsNegative()
+			}
+		}
+		return ValidateStablePoolTokens(coinDenoms(msg.GetAmounts()))
+	}
 
 	return nil
 }
@@ -76,13 +95,24 @@ func (msg MsgAddLiquidity) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MaxBaseAmount.Denom, msg.QuoteAmount.Denom)
 }
 
+// GetAmounts returns the amounts of all the tokens deposited
+func (msg MsgAddLiquidity) GetAmounts() sdk.SysCoins {
+	return append(sdk.SysCoins{msg.MaxBaseAmount, msg.QuoteAmount}, msg.ExtraAmounts...)
+}
+
+// GetStablePoolName returns the name of the stable pool the tokens are deposited into
+func (msg MsgAddLiquidity) GetStablePoolName() string {
+	return GetStablePoolName(coinDenoms(msg.GetAmounts()))
+}
+
 // MsgRemoveLiquidity burns pool tokens to withdraw okt and Tokens at current ratio.
 type MsgRemoveLiquidity struct {
-	Liquidity      sdk.Dec        `json:"liquidity"`        // Amount of pool token burned.
-	MinBaseAmount  sdk.SysCoin    `json:"min_base_amount"`  // Minimum base amount.
-	MinQuoteAmount sdk.SysCoin    `json:"min_quote_amount"` // Minimum quote amount.
-	Deadline       int64          `json:"deadline"`         // Time after which this transaction can no longer be executed.
-	Sender         sdk.AccAddress `json:"sender"`           // Sender
+	Liquidity       sdk.Dec        `json:"liquidity"`                   // Amount of pool token burned.
+	MinBaseAmount   sdk.SysCoin    `json:"min_base_amount"`             // Minimum base amount.
+	MinQuoteAmount  sdk.SysCoin    `json:"min_quote_amount"`            // Minimum quote amount.
+	Deadline        int64          `json:"deadline"`                    // Time after which this transaction can no longer be executed.
+	Sender          sdk.AccAddress `json:"sender"`                      // Sender
+	ExtraMinAmounts sdk.SysCoins   `json:"extra_min_amounts,omitempty"` // Minimum amounts of the other tokens withdrawn from the stable pool of more than two tokens
 }
 
 // NewMsgRemoveLiquidity is a constructor function for MsgAddLiquidity
@@ -120,6 +150,14 @@ func (msg MsgRemoveLiquidity) ValidateBasic() sdk.Error {
 	if err != nil {
 		return err
 	}
+	if len(msg.ExtraMinAmounts) > 0 {
+		for _, amount := range msg.ExtraMinAmounts {
+			if !amount.IsValid() {
+				return ErrMinBaseAmount()
+			}
+		}
+		return ValidateStablePoolTokens(coinDenoms(msg.GetMinAmounts()))
+	}
 	return nil
 }
 
@@ -138,22 +176,67 @@ func (msg MsgRemoveLiquidity) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MinBaseAmount.Denom, msg.MinQuoteAmount.Denom)
 }
 
+// GetMinAmounts returns the minimum amounts of all the tokens withdrawn
+func (msg MsgRemoveLiquidity) GetMinAmounts() sdk.SysCoins {
+	return append(sdk.SysCoins{msg.MinBaseAmount, msg.MinQuoteAmount}, msg.ExtraMinAmounts...)
+}
+
+// GetStablePoolName returns the name of the stable pool the tokens are withdrawn from
+func (msg MsgRemoveLiquidity) GetStablePoolName() string {
+	return GetStablePoolName(coinDenoms(msg.GetMinAmounts()))
+}
+
 // MsgCreateExchange creates a new exchange with token
 type MsgCreateExchange struct {
-	Token0Name string         `json:"token0_name"`
-	Token1Name string         `json:"token1_name"`
-	Sender     sdk.AccAddress `json:"sender"` // Sender
+	Token0Name      string         `json:"token0_name"`
+	Token1Name      string         `json:"token1_name"`
+	Sender          sdk.AccAddress `json:"sender"`                      // Sender
+	FeeRate         sdk.Dec        `json:"fee_rate,omitempty"`          // One of the fee tiers of params, the default fee rate is used if it's empty or zero
+	PoolType        string         `json:"pool_type,omitempty"`         // The type of the pool, the constant product pool is created if it's empty
+	InitialPrice    sdk.Dec        `json:"initial_price,omitempty"`     // The initial price of the concentrated pool, in the amount of the quote token per base token
+	TickSpacing     int64          `json:"tick_spacing,omitempty"`      // The tick spacing of the concentrated pool, the default tick spacing is used if it's zero
+	ExtraTokenNames []string       `json:"extra_token_names,omitempty"` // The other tokens of the stable pool of more than two tokens
+	Amplification   int64          `json:"amplification,omitempty"`     // The amplification coefficient of the stable pool
 }
 
 // NewMsgCreateExchange create a new exchange with token
 func NewMsgCreateExchange(token0Name string, token1Name string, sender sdk.AccAddress) MsgCreateExchange {
+	return NewMsgCreateExchangeWithFeeRate(token0Name, token1Name, sdk.Dec{}, sender)
+}
+
+// NewMsgCreateExchangeWithFeeRate create a new exchange with token and the fee tier
+func NewMsgCreateExchangeWithFeeRate(token0Name string, token1Name string, feeRate sdk.Dec,
+	sender sdk.AccAddress) MsgCreateExchange {
 	return MsgCreateExchange{
 		Token0Name: token0Name,
 		Token1Name: token1Name,
 		Sender:     sender,
+		FeeRate:    feeRate,
 	}
 }
 
+// NewMsgCreateConcentratedExchange create a new concentrated pool with token at the initial price
+func NewMsgCreateConcentratedExchange(token0Name string, token1Name string, feeRate sdk.Dec, initialPrice sdk.Dec,
+	tickSpacing int64, sender sdk.AccAddress) MsgCreateExchange {
+	msg := NewMsgCreateExchangeWithFeeRate(token0Name, token1Name, feeRate, sender)
+	msg.PoolType = PoolTypeConcentrated
+	msg.InitialPrice = initialPrice
+	msg.TickSpacing = tickSpacing
+	return msg
+}
+
+// NewMsgCreateStableExchange create a new stable pool of the tokens with the amplification coefficient
+func NewMsgCreateStableExchange(tokenNames []string, feeRate sdk.Dec, amplification int64,
+	sender sdk.AccAddress) MsgCreateExchange {
+	msg := NewMsgCreateExchangeWithFeeRate(tokenNames[0], tokenNames[1], feeRate, sender)
+	msg.PoolType = PoolTypeStable
+	if len(tokenNames) > 2 {
+		msg.ExtraTokenNames = tokenNames[2:]
+	}
+	msg.Amplification = amplification
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgCreateExchange) Route() string { return RouterKey }
 
@@ -176,9 +259,59 @@ func (msg MsgCreateExchange) ValidateBasic() sdk.Error {
 	if msg.Token0Name == msg.Token1Name {
 		return ErrToken0NameEqualToken1Name()
 	}
+
+	if !msg.FeeRate.IsNil() && (msg.FeeRate.IsNegative() || msg.FeeRate.GTE(sdk.OneDec())) {
+		return ErrInvalidFeeRate(msg.FeeRate)
+	}
+
+	if !IsValidPoolType(msg.PoolType) {
+		return ErrInvalidPoolType(msg.PoolType)
+	}
+	// the extra tokens and the amplification coefficient are only for the stable pools
+	if msg.PoolType != PoolTypeStable && (len(msg.ExtraTokenNames) > 0 || msg.Amplification != 0) {
+		return ErrInvalidPoolType(msg.PoolType)
+	}
+	if msg.PoolType == PoolTypeStable {
+		if err := ValidateStablePoolTokens(msg.GetTokenNames()); err != nil {
+			return err
+		}
+		if msg.Amplification <= 0 || msg.Amplification > MaxAmplification {
+			return ErrInvalidAmplification(fmt.Sprintf("amplification %d should be in (0, %d]", msg.Amplification, MaxAmplification))
+		}
+	}
+	if msg.PoolType != PoolTypeConcentrated {
+		// the initial price and the tick spacing are only for the concentrated pools
+		if (!msg.InitialPrice.IsNil() && !msg.InitialPrice.IsZero()) || msg.TickSpacing != 0 {
+			return ErrInvalidPoolType(msg.PoolType)
+		}
+		return nil
+	}
+	if msg.InitialPrice.IsNil() || !msg.InitialPrice.IsPositive() {
+		return ErrInvalidInitialPrice("initial price should be positive")
+	}
+	sqrtPrice, err := msg.InitialPrice.ApproxSqrt()
+	if err != nil || sqrtPrice.LT(TickToSqrtPrice(MinTick)) || sqrtPrice.GT(TickToSqrtPrice(MaxTick)) {
+		return ErrInvalidInitialPrice(fmt.Sprintf("%s is out of the price range of the ticks", msg.InitialPrice))
+	}
+	if msg.TickSpacing < 0 || msg.TickSpacing > MaxTickSpacing {
+		return ErrInvalidTickRange(MinTick, MaxTick, msg.TickSpacing)
+	}
 	return nil
 }
 
+// GetTickSpacing returns the tick spacing of the concentrated pool to create
+func (msg MsgCreateExchange) GetTickSpacing() int64 {
+	if msg.TickSpacing == 0 {
+		return DefaultTickSpacing
+	}
+	return msg.TickSpacing
+}
+
+// GetTokenNames returns the names of all the tokens of the pool to create
+func (msg MsgCreateExchange) GetTokenNames() []string {
+	return append([]string{msg.Token0Name, msg.Token1Name}, msg.ExtraTokenNames...)
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgCreateExchange) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -265,3 +398,367 @@ func (msg MsgTokenToToken) GetSigners() []sdk.AccAddress {
 func (msg MsgTokenToToken) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, msg.SoldTokenAmount.Denom)
 }
+
+// MsgTokenToTokenByRoute define the message for swap the exact amount of token through the swap token pairs of
+// the route
+type MsgTokenToTokenByRoute struct {
+	SoldTokenAmount      sdk.SysCoin    `json:"sold_token_amount"`       // Amount of Tokens sold.
+	MinBoughtTokenAmount sdk.SysCoin    `json:"min_bought_token_amount"` // Minimum token purchased.
+	IntermediateTokens   []string       `json:"route"`                   // The intermediate tokens between the sold token and the bought token.
+	Deadline             int64          `json:"deadline"`                // Time after which this transaction can no longer be executed.
+	Recipient            sdk.AccAddress `json:"recipient"`               // Recipient address,transfer Tokens to recipient.default recipient is sender.
+	Sender               sdk.AccAddress `json:"sender"`                  // Sender
+}
+
+// NewMsgTokenToTokenByRoute is a constructor function for MsgTokenToTokenByRoute
+func NewMsgTokenToTokenByRoute(
+	soldTokenAmount, minBoughtTokenAmount sdk.SysCoin, route []string, deadline int64, recipient, sender sdk.AccAddress,
+) MsgTokenToTokenByRoute {
+	return MsgTokenToTokenByRoute{
+		SoldTokenAmount:      soldTokenAmount,
+		MinBoughtTokenAmount: minBoughtTokenAmount,
+		IntermediateTokens:   route,
+		Deadline:             deadline,
+		Recipient:            recipient,
+		Sender:               sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTokenToTokenByRoute) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTokenToTokenByRoute) Type() string { return TypeMsgTokenSwapByRoute }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTokenToTokenByRoute) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+
+	if !(msg.SoldTokenAmount.IsPositive()) {
+		return ErrSoldTokenAmountIsNegative()
+	}
+	if !msg.SoldTokenAmount.IsValid() {
+		return ErrSoldTokenAmount()
+	}
+
+	if !msg.MinBoughtTokenAmount.IsValid() {
+		return ErrMinBoughtTokenAmount()
+	}
+
+	return ValidateSwapPath(msg.GetSwapPath())
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTokenToTokenByRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTokenToTokenByRoute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSwapPath returns the tokens swapped in order
+func (msg MsgTokenToTokenByRoute) GetSwapPath() []string {
+	return GetSwapPath(msg.SoldTokenAmount.Denom, msg.IntermediateTokens, msg.MinBoughtTokenAmount.Denom)
+}
+
+// MsgTokenToExactToken define the message for swap token for the exact amount of token through the swap token
+// pairs of the route
+type MsgTokenToExactToken struct {
+	MaxSoldTokenAmount sdk.SysCoin    `json:"max_sold_token_amount"` // Maximum token sold.
+	BoughtTokenAmount  sdk.SysCoin    `json:"bought_token_amount"`   // Amount of Tokens purchased.
+	IntermediateTokens []string       `json:"route"`                 // The intermediate tokens between the sold token and the bought token.
+	Deadline           int64          `json:"deadline"`              // Time after which this transaction can no longer be executed.
+	Recipient          sdk.AccAddress `json:"recipient"`             // Recipient address,transfer Tokens to recipient.default recipient is sender.
+	Sender             sdk.AccAddress `json:"sender"`                // Sender
+}
+
+// NewMsgTokenToExactToken is a constructor function for MsgTokenToExactToken
+func NewMsgTokenToExactToken(
+	maxSoldTokenAmount, boughtTokenAmount sdk.SysCoin, route []string, deadline int64, recipient, sender sdk.AccAddress,
+) MsgTokenToExactToken {
+	return MsgTokenToExactToken{
+		MaxSoldTokenAmount: maxSoldTokenAmount,
+		BoughtTokenAmount:  boughtTokenAmount,
+		IntermediateTokens: route,
+		Deadline:           deadline,
+		Recipient:          recipient,
+		Sender:             sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTokenToExactToken) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTokenToExactToken) Type() string { return TypeMsgTokenSwapExactOutput }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTokenToExactToken) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+
+	if !msg.MaxSoldTokenAmount.IsValid() {
+		return ErrMaxSoldTokenAmount()
+	}
+
+	if !(msg.BoughtTokenAmount.IsPositive() && msg.BoughtTokenAmount.IsValid()) {
+		return ErrBoughtTokenAmount()
+	}
+
+	return ValidateSwapPath(msg.GetSwapPath())
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTokenToExactToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTokenToExactToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSwapPath returns the tokens swapped in order
+func (msg MsgTokenToExactToken) GetSwapPath() []string {
+	return GetSwapPath(msg.MaxSoldTokenAmount.Denom, msg.IntermediateTokens, msg.BoughtTokenAmount.Denom)
+}
+
+// MsgAddConcentratedLiquidity deposits tokens into a concentrated pool within the ticks. A new position is created if
+// the position id is zero, otherwise the liquidity is added to the position of the sender.
+type MsgAddConcentratedLiquidity struct {
+	PositionID     uint64         `json:"position_id"`      // The position to add liquidity to, a new position is created if it's zero
+	LowerTick      int64          `json:"lower_tick"`       // The lower tick of the new position
+	UpperTick      int64          `json:"upper_tick"`       // The upper tick of the new position
+	MaxBaseAmount  sdk.SysCoin    `json:"max_base_amount"`  // Maximum number of base tokens deposited
+	MaxQuoteAmount sdk.SysCoin    `json:"max_quote_amount"` // Maximum number of quote tokens deposited
+	MinLiquidity   sdk.Dec        `json:"min_liquidity"`    // Minimum liquidity added to the position
+	Deadline       int64          `json:"deadline"`         // Time after which this transaction can no longer be executed.
+	Sender         sdk.AccAddress `json:"sender"`           // Sender
+}
+
+// NewMsgAddConcentratedLiquidity is a constructor function for MsgAddConcentratedLiquidity
+func NewMsgAddConcentratedLiquidity(positionID uint64, lowerTick, upperTick int64, maxBaseAmount, maxQuoteAmount sdk.SysCoin,
+	minLiquidity sdk.Dec, deadline int64, sender sdk.AccAddress) MsgAddConcentratedLiquidity {
+	return MsgAddConcentratedLiquidity{
+		PositionID:     positionID,
+		LowerTick:      lowerTick,
+		UpperTick:      upperTick,
+		MaxBaseAmount:  maxBaseAmount,
+		MaxQuoteAmount: maxQuoteAmount,
+		MinLiquidity:   minLiquidity,
+		Deadline:       deadline,
+		Sender:         sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddConcentratedLiquidity) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddConcentratedLiquidity) Type() string { return TypeMsgAddConcentratedLiquidity }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAddConcentratedLiquidity) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+	if msg.MinLiquidity.IsNil() || msg.MinLiquidity.IsNegative() {
+		return ErrMinLiquidityIsNegative()
+	}
+	if !msg.MaxBaseAmount.IsValid() {
+		return ErrMaxBaseAmount()
+	}
+	if !msg.MaxQuoteAmount.IsValid() {
+		return ErrQuoteAmount()
+	}
+	if !msg.MaxBaseAmount.IsPositive() && !msg.MaxQuoteAmount.IsPositive() {
+		return ErrMaxBaseAmountOrQuoteAmountIsNegative()
+	}
+	if err := ValidateBaseAndQuoteAmount(msg.MaxBaseAmount.Denom, msg.MaxQuoteAmount.Denom); err != nil {
+		return err
+	}
+	return ValidateTickRange(msg.LowerTick, msg.UpperTick)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddConcentratedLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddConcentratedLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSwapTokenPairName defines token pair
+func (msg MsgAddConcentratedLiquidity) GetSwapTokenPairName() string {
+	return GetSwapTokenPairName(msg.MaxBaseAmount.Denom, msg.MaxQuoteAmount.Denom)
+}
+
+// MsgRemoveConcentratedLiquidity withdraws the liquidity from the concentrated position, the fees accrued by the
+// position are collected together
+type MsgRemoveConcentratedLiquidity struct {
+	PositionID     uint64         `json:"position_id"`      // The position to remove liquidity from
+	Liquidity      sdk.Dec        `json:"liquidity"`        // Amount of the liquidity removed
+	MinBaseAmount  sdk.SysCoin    `json:"min_base_amount"`  // Minimum base amount withdrawn, the fees not included
+	MinQuoteAmount sdk.SysCoin    `json:"min_quote_amount"` // Minimum quote amount withdrawn, the fees not included
+	Deadline       int64          `json:"deadline"`         // Time after which this transaction can no longer be executed.
+	Sender         sdk.AccAddress `json:"sender"`           // Sender
+}
+
+// NewMsgRemoveConcentratedLiquidity is a constructor function for MsgRemoveConcentratedLiquidity
+func NewMsgRemoveConcentratedLiquidity(positionID uint64, liquidity sdk.Dec, minBaseAmount, minQuoteAmount sdk.SysCoin,
+	deadline int64, sender sdk.AccAddress) MsgRemoveConcentratedLiquidity {
+	return MsgRemoveConcentratedLiquidity{
+		PositionID:     positionID,
+		Liquidity:      liquidity,
+		MinBaseAmount:  minBaseAmount,
+		MinQuoteAmount: minQuoteAmount,
+		Deadline:       deadline,
+		Sender:         sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRemoveConcentratedLiquidity) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveConcentratedLiquidity) Type() string { return TypeMsgRemoveConcentratedLiquidity }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveConcentratedLiquidity) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+	if msg.PositionID == 0 {
+		return ErrNonExistConcentratedPosition(msg.PositionID)
+	}
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return ErrMinLiquidityIsNegative()
+	}
+	if !msg.MinBaseAmount.IsValid() {
+		return ErrMinBaseAmount()
+	}
+	if !msg.MinQuoteAmount.IsValid() {
+		return ErrMinQuoteAmount()
+	}
+	return ValidateBaseAndQuoteAmount(msg.MinBaseAmount.Denom, msg.MinQuoteAmount.Denom)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveConcentratedLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveConcentratedLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCollectConcentratedFees collects the fees accrued by the concentrated position
+type MsgCollectConcentratedFees struct {
+	PositionID uint64         `json:"position_id"`
+	Sender     sdk.AccAddress `json:"sender"`
+}
+
+// NewMsgCollectConcentratedFees is a constructor function for MsgCollectConcentratedFees
+func NewMsgCollectConcentratedFees(positionID uint64, sender sdk.AccAddress) MsgCollectConcentratedFees {
+	return MsgCollectConcentratedFees{
+		PositionID: positionID,
+		Sender:     sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCollectConcentratedFees) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCollectConcentratedFees) Type() string { return TypeMsgCollectConcentratedFees }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCollectConcentratedFees) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+	if msg.PositionID == 0 {
+		return ErrNonExistConcentratedPosition(msg.PositionID)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCollectConcentratedFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCollectConcentratedFees) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgTransferConcentratedPosition transfers the concentrated position with its fees not collected to the recipient
+type MsgTransferConcentratedPosition struct {
+	PositionID uint64         `json:"position_id"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Sender     sdk.AccAddress `json:"sender"`
+}
+
+// NewMsgTransferConcentratedPosition is a constructor function for MsgTransferConcentratedPosition
+func NewMsgTransferConcentratedPosition(positionID uint64, recipient, sender sdk.AccAddress) MsgTransferConcentratedPosition {
+	return MsgTransferConcentratedPosition{
+		PositionID: positionID,
+		Recipient:  recipient,
+		Sender:     sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferConcentratedPosition) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferConcentratedPosition) Type() string { return TypeMsgTransferConcentratedPosition }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferConcentratedPosition) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+	if msg.PositionID == 0 {
+		return ErrNonExistConcentratedPosition(msg.PositionID)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferConcentratedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferConcentratedPosition) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func coinDenoms(coins sdk.SysCoins) []string {
+	denoms := make([]string, len(coins))
+	for i, coin := range coins {
+		denoms[i] = coin.Denom
+	}
+	return denoms
+}
@@ -11,6 +11,9 @@ import (
 // FeeRate defines swap fee rate
 var (
 	defaultFeeRate = sdk.NewDecWithPrec(3, 3)
+	// the fee tiers for the stablecoin pairs, the common pairs and the volatile pairs
+	defaultFeeTiers        = []sdk.Dec{sdk.NewDecWithPrec(5, 4), sdk.NewDecWithPrec(3, 3), sdk.NewDecWithPrec(1, 2)}
+	defaultProtocolFeeRate = sdk.ZeroDec()
 )
 
 // Default parameter namespace
@@ -22,7 +25,12 @@ const (
 
 // Parameter store keys
 var (
-	KeyFeeRate = []byte("FeeRate")
+	KeyFeeRate         = []byte("FeeRate")
+	KeyFeeTiers        = []byte("FeeTiers")
+	KeyProtocolFeeRate = []byte("ProtocolFeeRate")
+
+	// OptionalParamKeys are the keys of the params added after the genesis, which are the defaults until set
+	OptionalParamKeys = [][]byte{KeyFeeTiers, KeyProtocolFeeRate}
 )
 
 // ParamKeyTable for swap module
@@ -32,22 +40,37 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for swap at genesis
 type Params struct {
-	FeeRate sdk.Dec `json:"fee_rate"`
+	FeeRate         sdk.Dec   `json:"fee_rate"`          // The default fee rate of the token pairs
+	FeeTiers        []sdk.Dec `json:"fee_tiers"`         // The fee rates allowed to create the token pairs with
+	ProtocolFeeRate sdk.Dec   `json:"protocol_fee_rate"` // The fraction of the swap fees collected by the protocol
 }
 
 // NewParams creates a new Params object
-func NewParams(feeRate sdk.Dec) Params {
+func NewParams(feeRate sdk.Dec, feeTiers []sdk.Dec, protocolFeeRate sdk.Dec) Params {
 	return Params{
-		FeeRate: feeRate,
+		FeeRate:         feeRate,
+		FeeTiers:        feeTiers,
+		ProtocolFeeRate: protocolFeeRate,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Poolswap Params:
-  TradeFeeRate: %s`, p.FeeRate)
+  TradeFeeRate: %s
+  FeeTiers: %s
+  ProtocolFeeRate: %s`, p.FeeRate, p.FeeTiers, p.ProtocolFeeRate)
 }
 
+// IsFeeTier returns whether the fee rate is one of the fee tiers
+func (p Params) IsFeeTier(feeRate sdk.Dec) bool {
+	for _, feeTier := range p.FeeTiers {
+		if feeTier.Equal(feeRate) {
+			return true
+		}
+	}
+	return false
+}
 
 func validateParams(value interface{}) error {
 	v, ok := value.(sdk.Dec)
@@ -64,14 +87,35 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateFeeTiers(value interface{}) error {
+	v, ok := value.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	for i, feeTier := range v {
+		if !feeTier.IsPositive() || feeTier.GTE(sdk.OneDec()) {
+			return fmt.Errorf("fee tier must be positive and less than 1: %s", feeTier)
+		}
+		for _, other := range v[:i] {
+			if other.Equal(feeTier) {
+				return fmt.Errorf("duplicated fee tier: %s", feeTier)
+			}
+		}
+	}
+	return nil
+}
+
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: KeyFeeRate, Value: &p.FeeRate, ValidatorFn: validateParams},
+		{Key: KeyFeeTiers, Value: &p.FeeTiers, ValidatorFn: validateFeeTiers},
+		{Key: KeyProtocolFeeRate, Value: &p.ProtocolFeeRate, ValidatorFn: validateParams},
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(defaultFeeRate)
+	return NewParams(defaultFeeRate, defaultFeeTiers, defaultProtocolFeeRate)
 }
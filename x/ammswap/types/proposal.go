@@ -0,0 +1,199 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeWithdrawProtocolFee defines the type for a WithdrawProtocolFeeProposal
+	proposalTypeWithdrawProtocolFee = "WithdrawProtocolFee"
+	// proposalTypeRampAmplification defines the type for a RampAmplificationProposal
+	proposalTypeRampAmplification = "RampAmplification"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeWithdrawProtocolFee)
+	govtypes.RegisterProposalType(proposalTypeRampAmplification)
+	govtypes.RegisterProposalTypeCodec(WithdrawProtocolFeeProposal{}, "okexchain/ammswap/WithdrawProtocolFeeProposal")
+	govtypes.RegisterProposalTypeCodec(RampAmplificationProposal{}, "okexchain/ammswap/RampAmplificationProposal")
+}
+
+var (
+	_ govtypes.Content = (*WithdrawProtocolFeeProposal)(nil)
+	_ govtypes.Content = (*RampAmplificationProposal)(nil)
+)
+
+// WithdrawProtocolFeeProposal - structure for the proposal to withdraw the protocol fees of the swaps
+type WithdrawProtocolFeeProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.SysCoins   `json:"amount" yaml:"amount"`
+}
+
+// NewWithdrawProtocolFeeProposal creates a new instance of WithdrawProtocolFeeProposal
+func NewWithdrawProtocolFeeProposal(title, description string, recipient sdk.AccAddress, amount sdk.SysCoins,
+) WithdrawProtocolFeeProposal {
+	return WithdrawProtocolFeeProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns title of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) GetTitle() string {
+	return wp.Title
+}
+
+// GetDescription returns description of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) GetDescription() string {
+	return wp.Description
+}
+
+// ProposalRoute returns route key of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a withdraw protocol fee proposal object
+func (wp WithdrawProtocolFeeProposal) ProposalType() string {
+	return proposalTypeWithdrawProtocolFee
+}
+
+// ValidateBasic validates a withdraw protocol fee proposal
+func (wp WithdrawProtocolFeeProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(wp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(wp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(wp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(wp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if wp.ProposalType() != proposalTypeWithdrawProtocolFee {
+		return govtypes.ErrInvalidProposalType(wp.ProposalType())
+	}
+
+	if wp.Recipient.Empty() {
+		return govtypes.ErrInvalidProposalContent("recipient is required")
+	}
+
+	if wp.Amount.Empty() || !wp.Amount.IsValid() {
+		return govtypes.ErrInvalidProposalContent("amount is invalid")
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a WithdrawProtocolFeeProposal
+func (wp WithdrawProtocolFeeProposal) String() string {
+	return fmt.Sprintf(`WithdrawProtocolFeeProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Recipient:				%s
+ Amount:				%s`,
+		wp.Title, wp.Description, wp.ProposalType(), wp.Recipient, wp.Amount)
+}
+
+// RampAmplificationProposal - structure for the proposal to ramp the amplification coefficient of a stable pool
+type RampAmplificationProposal struct {
+	Title               string `json:"title" yaml:"title"`
+	Description         string `json:"description" yaml:"description"`
+	PoolName            string `json:"pool_name" yaml:"pool_name"`
+	FutureAmplification int64  `json:"future_amplification" yaml:"future_amplification"`
+	RampDuration        int64  `json:"ramp_duration" yaml:"ramp_duration"` // in seconds, between MinRampDuration and MaxRampDuration
+}
+
+// NewRampAmplificationProposal creates a new instance of RampAmplificationProposal
+func NewRampAmplificationProposal(title, description, poolName string, futureAmplification, rampDuration int64,
+) RampAmplificationProposal {
+	return RampAmplificationProposal{
+		Title:               title,
+		Description:         description,
+		PoolName:            poolName,
+		FutureAmplification: futureAmplification,
+		RampDuration:        rampDuration,
+	}
+}
+
+// GetTitle returns title of a ramp amplification proposal object
+func (rp RampAmplificationProposal) GetTitle() string {
+	return rp.Title
+}
+
+// GetDescription returns description of a ramp amplification proposal object
+func (rp RampAmplificationProposal) GetDescription() string {
+	return rp.Description
+}
+
+// ProposalRoute returns route key of a ramp amplification proposal object
+func (rp RampAmplificationProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a ramp amplification proposal object
+func (rp RampAmplificationProposal) ProposalType() string {
+	return proposalTypeRampAmplification
+}
+
+// ValidateBasic validates a ramp amplification proposal
+func (rp RampAmplificationProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(rp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(rp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(rp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(rp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if rp.ProposalType() != proposalTypeRampAmplification {
+		return govtypes.ErrInvalidProposalType(rp.ProposalType())
+	}
+
+	if err := ValidateStablePoolTokens(strings.Split(rp.PoolName, "_")); err != nil {
+		return govtypes.ErrInvalidProposalContent(err.Error())
+	}
+
+	if rp.FutureAmplification <= 0 || rp.FutureAmplification > MaxAmplification {
+		return govtypes.ErrInvalidProposalContent("future amplification is out of range")
+	}
+
+	if err := ValidateRampDuration(rp.RampDuration); err != nil {
+		return govtypes.ErrInvalidProposalContent(err.Error())
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a RampAmplificationProposal
+func (rp RampAmplificationProposal) String() string {
+	return fmt.Sprintf(`RampAmplificationProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ PoolName:				%s
+ FutureAmplification:	%d
+ RampDuration:			%ds`,
+		rp.Title, rp.Description, rp.ProposalType(), rp.PoolName, rp.FutureAmplification, rp.RampDuration)
+}
@@ -18,6 +18,20 @@ func NewQuerySwapBuyInfoParams(sellTokenAmount string, buyToken string) QuerySwa
 	}
 }
 
+// nolint
+type QuerySwapSellInfoParams struct {
+	BuyTokenAmount string `json:"buy_token_amount"`
+	SellToken      string `json:"sell_token"`
+}
+
+// NewQuerySwapSellInfoParams creates a new instance of QuerySwapSellInfoParams
+func NewQuerySwapSellInfoParams(buyTokenAmount string, sellToken string) QuerySwapSellInfoParams {
+	return QuerySwapSellInfoParams{
+		BuyTokenAmount: buyTokenAmount,
+		SellToken:      sellToken,
+	}
+}
+
 // nolint
 type QuerySwapAddInfoParams struct {
 	QuoteTokenAmount string `json:"quote_token_amount"`
@@ -50,3 +64,19 @@ type QueryBuyAmountParams struct {
 	SoldToken  sdk.SysCoin
 	TokenToBuy string
 }
+
+// nolint
+type QuerySwapTWAPParams struct {
+	TokenPairName string `json:"token_pair_name"`
+	StartHeight   int64  `json:"start_height"`
+	EndHeight     int64  `json:"end_height"`
+}
+
+// NewQuerySwapTWAPParams creates a new instance of QuerySwapTWAPParams
+func NewQuerySwapTWAPParams(tokenPairName string, startHeight, endHeight int64) QuerySwapTWAPParams {
+	return QuerySwapTWAPParams{
+		TokenPairName: tokenPairName,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxSwapRouteHops defines the max number of swap token pairs a swap route passes through
+const MaxSwapRouteHops = 4
+
+// SwapRoute defines the tokens swapped through several swap token pairs
+type SwapRoute struct {
+	Route             []string    `json:"route"`               // The intermediate tokens between the sold token and the bought token
+	SoldTokenAmount   sdk.SysCoin `json:"sold_token_amount"`   // Amount of the sold token
+	BoughtTokenAmount sdk.SysCoin `json:"bought_token_amount"` // Amount of the bought token
+}
+
+// String implement fmt.Stringer
+func (r SwapRoute) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Route: %s
+SoldTokenAmount: %s
+BoughtTokenAmount: %s`, strings.Join(r.Route, ","), r.SoldTokenAmount, r.BoughtTokenAmount))
+}
+
+// GetSwapPath returns the tokens swapped in order from the sold token to the bought token through the route
+func GetSwapPath(soldToken string, route []string, boughtToken string) []string {
+	path := make([]string, 0, len(route)+2)
+	path = append(path, soldToken)
+	path = append(path, route...)
+	return append(path, boughtToken)
+}
+
+// ValidateSwapPath validates the tokens of the swap path, which passes through each token once at most
+func ValidateSwapPath(path []string) sdk.Error {
+	if len(path) < 2 || len(path)-1 > MaxSwapRouteHops {
+		return ErrInvalidSwapRoute(fmt.Sprintf("the number of swap token pairs must be between 1 and %d", MaxSwapRouteHops))
+	}
+	tokens := make(map[string]bool, len(path))
+	for _, token := range path {
+		if err := ValidateSwapAmountName(token); err != nil {
+			return err
+		}
+		if tokens[token] {
+			return ErrInvalidSwapRoute(fmt.Sprintf("token %s is repeated", token))
+		}
+		tokens[token] = true
+	}
+	return nil
+}
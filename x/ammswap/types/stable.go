@@ -0,0 +1,351 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// PoolTypeStable is the pool of StablePool, whose swaps follow the StableSwap invariant
+const PoolTypeStable = "stable"
+
+const (
+	// MinStablePoolTokens is the min number of the tokens in a stable pool
+	MinStablePoolTokens = 2
+	// MaxStablePoolTokens is the max number of the tokens in a stable pool, which the pool token name is limited by
+	MaxStablePoolTokens = 4
+	// MaxAmplification is the max amplification coefficient of the stable pools
+	MaxAmplification int64 = 1000000
+	// MaxAmplificationChange is the max factor the amplification coefficient could be ramped up or down by at once
+	MaxAmplificationChange int64 = 10
+	// MinRampDuration is the min duration in seconds to ramp the amplification coefficient over, which is the
+	// MIN_RAMP_TIME of curve
+	MinRampDuration int64 = 86400
+	// MaxRampDuration is the max duration in seconds to ramp the amplification coefficient over
+	MaxRampDuration int64 = 365 * 86400
+
+	// stableSwapMaxIterations is the max iterations of the newton's method calculating the invariant
+	stableSwapMaxIterations = 255
+)
+
+// StablePool defines the exchange of the tokens pegged to each other, whose swaps follow the StableSwap invariant
+//
+//	A * n^n * sum(x_i) + D = A * D * n^n + D^(n+1) / (n^n * prod(x_i))
+//
+// The amplification coefficient A is ramped linearly from the initial one to the future one over the block time, so
+// that the governance could adjust it without moving the prices of the pool suddenly.
+type StablePool struct {
+	PooledCoins              sdk.SysCoins `json:"pooled_coins"`               // The volumes of the tokens in the pool, sorted by the denoms
+	PoolTokenName            string       `json:"pool_token_name"`            // The name of pool token
+	FeeRate                  sdk.Dec      `json:"fee_rate"`                   // The fee rate of the swaps, the default fee rate of params is used if it's zero
+	InitialAmplification     int64        `json:"initial_amplification"`      // The amplification coefficient the ramping starts from
+	FutureAmplification      int64        `json:"future_amplification"`       // The amplification coefficient the ramping ends at
+	InitialAmplificationTime int64        `json:"initial_amplification_time"` // The unix time the ramping starts at
+	FutureAmplificationTime  int64        `json:"future_amplification_time"`  // The unix time the ramping ends at
+}
+
+// NewStablePool creates a stable pool of the tokens without liquidity
+func NewStablePool(tokens []string, feeRate sdk.Dec, amplification int64) StablePool {
+	tokens = SortStablePoolTokens(tokens)
+	pooledCoins := make(sdk.SysCoins, len(tokens))
+	for i, token := range tokens {
+		pooledCoins[i] = sdk.NewDecCoinFromDec(token, sdk.ZeroDec())
+	}
+	return StablePool{
+		PooledCoins:          pooledCoins,
+		PoolTokenName:        PoolTokenPrefix + GetStablePoolName(tokens),
+		FeeRate:              feeRate,
+		InitialAmplification: amplification,
+		FutureAmplification:  amplification,
+	}
+}
+
+// String implement fmt.Stringer
+func (p StablePool) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`PooledCoins: %s
+PoolTokenName: %s
+FeeRate: %s
+Amplification: %d -> %d
+AmplificationTime: %d -> %d`, p.PooledCoins, p.PoolTokenName, p.FeeRate, p.InitialAmplification,
+		p.FutureAmplification, p.InitialAmplificationTime, p.FutureAmplificationTime))
+}
+
+// Name returns the name of the stable pool, which is the denoms of its tokens joined by "_"
+func (p StablePool) Name() string {
+	return GetStablePoolName(p.Tokens())
+}
+
+// Tokens returns the denoms of the tokens in the pool
+func (p StablePool) Tokens() []string {
+	tokens := make([]string, len(p.PooledCoins))
+	for i, coin := range p.PooledCoins {
+		tokens[i] = coin.Denom
+	}
+	return tokens
+}
+
+// TokenIndex returns the index of the token in the pool, or -1 if the token isn't in the pool
+func (p StablePool) TokenIndex(token string) int {
+	for i, coin := range p.PooledCoins {
+		if coin.Denom == token {
+			return i
+		}
+	}
+	return -1
+}
+
+// TokenPairNames returns the names of all the token pairs in the pool
+func (p StablePool) TokenPairNames() (names []string) {
+	for i := 0; i < len(p.PooledCoins); i++ {
+		for j := i + 1; j < len(p.PooledCoins); j++ {
+			names = append(names, GetSwapTokenPairName(p.PooledCoins[i].Denom, p.PooledCoins[j].Denom))
+		}
+	}
+	return names
+}
+
+// HasLiquidity returns true if all the tokens in the pool are positive, which the swaps require
+func (p StablePool) HasLiquidity() bool {
+	for _, coin := range p.PooledCoins {
+		if !coin.Amount.IsPositive() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetFeeRate returns the fee rate of the swaps in the stable pool
+func (p StablePool) GetFeeRate(params Params) sdk.Dec {
+	if p.FeeRate.IsNil() || p.FeeRate.IsZero() {
+		return params.FeeRate
+	}
+	return p.FeeRate
+}
+
+// GetAmplification returns the amplification coefficient at the unix time, which is ramped linearly from the initial
+// one to the future one
+func (p StablePool) GetAmplification(blockTime int64) int64 {
+	if blockTime >= p.FutureAmplificationTime {
+		return p.FutureAmplification
+	}
+	if blockTime <= p.InitialAmplificationTime {
+		return p.InitialAmplification
+	}
+	// the change is calculated in big ints, so the product of the change and the elapsed time can't overflow
+	elapsed := big.NewInt(blockTime - p.InitialAmplificationTime)
+	duration := big.NewInt(p.FutureAmplificationTime - p.InitialAmplificationTime)
+	change := new(big.Int).Sub(big.NewInt(p.FutureAmplification), big.NewInt(p.InitialAmplification))
+	change.Mul(change, elapsed).Quo(change, duration)
+	return p.InitialAmplification + change.Int64()
+}
+
+// RampAmplification ramps the amplification coefficient from the current one at the block time to the future one at
+// the future time
+func (p *StablePool) RampAmplification(blockTime int64, futureAmplification int64, futureTime int64) {
+	p.InitialAmplification = p.GetAmplification(blockTime)
+	p.InitialAmplificationTime = blockTime
+	p.FutureAmplification = futureAmplification
+	p.FutureAmplificationTime = futureTime
+}
+
+// ValidateRampDuration checks the duration to ramp the amplification coefficient over
+func ValidateRampDuration(duration int64) sdk.Error {
+	if duration < MinRampDuration || duration > MaxRampDuration {
+		return ErrInvalidAmplification(fmt.Sprintf("ramp duration %ds should be in [%d, %d]",
+			duration, MinRampDuration, MaxRampDuration))
+	}
+	return nil
+}
+
+// ValidateAmplificationChange checks the amplification coefficient could be ramped from the current one to the future one
+func ValidateAmplificationChange(current, future int64) sdk.Error {
+	if future <= 0 || future > MaxAmplification {
+		return ErrInvalidAmplification(fmt.Sprintf("amplification %d should be in (0, %d]", future, MaxAmplification))
+	}
+	if future > current*MaxAmplificationChange || future*MaxAmplificationChange < current {
+		return ErrInvalidAmplification(fmt.Sprintf("amplification can't be changed from %d to %d by more than %d times",
+			current, future, MaxAmplificationChange))
+	}
+	return nil
+}
+
+// SortStablePoolTokens returns the copy of the tokens sorted in the order of the pooled coins
+func SortStablePoolTokens(tokens []string) []string {
+	sorted := make([]string, len(tokens))
+	copy(sorted, tokens)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// GetStablePoolName returns the name of the stable pool of the tokens
+func GetStablePoolName(tokens []string) string {
+	return strings.Join(SortStablePoolTokens(tokens), "_")
+}
+
+// ValidateStablePoolTokens checks the number of the tokens and the tokens are valid and distinct
+func ValidateStablePoolTokens(tokens []string) sdk.Error {
+	if len(tokens) < MinStablePoolTokens || len(tokens) > MaxStablePoolTokens {
+		return ErrInvalidStablePoolTokens(fmt.Sprintf("the number of the tokens should be in [%d, %d]",
+			MinStablePoolTokens, MaxStablePoolTokens))
+	}
+	sorted := SortStablePoolTokens(tokens)
+	for i, token := range sorted {
+		if err := ValidateSwapAmountName(token); err != nil {
+			return ErrInvalidStablePoolTokens(err.Error())
+		}
+		if i > 0 && token == sorted[i-1] {
+			return ErrInvalidStablePoolTokens(fmt.Sprintf("duplicate token %s", token))
+		}
+	}
+	return nil
+}
+
+// CalculateStableInvariant returns the invariant D of the balances with the amplification coefficient, which is
+// calculated with the newton's method
+func CalculateStableInvariant(balances []sdk.Dec, amplification int64) sdk.Dec {
+	xs := decsToBigInts(balances)
+	return sdk.NewDecFromBigIntWithPrec(calculateStableInvariant(xs, amplification), sdk.Precision)
+}
+
+// CalculateStableSwap returns the balance of the token j after the balance of the token i is changed to x, which keeps
+// the invariant of the balances
+func CalculateStableSwap(balances []sdk.Dec, amplification int64, i, j int, x sdk.Dec) sdk.Dec {
+	xs := decsToBigInts(balances)
+	d := calculateStableInvariant(xs, amplification)
+	xs[i] = x.BigInt()
+	return sdk.NewDecFromBigIntWithPrec(calculateStableBalance(xs, amplification, j, d), sdk.Precision)
+}
+
+// CalculateStableTokenToBuy returns the amount of the token bought by selling the token in the stable pool, and the
+// fee charged in the token bought. The fee is left in the pool except the protocol fee.
+func CalculateStableTokenToBuy(pool StablePool, sellToken sdk.SysCoin, buyTokenDenom string, amplification int64,
+	feeRate sdk.Dec) (tokenBuy, fee sdk.SysCoin) {
+	i, j := pool.TokenIndex(sellToken.Denom), pool.TokenIndex(buyTokenDenom)
+	balances := pool.balances()
+	y := CalculateStableSwap(balances, amplification, i, j, balances[i].Add(sellToken.Amount))
+	// the amount bought is rounded down by the smallest unit in favor of the pool
+	amount := balances[j].Sub(y).Sub(sdk.SmallestDec())
+	if !amount.IsPositive() {
+		return sdk.NewDecCoinFromDec(buyTokenDenom, sdk.ZeroDec()), sdk.NewDecCoinFromDec(buyTokenDenom, sdk.ZeroDec())
+	}
+	feeAmount := amount.MulTruncate(feeRate)
+	return sdk.NewDecCoinFromDec(buyTokenDenom, amount.Sub(feeAmount)), sdk.NewDecCoinFromDec(buyTokenDenom, feeAmount)
+}
+
+// CalculateStableLiquidityToMint returns the liquidity minted by depositing the amounts of all the tokens into the
+// stable pool. The deposit imbalanced from the pool is charged with the fee of a swap, which is left in the pool.
+func CalculateStableLiquidityToMint(pool StablePool, amounts []sdk.Dec, totalSupply sdk.Dec, amplification int64,
+	feeRate sdk.Dec) sdk.Dec {
+	oldBalances := pool.balances()
+	newBalances := make([]sdk.Dec, len(oldBalances))
+	for i := range oldBalances {
+		newBalances[i] = oldBalances[i].Add(amounts[i])
+	}
+	d0 := sdk.ZeroDec()
+	if totalSupply.IsPositive() {
+		d0 = CalculateStableInvariant(oldBalances, amplification)
+	}
+	d1 := CalculateStableInvariant(newBalances, amplification)
+	if d1.LTE(d0) {
+		return sdk.ZeroDec()
+	}
+	if !totalSupply.IsPositive() || d0.IsZero() {
+		return d1
+	}
+
+	// the fee of the imbalanced deposit is fee rate * n / (4 * (n - 1)) of the difference from the ideal balances
+	n := int64(len(oldBalances))
+	imbalanceFeeRate := feeRate.MulInt64(n).QuoInt64(4 * (n - 1))
+	adjustedBalances := make([]sdk.Dec, len(newBalances))
+	for i := range newBalances {
+		idealBalance := mulDiv(d1, oldBalances[i], d0, false)
+		fee := idealBalance.Sub(newBalances[i]).Abs().MulTruncate(imbalanceFeeRate)
+		adjustedBalances[i] = newBalances[i].Sub(fee)
+	}
+	d2 := CalculateStableInvariant(adjustedBalances, amplification)
+	if d2.LTE(d0) {
+		return sdk.ZeroDec()
+	}
+	return mulDiv(totalSupply, d2.Sub(d0), d0, false)
+}
+
+func (p StablePool) balances() []sdk.Dec {
+	balances := make([]sdk.Dec, len(p.PooledCoins))
+	for i, coin := range p.PooledCoins {
+		balances[i] = coin.Amount
+	}
+	return balances
+}
+
+// calculateStableInvariant calculates D on the raw integers of the balances, it iterates
+//
+//	D = (Ann * S + D_P * n) * D / ((Ann - 1) * D + (n + 1) * D_P), D_P = D^(n+1) / (n^n * prod(x_i))
+//
+// until D converges. The invariant is zero if any of the balances is zero.
+func calculateStableInvariant(xs []*big.Int, amplification int64) *big.Int {
+	s := new(big.Int)
+	for _, x := range xs {
+		if x.Sign() <= 0 {
+			return new(big.Int)
+		}
+		s.Add(s, x)
+	}
+	n := big.NewInt(int64(len(xs)))
+	ann := new(big.Int).Mul(big.NewInt(amplification), new(big.Int).Exp(n, n, nil))
+	d := new(big.Int).Set(s)
+	for k := 0; k < stableSwapMaxIterations; k++ {
+		dp := new(big.Int).Set(d)
+		for _, x := range xs {
+			dp.Quo(dp.Mul(dp, d), new(big.Int).Mul(x, n))
+		}
+		prev := d
+		numerator := new(big.Int).Mul(new(big.Int).Add(new(big.Int).Mul(ann, s), new(big.Int).Mul(dp, n)), d)
+		denominator := new(big.Int).Add(new(big.Int).Mul(new(big.Int).Sub(ann, big.NewInt(1)), d),
+			new(big.Int).Mul(new(big.Int).Add(n, big.NewInt(1)), dp))
+		d = numerator.Quo(numerator, denominator)
+		if new(big.Int).Sub(d, prev).CmpAbs(big.NewInt(1)) <= 0 {
+			break
+		}
+	}
+	return d
+}
+
+// calculateStableBalance calculates the balance y of the token j which keeps the invariant D with the other balances,
+// it iterates y = (y^2 + c) / (2 * y + b - D) until y converges
+func calculateStableBalance(xs []*big.Int, amplification int64, j int, d *big.Int) *big.Int {
+	n := big.NewInt(int64(len(xs)))
+	ann := new(big.Int).Mul(big.NewInt(amplification), new(big.Int).Exp(n, n, nil))
+	c := new(big.Int).Set(d)
+	s := new(big.Int)
+	for k, x := range xs {
+		if k == j {
+			continue
+		}
+		s.Add(s, x)
+		c.Quo(c.Mul(c, d), new(big.Int).Mul(x, n))
+	}
+	c.Quo(c.Mul(c, d), new(big.Int).Mul(ann, n))
+	b := new(big.Int).Add(s, new(big.Int).Quo(d, ann))
+	y := new(big.Int).Set(d)
+	for k := 0; k < stableSwapMaxIterations; k++ {
+		prev := y
+		numerator := new(big.Int).Add(new(big.Int).Mul(y, y), c)
+		denominator := new(big.Int).Sub(new(big.Int).Add(new(big.Int).Mul(y, big.NewInt(2)), b), d)
+		y = numerator.Quo(numerator, denominator)
+		if new(big.Int).Sub(y, prev).CmpAbs(big.NewInt(1)) <= 0 {
+			break
+		}
+	}
+	return y
+}
+
+func decsToBigInts(decs []sdk.Dec) []*big.Int {
+	ints := make([]*big.Int, len(decs))
+	for i, dec := range decs {
+		ints[i] = dec.BigInt()
+	}
+	return ints
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateStableInvariant(t *testing.T) {
+	// the invariant of the balanced pool is the sum of the balances
+	balances := []sdk.Dec{sdk.NewDec(1000), sdk.NewDec(1000), sdk.NewDec(1000)}
+	require.Equal(t, sdk.NewDec(3000), CalculateStableInvariant(balances, 100))
+
+	// the invariant of the imbalanced pool is between the constant product one and the sum
+	balances = []sdk.Dec{sdk.NewDec(500), sdk.NewDec(2000)}
+	d := CalculateStableInvariant(balances, 100)
+	require.True(t, d.LT(sdk.NewDec(2500)))
+	require.True(t, d.GT(sdk.NewDec(2000)))
+
+	// the invariant is zero if any of the balances is zero
+	balances = []sdk.Dec{sdk.NewDec(1000), sdk.ZeroDec()}
+	require.True(t, CalculateStableInvariant(balances, 100).IsZero())
+
+	// the balance calculated keeps the invariant
+	balances = []sdk.Dec{sdk.NewDec(1000), sdk.NewDec(1200), sdk.NewDec(900)}
+	d = CalculateStableInvariant(balances, 50)
+	y := CalculateStableSwap(balances, 50, 0, 2, sdk.NewDec(1100))
+	balances[0], balances[2] = sdk.NewDec(1100), y
+	require.True(t, CalculateStableInvariant(balances, 50).Sub(d).Abs().LT(sdk.NewDecWithPrec(1, 10)))
+}
+
+func TestCalculateStableTokenToBuy(t *testing.T) {
+	tokens := []string{TestBasePooledToken, TestBasePooledToken2, TestQuotePooledToken}
+	pool := NewStablePool(tokens, sdk.ZeroDec(), 100)
+	for i := range pool.PooledCoins {
+		pool.PooledCoins[i].Amount = sdk.NewDec(1000000)
+	}
+	sellToken := sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(1000))
+
+	// the price of the balanced pool is close to 1, with much less slippage than the constant product curve
+	tokenBuy, fee := CalculateStableTokenToBuy(pool, sellToken, TestQuotePooledToken, 100, sdk.ZeroDec())
+	require.True(t, fee.IsZero())
+	require.True(t, tokenBuy.Amount.LT(sellToken.Amount))
+	require.True(t, tokenBuy.Amount.GT(sdk.NewDecWithPrec(9999, 1)))
+	constantProduct := constantProductAmountOut(sellToken.Amount)
+	require.True(t, tokenBuy.Amount.GT(constantProduct))
+
+	// a higher amplification gives less slippage
+	tokenBuyLowAmp, _ := CalculateStableTokenToBuy(pool, sellToken, TestQuotePooledToken, 1, sdk.ZeroDec())
+	require.True(t, tokenBuy.Amount.GT(tokenBuyLowAmp.Amount))
+
+	// the fee is charged on the token bought
+	feeRate := sdk.NewDecWithPrec(4, 4)
+	tokenBuyWithFee, fee := CalculateStableTokenToBuy(pool, sellToken, TestQuotePooledToken, 100, feeRate)
+	require.Equal(t, TestQuotePooledToken, fee.Denom)
+	require.Equal(t, tokenBuy.Amount, tokenBuyWithFee.Amount.Add(fee.Amount))
+}
+
+// constantProductAmountOut returns the amount bought from the constant product pool of 1000000 tokens on each side
+// without fee
+func constantProductAmountOut(amount sdk.Dec) sdk.Dec {
+	reserve := sdk.NewDec(1000000)
+	return amount.Mul(reserve).Quo(reserve.Add(amount))
+}
+
+func TestCalculateStableLiquidityToMint(t *testing.T) {
+	tokens := []string{TestBasePooledToken, TestQuotePooledToken}
+	pool := NewStablePool(tokens, sdk.ZeroDec(), 100)
+	feeRate := sdk.NewDecWithPrec(3, 3)
+
+	// the first deposit mints the invariant
+	amounts := []sdk.Dec{sdk.NewDec(1000), sdk.NewDec(1000)}
+	liquidity := CalculateStableLiquidityToMint(pool, amounts, sdk.ZeroDec(), 100, feeRate)
+	require.Equal(t, sdk.NewDec(2000), liquidity)
+
+	// the first deposit must contain all the tokens
+	require.True(t, CalculateStableLiquidityToMint(pool, []sdk.Dec{sdk.NewDec(1000), sdk.ZeroDec()}, sdk.ZeroDec(),
+		100, feeRate).IsZero())
+
+	// the balanced deposit is free of the fee, while the imbalanced one is charged
+	pool.PooledCoins[0].Amount, pool.PooledCoins[1].Amount = sdk.NewDec(1000), sdk.NewDec(1000)
+	balanced := CalculateStableLiquidityToMint(pool, amounts, liquidity, 100, feeRate)
+	require.Equal(t, sdk.NewDec(2000), balanced)
+	imbalanced := CalculateStableLiquidityToMint(pool, []sdk.Dec{sdk.NewDec(2000), sdk.ZeroDec()}, liquidity, 100, feeRate)
+	require.True(t, imbalanced.IsPositive())
+	require.True(t, imbalanced.LT(balanced))
+}
+
+func TestStablePoolRampAmplification(t *testing.T) {
+	pool := NewStablePool([]string{TestQuotePooledToken, TestBasePooledToken}, sdk.ZeroDec(), 100)
+	require.Equal(t, []string{TestBasePooledToken, TestQuotePooledToken}, pool.Tokens())
+	require.Equal(t, TestSwapTokenPairName, pool.Name())
+	require.Equal(t, []string{TestSwapTokenPairName}, pool.TokenPairNames())
+	require.Equal(t, int64(100), pool.GetAmplification(0))
+
+	// the amplification changes linearly, and stays at the future one after the ramping
+	pool.RampAmplification(1000, 200, 2000)
+	require.Equal(t, int64(100), pool.GetAmplification(1000))
+	require.Equal(t, int64(150), pool.GetAmplification(1500))
+	require.Equal(t, int64(200), pool.GetAmplification(2000))
+	require.Equal(t, int64(200), pool.GetAmplification(3000))
+
+	// ramp down from the middle of the ramping
+	pool.RampAmplification(1500, 50, 2500)
+	require.Equal(t, int64(150), pool.GetAmplification(1500))
+	require.Equal(t, int64(100), pool.GetAmplification(2000))
+	require.Equal(t, int64(50), pool.GetAmplification(2500))
+
+	require.Nil(t, ValidateAmplificationChange(100, 1000))
+	require.NotNil(t, ValidateAmplificationChange(100, 1001))
+	require.NotNil(t, ValidateAmplificationChange(100, 9))
+	require.NotNil(t, ValidateAmplificationChange(100, 0))
+	require.NotNil(t, ValidateAmplificationChange(MaxAmplification, MaxAmplification+1))
+
+	// the ramping over a long time doesn't overflow
+	pool = NewStablePool([]string{TestQuotePooledToken, TestBasePooledToken}, sdk.ZeroDec(), 100)
+	pool.RampAmplification(0, 1000, math.MaxInt64)
+	require.Equal(t, int64(999), pool.GetAmplification(math.MaxInt64-1))
+
+	require.Nil(t, ValidateRampDuration(MinRampDuration))
+	require.Nil(t, ValidateRampDuration(MaxRampDuration))
+	require.NotNil(t, ValidateRampDuration(0))
+	require.NotNil(t, ValidateRampDuration(MinRampDuration-1))
+	require.NotNil(t, ValidateRampDuration(MaxRampDuration+1))
+}
+
+func TestMsgCreateStableExchange(t *testing.T) {
+	addr, err := sdk.AccAddressFromHex(addrStr)
+	require.Nil(t, err)
+	tokens := []string{TestBasePooledToken, TestBasePooledToken2, TestQuotePooledToken}
+
+	tests := []struct {
+		testCase     string
+		msg          MsgCreateExchange
+		expectedCode uint32
+	}{
+		{"success", NewMsgCreateStableExchange(tokens, sdk.ZeroDec(), 100, addr), sdk.CodeOK},
+		{"two tokens", NewMsgCreateStableExchange(tokens[:2], sdk.ZeroDec(), 100, addr), sdk.CodeOK},
+		{"too many tokens", NewMsgCreateStableExchange(append(tokens, "abc", "bcd"), sdk.ZeroDec(), 100, addr), CodeInvalidStablePoolTokens},
+		{"duplicate tokens", NewMsgCreateStableExchange(append(tokens, TestBasePooledToken), sdk.ZeroDec(), 100, addr), CodeInvalidStablePoolTokens},
+		{"no amplification", NewMsgCreateStableExchange(tokens, sdk.ZeroDec(), 0, addr), CodeInvalidAmplification},
+		{"extra tokens of the constant product pool", MsgCreateExchange{Token0Name: TestBasePooledToken, Token1Name: TestQuotePooledToken,
+			ExtraTokenNames: []string{TestBasePooledToken2}, Sender: addr}, CodeInvalidPoolType},
+	}
+	for _, testCase := range tests {
+		err := testCase.msg.ValidateBasic()
+		testCode(t, err, testCase.expectedCode)
+	}
+	require.Equal(t, tokens, tests[0].msg.GetTokenNames())
+	require.Nil(t, tests[1].msg.ExtraTokenNames)
+}
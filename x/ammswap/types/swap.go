@@ -17,15 +17,17 @@ type SwapTokenPair struct {
 	QuotePooledCoin sdk.SysCoin `json:"quote_pooled_coin"` // The volume of quote token in the token pair exchange pool
 	BasePooledCoin  sdk.SysCoin `json:"base_pooled_coin"`  // The volume of base token in the token pair exchange pool
 	PoolTokenName   string      `json:"pool_token_name"`   // The name of pool token
+	FeeRate         sdk.Dec     `json:"fee_rate"`          // The fee rate of the swaps, the default fee rate of params is used if it's nil or zero
 }
 
 func NewSwapPair(token0, token1 string) SwapTokenPair {
 	base, quote := GetBaseQuoteTokenName(token0, token1)
 
 	swapTokenPair := SwapTokenPair{
-		sdk.NewDecCoinFromDec(quote, sdk.ZeroDec()),
-		sdk.NewDecCoinFromDec(base, sdk.ZeroDec()),
-		GetPoolTokenName(token0, token1),
+		QuotePooledCoin: sdk.NewDecCoinFromDec(quote, sdk.ZeroDec()),
+		BasePooledCoin:  sdk.NewDecCoinFromDec(base, sdk.ZeroDec()),
+		PoolTokenName:   GetPoolTokenName(token0, token1),
+		FeeRate:         sdk.ZeroDec(),
 	}
 	return swapTokenPair
 }
@@ -36,6 +38,7 @@ func NewSwapTokenPair(quotePooledCoin sdk.SysCoin, basePooledCoin sdk.SysCoin, p
 		QuotePooledCoin: quotePooledCoin,
 		BasePooledCoin:  basePooledCoin,
 		PoolTokenName:   poolTokenName,
+		FeeRate:         sdk.ZeroDec(),
 	}
 	return swapTokenPair
 }
@@ -44,7 +47,22 @@ func NewSwapTokenPair(quotePooledCoin sdk.SysCoin, basePooledCoin sdk.SysCoin, p
 func (s SwapTokenPair) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`QuotePooledCoin: %s
 BasePooledCoin: %s
-PoolTokenName: %s`, s.QuotePooledCoin.String(), s.BasePooledCoin.String(), s.PoolTokenName))
+PoolTokenName: %s
+FeeRate: %s`, s.QuotePooledCoin.String(), s.BasePooledCoin.String(), s.PoolTokenName, s.FeeRate))
+}
+
+// GetFeeRate returns the fee rate of the swaps in the token pair
+func (s SwapTokenPair) GetFeeRate(params Params) sdk.Dec {
+	if s.FeeRate.IsNil() || s.FeeRate.IsZero() {
+		return params.FeeRate
+	}
+	return s.FeeRate
+}
+
+// GetProtocolFee returns the protocol fee taken from the swap fee of selling the token in the token pair
+func (s SwapTokenPair) GetProtocolFee(sellToken sdk.SysCoin, params Params) sdk.SysCoin {
+	fee := sellToken.Amount.MulTruncate(s.GetFeeRate(params))
+	return sdk.NewDecCoinFromDec(sellToken.Denom, fee.MulTruncate(params.ProtocolFeeRate))
 }
 
 // TokenPairName defines token pair
@@ -52,6 +70,14 @@ func (s SwapTokenPair) TokenPairName() string {
 	return s.BasePooledCoin.Denom + "_" + s.QuotePooledCoin.Denom
 }
 
+// PooledCoin returns the pooled coin of the token in the token pair
+func (s SwapTokenPair) PooledCoin(token string) sdk.SysCoin {
+	if s.BasePooledCoin.Denom == token {
+		return s.BasePooledCoin
+	}
+	return s.QuotePooledCoin
+}
+
 // InitPoolToken default pool token
 func InitPoolToken(poolTokenName string) token.Token {
 	return token.Token{
@@ -23,13 +23,14 @@ func GetTestSwapTokenPair() SwapTokenPair {
 		QuotePooledCoin: sdk.NewDecCoinFromDec(TestQuotePooledToken, sdk.NewDec(0)),
 		BasePooledCoin:  sdk.NewDecCoinFromDec(TestBasePooledToken, sdk.NewDec(0)),
 		PoolTokenName:   GetPoolTokenName(TestBasePooledToken, TestQuotePooledToken),
+		FeeRate:         sdk.NewDec(0),
 	}
 }
 
 func SetTestTokens(ctx sdk.Context, tokenKeeper token.Keeper, supplyKeeper supply.Keeper, addr sdk.AccAddress, coins sdk.DecCoins) error {
 	for _, coin := range coins {
 		name := coin.Denom
-		tokenKeeper.NewToken(ctx, tokentypes.Token{"", name, name,name, coin.Amount, 1,addr,true})
+		tokenKeeper.NewToken(ctx, tokentypes.Token{"", name, name,name, coin.Amount, 1,addr,true, false, false})
 	}
 	err := supplyKeeper.MintCoins(ctx, tokentypes.ModuleName, coins)
 	if err != nil {
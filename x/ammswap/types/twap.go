@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxSwapTWAPWindow defines the number of blocks the price observations are kept for the TWAP queries
+const MaxSwapTWAPWindow int64 = 100000
+
+// SwapPriceObservation defines the cumulative prices of a swap token pair at the beginning of the block, and the
+// prices at the end of the block, which last until the next observation
+type SwapPriceObservation struct {
+	BlockHeight          int64   `json:"block_height"`
+	BaseCumulativePrice  sdk.Dec `json:"base_cumulative_price"`  // Sum of the base prices at the end of each block before
+	QuoteCumulativePrice sdk.Dec `json:"quote_cumulative_price"` // Sum of the quote prices at the end of each block before
+	BasePrice            sdk.Dec `json:"base_price"`             // Price of the base token in the quote token
+	QuotePrice           sdk.Dec `json:"quote_price"`            // Price of the quote token in the base token
+}
+
+// NewSwapPriceObservation creates the price observation of the swap token pair at the block height
+func NewSwapPriceObservation(blockHeight int64, baseCumulativePrice, quoteCumulativePrice sdk.Dec,
+	swapTokenPair SwapTokenPair) SwapPriceObservation {
+	basePrice, quotePrice := sdk.ZeroDec(), sdk.ZeroDec()
+	if swapTokenPair.BasePooledCoin.IsPositive() && swapTokenPair.QuotePooledCoin.IsPositive() {
+		basePrice = swapTokenPair.QuotePooledCoin.Amount.Quo(swapTokenPair.BasePooledCoin.Amount)
+		quotePrice = swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount)
+	}
+	return SwapPriceObservation{
+		BlockHeight:          blockHeight,
+		BaseCumulativePrice:  baseCumulativePrice,
+		QuoteCumulativePrice: quoteCumulativePrice,
+		BasePrice:            basePrice,
+		QuotePrice:           quotePrice,
+	}
+}
+
+// SwapPriceObservationRecord defines the price observation of a swap token pair in the genesis state
+type SwapPriceObservationRecord struct {
+	TokenPairName string               `json:"token_pair_name"`
+	Observation   SwapPriceObservation `json:"observation"`
+}
+
+// CumulativePricesAt returns the cumulative prices at the beginning of the block height, which is not before the
+// block height of the observation
+func (o SwapPriceObservation) CumulativePricesAt(blockHeight int64) (baseCumulativePrice, quoteCumulativePrice sdk.Dec) {
+	blocks := sdk.NewDec(blockHeight - o.BlockHeight)
+	return o.BaseCumulativePrice.Add(o.BasePrice.MulTruncate(blocks)),
+		o.QuoteCumulativePrice.Add(o.QuotePrice.MulTruncate(blocks))
+}
+
+// SwapTWAP defines the time-weighted average prices of a swap token pair over the blocks from the start height
+// to the one before the end height
+type SwapTWAP struct {
+	TokenPairName string  `json:"token_pair_name"`
+	StartHeight   int64   `json:"start_height"`
+	EndHeight     int64   `json:"end_height"`
+	BasePrice     sdk.Dec `json:"base_price"`  // Average price of the base token in the quote token
+	QuotePrice    sdk.Dec `json:"quote_price"` // Average price of the quote token in the base token
+}
+
+// String implement fmt.Stringer
+func (t SwapTWAP) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`TokenPairName: %s
+StartHeight: %d
+EndHeight: %d
+BasePrice: %s
+QuotePrice: %s`, t.TokenPairName, t.StartHeight, t.EndHeight, t.BasePrice, t.QuotePrice))
+}
@@ -166,6 +166,13 @@ func GetNewDealsAndMatchResultsAtEndBlock(ctx sdk.Context, orderKeeper types.Ord
 
 		for _, record := range matchResult.Deals {
 			order := orderKeeper.GetOrder(ctx, record.OrderID)
+			// deals of continuous auction products are executed at different prices in one block
+			dealPrice := price
+			if !record.Price.IsNil() {
+				if p, err := strconv.ParseFloat(record.Price.String(), 64); err == nil {
+					dealPrice = p
+				}
+			}
 			if quantity, err := strconv.ParseFloat(record.Quantity.String(), 64); err == nil {
 
 				deal := &types.Deal{
@@ -174,7 +181,7 @@ func GetNewDealsAndMatchResultsAtEndBlock(ctx sdk.Context, orderKeeper types.Ord
 					Side:        record.Side,
 					Sender:      order.Sender.String(),
 					Product:     product,
-					Price:       price,
+					Price:       dealPrice,
 					Quantity:    quantity,
 					Fee:         record.Fee,
 					Timestamp:   ctx.BlockHeader().Time.Unix(),
@@ -210,6 +217,7 @@ func GetNewOrdersAtEndBlock(ctx sdk.Context, orderKeeper types.OrderKeeper) ([]*
 				FilledAvgPrice: order.FilledAvgPrice.String(),
 				RemainQuantity: order.RemainQuantity.String(),
 				Timestamp:      order.Timestamp,
+				OrderType:      order.OrderType,
 			}
 			orders = append(orders, orderDb)
 		} else {
@@ -238,6 +246,7 @@ func GetUpdatedOrdersAtEndBlock(ctx sdk.Context, orderKeeper types.OrderKeeper)
 				FilledAvgPrice: order.FilledAvgPrice.String(),
 				RemainQuantity: order.RemainQuantity.String(),
 				Timestamp:      order.Timestamp,
+				OrderType:      order.OrderType,
 			}
 			orders = append(orders, orderDb)
 		}
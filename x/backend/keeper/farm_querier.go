@@ -594,13 +594,15 @@ func queryFarmFirstPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		firstPool.Balance = accountCoins.AmountOf(farmPool.MinLockAmount.Denom)
 
 		// locked info
+		accountWeight := sdk.ZeroDec()
 		if lockedInfo, found := keeper.farmKeeper.GetLockInfo(ctx, address, farmPool.Name); found {
 			firstPool.AccountStaked = lockedInfo.Amount.Amount
+			accountWeight = lockedInfo.GetWeight()
 		}
 
-		// estimated farm
-		if !farmPool.TotalValueLocked.IsZero() {
-			firstPool.EstimatedFarm = farmAmount.Mul(firstPool.AccountStaked.Quo(farmPool.TotalValueLocked.Amount))
+		// estimated farm, which is shared by the weights boosted by the lock durations
+		if totalWeight := farmPool.GetTotalWeightedValueLocked(); !totalWeight.IsZero() {
+			firstPool.EstimatedFarm = farmAmount.Mul(accountWeight.Quo(totalWeight.Amount))
 		}
 
 		if firstPool.EstimatedFarm.IsZero() {
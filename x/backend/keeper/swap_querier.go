@@ -118,10 +118,11 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 			price24h = volumePriceInfo.Price24h
 		}
 
-		// calculate fee apy
+		// calculate fee apy, the protocol fee doesn't go to the liquidity providers
 		feeApy := sdk.ZeroDec()
 		if liquidity.IsPositive() && liquidity.IsPositive() {
-			feeApy = volume24h.Mul(swapParams.FeeRate).Quo(liquidity).Mul(sdk.NewDec(365))
+			lpFeeRate := swapTokenPair.GetFeeRate(swapParams).Mul(sdk.OneDec().Sub(swapParams.ProtocolFeeRate))
+			feeApy = volume24h.Mul(lpFeeRate).Quo(liquidity).Mul(sdk.NewDec(365))
 		}
 
 		// calculate price change
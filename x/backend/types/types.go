@@ -27,6 +27,10 @@ const (
 	FeeTypeOrderExpire  = orderTypes.FeeTypeOrderExpire
 	FeeTypeOrderDeal    = orderTypes.FeeTypeOrderDeal
 	FeeTypeOrderReceive = orderTypes.FeeTypeOrderReceive
+
+	FeeTypeOrderDealMaker  = orderTypes.FeeTypeOrderDealMaker
+	FeeTypeOrderDealTaker  = orderTypes.FeeTypeOrderDealTaker
+	FeeTypeOrderDealRebate = orderTypes.FeeTypeOrderDealRebate
 )
 
 type Ticker struct {
@@ -103,6 +107,7 @@ type Order struct {
 	FilledAvgPrice string `gorm:"type:varchar(40)" json:"filled_avg_price" v2:"filled_avg_price"`
 	RemainQuantity string `gorm:"type:varchar(40)" json:"remain_quantity" v2:"remain_quantity"`
 	Timestamp      int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+	OrderType      string `gorm:"type:varchar(20)" json:"order_type" v2:"order_type"`
 }
 
 type Transaction struct {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/okex/exchain/x/dex"
+	orderTypes "github.com/okex/exchain/x/order/types"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
@@ -107,11 +108,14 @@ func ConvertOrderToOrderV2(order Order) OrderV2 {
 	res.OrderID = order.OrderID
 	res.Price = order.Price
 	res.Size = order.Quantity
-	res.OrderType = "0"
+	res.OrderType = convertOrderTypeToV2(order.OrderType)
 	res.Notional = order.FilledAvgPrice
 	res.InstrumentID = order.Product
 	res.Side = order.Side
 	res.Type = "limit"
+	if order.OrderType == orderTypes.OrderTypeMarket {
+		res.Type = "market"
+	}
 	res.Timestamp = time.Unix(order.Timestamp, 0).UTC().Format("2006-01-02T15:04:05.000Z")
 	res.State = strconv.FormatInt(order.Status, 10)
 
@@ -124,6 +128,20 @@ func ConvertOrderToOrderV2(order Order) OrderV2 {
 	return res
 }
 
+// convertOrderTypeToV2 converts the order type to v2 order_type, 0: normal, 1: post only, 2: FOK, 3: IOC
+func convertOrderTypeToV2(orderType string) string {
+	switch orderType {
+	case orderTypes.OrderTypePostOnly:
+		return "1"
+	case orderTypes.OrderTypeFOK:
+		return "2"
+	case orderTypes.OrderTypeIOC:
+		return "3"
+	default:
+		return "0"
+	}
+}
+
 type QueryOrderParamsV2 struct {
 	OrderID string
 	Product string
@@ -326,3 +326,29 @@ func ValidateUint16Positive(param string) subspace.ValueValidatorFn {
 		return nil
 	}
 }
+
+// ParamGetter gets the params from the param store
+type ParamGetter interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+}
+
+// GetParamSetWithDefaults gets the params of the param set from the param store like GetParamSet of the subspace,
+// except that the params of the optional keys, which are added after the genesis, keep their values in the param
+// set if they don't exist, so that the chains upgraded from the versions without them use the defaults
+func GetParamSetWithDefaults(ctx sdk.Context, getter ParamGetter, ps subspace.ParamSet, optionalKeys ...[]byte) {
+	for _, pair := range ps.ParamSetPairs() {
+		optional := false
+		for _, key := range optionalKeys {
+			if bytes.Equal(key, pair.Key) {
+				optional = true
+				break
+			}
+		}
+		if optional {
+			getter.GetIfExists(ctx, pair.Key, pair.Value)
+		} else {
+			getter.Get(ctx, pair.Key, pair.Value)
+		}
+	}
+}
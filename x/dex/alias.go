@@ -45,6 +45,7 @@ type (
 	MsgConfirmOwnership  = types.MsgConfirmOwnership
 	MsgUpdateOperator    = types.MsgUpdateOperator
 	MsgCreateOperator    = types.MsgCreateOperator
+	MsgSetDealFeeRates   = types.MsgSetDealFeeRates
 
 	TokenPair     = types.TokenPair
 	Params        = types.Params
@@ -52,6 +53,7 @@ type (
 	WithdrawInfos = types.WithdrawInfos
 	DEXOperator   = types.DEXOperator
 	DEXOperators  = types.DEXOperators
+	DealFeeRates  = types.DealFeeRates
 )
 
 var (
@@ -68,5 +70,7 @@ var (
 	NewMsgDeposit  = types.NewMsgDeposit
 	NewMsgWithdraw = types.NewMsgWithdraw
 
+	NewDealFeeRates = types.NewDealFeeRates
+
 	ErrTokenPairNotFound   = types.ErrTokenPairNotFound
 )
@@ -33,6 +33,8 @@ const (
 	FlagTo                 = "to"
 	FlagWebsite            = "website"
 	FlagHandlingFeeAddress = "handling-fee-address"
+	FlagMakerFeeRate       = "maker-fee-rate"
+	FlagTakerFeeRate       = "taker-fee-rate"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -50,6 +52,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdConfirmOwnership(cdc),
 		getCmdRegisterOperator(cdc),
 		getCmdEditOperator(cdc),
+		getCmdSetDealFeeRates(cdc),
 	)...)
 
 	return txCmd
@@ -376,3 +379,54 @@ $ exchaincli tx dex edit-operator --website http://xxx/operator.json --handling-
 
 	return cmd
 }
+
+func getCmdSetDealFeeRates(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-deal-fee-rates",
+		Short: "set the maker and taker fee rates of a dex operator or its token pair",
+		Args:  cobra.ExactArgs(0),
+		Long: strings.TrimSpace(`Set the default maker and taker fee rates of a dex operator, or set them on a token pair
+owned by the dex operator with the product flag. A negative maker fee rate is a rebate to makers:
+
+$ exchaincli tx dex set-deal-fee-rates --maker-fee-rate=-0.0002 --taker-fee-rate=0.001 --from mykey
+$ exchaincli tx dex set-deal-fee-rates --product=btc-000_okt --maker-fee-rate=0 --taker-fee-rate=0.002 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			flags := cmd.Flags()
+			product, err := flags.GetString(FlagProduct)
+			if err != nil {
+				return err
+			}
+			makerFeeRateStr, err := flags.GetString(FlagMakerFeeRate)
+			if err != nil {
+				return err
+			}
+			makerFeeRate, err := sdk.NewDecFromStr(makerFeeRateStr)
+			if err != nil {
+				return fmt.Errorf("invalid maker fee rate: %s", makerFeeRateStr)
+			}
+			takerFeeRateStr, err := flags.GetString(FlagTakerFeeRate)
+			if err != nil {
+				return err
+			}
+			takerFeeRate, err := sdk.NewDecFromStr(takerFeeRateStr)
+			if err != nil {
+				return fmt.Errorf("invalid taker fee rate: %s", takerFeeRateStr)
+			}
+
+			msg := types.NewMsgSetDealFeeRates(cliCtx.GetFromAddress(), product, makerFeeRate, takerFeeRate)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagProduct, "", "the token pair to set the fee rates on, the default fee rates of the operator are set if it's empty")
+	cmd.Flags().String(FlagMakerFeeRate, "0", "the fee rate charged on makers, a negative one is a rebate to makers")
+	cmd.Flags().String(FlagTakerFeeRate, "0", "the fee rate charged on takers")
+
+	return cmd
+}
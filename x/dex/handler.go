@@ -60,6 +60,11 @@ func NewHandler(k IKeeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgUpdateOperator(ctx, k, msg, logger)
 			}
+		case MsgSetDealFeeRates:
+			name = "handleMsgSetDealFeeRates"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetDealFeeRates(ctx, k, msg, logger)
+			}
 		default:
 			return types.ErrDexUnknownMsgType(msg.Type()).Result()
 		}
@@ -329,3 +334,43 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetDealFeeRates(ctx sdk.Context, keeper IKeeper, msg MsgSetDealFeeRates, logger log.Logger) (*sdk.Result, error) {
+
+	logger.Debug(fmt.Sprintf("handleMsgSetDealFeeRates msg: %+v", msg))
+
+	if err := msg.FeeRates.Validate(keeper.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+	feeRates := msg.FeeRates
+
+	if msg.Product == "" {
+		// set the default fee schedule of the operator
+		operator, isExist := keeper.GetOperator(ctx, msg.Owner)
+		if !isExist {
+			return types.ErrUnknownOperator(msg.Owner).Result()
+		}
+		operator.FeeRates = &feeRates
+		keeper.SetOperator(ctx, operator)
+	} else {
+		// set the fee schedule of the token pair
+		tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+		if tokenPair == nil {
+			return types.ErrTokenPairNotFound(msg.Product).Result()
+		}
+		if !tokenPair.Owner.Equals(msg.Owner) {
+			return types.ErrMustTokenPairOwner(msg.Owner.String(), msg.Product).Result()
+		}
+		tokenPair.FeeRates = &feeRates
+		keeper.UpdateTokenPair(ctx, msg.Product, tokenPair)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
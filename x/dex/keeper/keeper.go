@@ -7,6 +7,7 @@ import (
 
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/dex/types"
 	"github.com/okex/exchain/x/params"
 )
@@ -310,7 +311,8 @@ func (k Keeper) SortProducts(ctx sdk.Context, products []string) {
 
 // GetParams gets inflation params from the global param store
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.GetParamSubspace().GetParamSet(ctx, &params)
+	params = *types.DefaultParams()
+	common.GetParamSetWithDefaults(ctx, k.GetParamSubspace(), &params, types.OptionalParamKeys...)
 	return params
 }
 
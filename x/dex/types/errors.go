@@ -43,6 +43,7 @@ const (
 	CodeIsTransferringOwner         uint32 = 64031
 	CodeTransferOwnerExpired        uint32 = 64032
 	CodeUnauthorizedOperator        uint32 = 64033
+	CodeInvalidDealFeeRates         uint32 = 64034
 )
 
 // Addr and Product All Required
@@ -186,3 +187,8 @@ func ErrIsTransferOwnerExpired(time string) sdk.EnvelopedErr {
 func ErrUnauthorizedOperator(operator, owner string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedOperator, fmt.Sprintf("%s is not the owner of operator(%s)", owner, operator))}
 }
+
+// ErrInvalidDealFeeRates returns an error when the maker and taker fee rates are invalid
+func ErrInvalidDealFeeRates(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidDealFeeRates, fmt.Sprintf("invalid deal fee rates: %s", msg))}
+}
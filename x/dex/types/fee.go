@@ -1,12 +1,75 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
 const (
 	defaultFeeList              = "20000"
 	defaultFeeTransferOwnership = "10"
 	defaultDelistMinDeposit     = "100"
+	defaultMinMakerFeeRate      = "-0.001"
+	defaultMaxTakerFeeRate      = "0.01"
 
 	// DefaultMaxPriceDigitSize defines default max price digit size
 	DefaultMaxPriceDigitSize = 4
 	// DefaultMaxQuantityDigitSize defines default max quantity digit size
 	DefaultMaxQuantityDigitSize = 4
 )
+
+// DealFeeRates defines the fee rates charged on deals of token pairs, a negative maker fee rate is a rebate to makers
+type DealFeeRates struct {
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// NewDealFeeRates creates a new instance of DealFeeRates
+func NewDealFeeRates(makerFeeRate, takerFeeRate sdk.Dec) DealFeeRates {
+	return DealFeeRates{
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+}
+
+// ValidateBasic checks the fee rates without the bounds set by governance.
+// The taker fee rate can't be negative, and the rebate to makers can't exceed the fee charged on takers
+func (r DealFeeRates) ValidateBasic() sdk.Error {
+	if r.MakerFeeRate.IsNil() || r.TakerFeeRate.IsNil() {
+		return ErrInvalidDealFeeRates("fee rates are required")
+	}
+	if r.TakerFeeRate.IsNegative() || r.TakerFeeRate.GTE(sdk.OneDec()) {
+		return ErrInvalidDealFeeRates(fmt.Sprintf("taker fee rate %s should be in [0, 1)", r.TakerFeeRate))
+	}
+	if r.MakerFeeRate.GT(r.TakerFeeRate) {
+		return ErrInvalidDealFeeRates(fmt.Sprintf("maker fee rate %s is greater than taker fee rate %s",
+			r.MakerFeeRate, r.TakerFeeRate))
+	}
+	if r.MakerFeeRate.Add(r.TakerFeeRate).IsNegative() {
+		return ErrInvalidDealFeeRates(fmt.Sprintf("maker rebate rate %s exceeds taker fee rate %s",
+			r.MakerFeeRate.Neg(), r.TakerFeeRate))
+	}
+	return nil
+}
+
+// Validate checks the fee rates with the bounds set by governance
+func (r DealFeeRates) Validate(params Params) sdk.Error {
+	if err := r.ValidateBasic(); err != nil {
+		return err
+	}
+	if r.MakerFeeRate.LT(params.MinMakerFeeRate) {
+		return ErrInvalidDealFeeRates(fmt.Sprintf("maker fee rate %s is less than %s",
+			r.MakerFeeRate, params.MinMakerFeeRate))
+	}
+	if r.TakerFeeRate.GT(params.MaxTakerFeeRate) {
+		return ErrInvalidDealFeeRates(fmt.Sprintf("taker fee rate %s is greater than %s",
+			r.TakerFeeRate, params.MaxTakerFeeRate))
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (r DealFeeRates) String() string {
+	return fmt.Sprintf("maker: %s, taker: %s", r.MakerFeeRate, r.TakerFeeRate)
+}
@@ -14,6 +14,7 @@ const (
 	typeMsgTransferOwnership = "transferOwnership"
 	typeMsgUpdateOperator    = "updateOperator"
 	typeMsgCreateOperator    = "createOperator"
+	typeMsgSetDealFeeRates   = "setDealFeeRates"
 )
 
 // MsgList - high level transaction of the dex module
@@ -324,6 +325,49 @@ func (msg MsgUpdateOperator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgSetDealFeeRates sets the maker and taker fee rates of deals
+// if Product is empty, the fee rates are set as the default fee schedule of the DEXOperator
+// else the fee rates are set on the token pair owned by the DEXOperator
+type MsgSetDealFeeRates struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Product  string         `json:"product"`
+	FeeRates DealFeeRates   `json:"fee_rates"`
+}
+
+// NewMsgSetDealFeeRates creates a new MsgSetDealFeeRates
+func NewMsgSetDealFeeRates(owner sdk.AccAddress, product string, makerFeeRate, takerFeeRate sdk.Dec) MsgSetDealFeeRates {
+	return MsgSetDealFeeRates{
+		Owner:    owner,
+		Product:  strings.TrimSpace(product),
+		FeeRates: NewDealFeeRates(makerFeeRate, takerFeeRate),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetDealFeeRates) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetDealFeeRates) Type() string { return typeMsgSetDealFeeRates }
+
+// ValidateBasic Implements Msg
+func (msg MsgSetDealFeeRates) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired("owner")
+	}
+	return msg.FeeRates.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg
+func (msg MsgSetDealFeeRates) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg
+func (msg MsgSetDealFeeRates) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 func checkWebsite(website string) sdk.Error {
 	if len(website) == 0 {
 		return nil
@@ -22,6 +22,7 @@ func TestMsg(t *testing.T) {
 	msgDeposit := NewMsgDeposit(product, sdk.NewDecCoin(common.NativeToken, sdk.NewInt(100)), addr)
 	msgWithdraw := NewMsgWithdraw(product, sdk.NewDecCoin(common.NativeToken, sdk.NewInt(100)), addr)
 	msgTransferOwnership := NewMsgTransferOwnership(addr, addr, product)
+	msgSetDealFeeRates := NewMsgSetDealFeeRates(addr, product, sdk.MustNewDecFromStr("-0.0002"), sdk.MustNewDecFromStr("0.001"))
 
 	// test msg.Route()、msg.Type()、msg.GetSigners()、GetSignBytes()
 	type Want struct {
@@ -43,6 +44,8 @@ func TestMsg(t *testing.T) {
 			Want{"dex", typeMsgWithdraw, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgWithdraw)), []sdk.AccAddress{addr}}},
 		{"msgTransferOwnership", msgTransferOwnership,
 			Want{"dex", typeMsgTransferOwnership, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgTransferOwnership)), []sdk.AccAddress{addr}}},
+		{"msgSetDealFeeRates", msgSetDealFeeRates,
+			Want{"dex", typeMsgSetDealFeeRates, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgSetDealFeeRates)), []sdk.AccAddress{addr}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -88,6 +91,12 @@ func TestMsg(t *testing.T) {
 		{"transfer-no-from", NewMsgTransferOwnership(nil, toAddr, product), false},
 		{"transfer-no-to", NewMsgTransferOwnership(fromAddr, nil, product), false},
 		{"transfer-no-product", NewMsgTransferOwnership(fromAddr, toAddr, ""), false},
+
+		{"msgSetDealFeeRates", msgSetDealFeeRates, true},
+		{"set-fee-rates-no-owner", NewMsgSetDealFeeRates(nil, product, sdk.ZeroDec(), sdk.ZeroDec()), false},
+		{"set-fee-rates-negative-taker", NewMsgSetDealFeeRates(addr, product, sdk.ZeroDec(), sdk.MustNewDecFromStr("-0.001")), false},
+		{"set-fee-rates-maker-above-taker", NewMsgSetDealFeeRates(addr, product, sdk.MustNewDecFromStr("0.002"), sdk.MustNewDecFromStr("0.001")), false},
+		{"set-fee-rates-rebate-above-taker", NewMsgSetDealFeeRates(addr, product, sdk.MustNewDecFromStr("-0.002"), sdk.MustNewDecFromStr("0.001")), false},
 	}
 	for _, tb := range testBasics {
 		t.Run(tb.name, func(t *testing.T) {
@@ -101,3 +110,17 @@ func TestMsg(t *testing.T) {
 	}
 
 }
+
+func TestDealFeeRatesValidate(t *testing.T) {
+	params := DefaultParams()
+
+	require.Nil(t, NewDealFeeRates(params.MinMakerFeeRate, params.MaxTakerFeeRate).Validate(*params))
+	require.Nil(t, NewDealFeeRates(sdk.ZeroDec(), sdk.ZeroDec()).Validate(*params))
+	require.NotNil(t, DealFeeRates{}.Validate(*params))
+
+	// out of the bounds set by governance
+	require.NotNil(t, NewDealFeeRates(params.MinMakerFeeRate.Sub(sdk.NewDecWithPrec(1, 4)),
+		params.MaxTakerFeeRate).Validate(*params))
+	require.NotNil(t, NewDealFeeRates(sdk.ZeroDec(),
+		params.MaxTakerFeeRate.Add(sdk.NewDecWithPrec(1, 4))).Validate(*params))
+}
@@ -14,6 +14,9 @@ type DEXOperator struct {
 	Website            string         `json:"website"`
 	InitHeight         int64          `json:"init_height"`
 	TxHash             string         `json:"tx_hash"`
+	// FeeRates is the default fee schedule of the token pairs owned by the operator, the trade fee rate of order
+	// params is charged on both sides of deals if it is not set
+	FeeRates *DealFeeRates `json:"fee_rates,omitempty"`
 }
 
 // nolint
@@ -26,7 +29,15 @@ func (o DEXOperator) String() string {
   TxHash:               %s`,
 		o.Address, o.HandlingFeeAddress, o.Website,
 		o.InitHeight, o.TxHash,
-	)
+	) + feeRatesString(o.FeeRates)
+}
+
+func feeRatesString(feeRates *DealFeeRates) string {
+	if feeRates == nil {
+		return ""
+	}
+	return fmt.Sprintf(`
+  Fee Rates:            %s`, feeRates)
 }
 
 type DEXOperators []DEXOperator
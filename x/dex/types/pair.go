@@ -23,6 +23,8 @@ type TokenPair struct {
 	Owner            sdk.AccAddress `json:"owner"`
 	Deposits         sdk.SysCoin    `json:"deposits"`
 	BlockHeight      int64          `json:"block_height"`
+	// FeeRates is the fee schedule of the token pair, which overrides the one of its owner
+	FeeRates *DealFeeRates `json:"fee_rates,omitempty"`
 }
 
 // Name returns name of token pair
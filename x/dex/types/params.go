@@ -18,6 +18,11 @@ var (
 	keyDelistVotingPeriod     = []byte("DelistVotingPeriod")
 	keyWithdrawPeriod         = []byte("WithdrawPeriod")
 	keyOwnershipConfirmWindow = []byte("OwnershipConfirmWindow")
+	keyMinMakerFeeRate        = []byte("MinMakerFeeRate")
+	keyMaxTakerFeeRate        = []byte("MaxTakerFeeRate")
+
+	// OptionalParamKeys are the keys of the params added after the genesis, which are the defaults until set
+	OptionalParamKeys = [][]byte{keyMinMakerFeeRate, keyMaxTakerFeeRate}
 )
 
 // Params defines param object
@@ -35,6 +40,11 @@ type Params struct {
 
 	WithdrawPeriod         time.Duration `json:"withdraw_period"`
 	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+
+	// the lower bound of maker fee rates set by operators, a negative one allows rebates to makers
+	MinMakerFeeRate sdk.Dec `json:"min_maker_fee_rate"`
+	// the upper bound of taker fee rates set by operators
+	MaxTakerFeeRate sdk.Dec `json:"max_taker_fee_rate"`
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -48,7 +58,20 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: keyDelistVotingPeriod, Value: &p.DelistVotingPeriod, ValidatorFn: common.ValidateDurationPositive("delist voting period")},
 		{Key: keyWithdrawPeriod, Value: &p.WithdrawPeriod, ValidatorFn: common.ValidateDurationPositive("withdraw period")},
 		{Key: keyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: common.ValidateDurationPositive("ownership confirm window")},
+		{Key: keyMinMakerFeeRate, Value: &p.MinMakerFeeRate, ValidatorFn: validateMinMakerFeeRate},
+		{Key: keyMaxTakerFeeRate, Value: &p.MaxTakerFeeRate, ValidatorFn: common.ValidateRateNotNeg("max taker fee rate")},
+	}
+}
+
+func validateMinMakerFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LTE(sdk.OneDec().Neg()) || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("min maker fee rate should be in (-1, 1): %s", v)
 	}
+	return nil
 }
 
 // ParamKeyTable for auth module
@@ -70,12 +93,16 @@ func DefaultParams() *Params {
 		DelistVotingPeriod:     time.Hour * 72,
 		WithdrawPeriod:         DefaultWithdrawPeriod,
 		OwnershipConfirmWindow: DefaultOwnershipConfirmWindow,
+		MinMakerFeeRate:        sdk.MustNewDecFromStr(defaultMinMakerFeeRate),
+		MaxTakerFeeRate:        sdk.MustNewDecFromStr(defaultMaxTakerFeeRate),
 	}
 }
 
 // String implements the stringer interface.
 func (p Params) String() string {
 	return fmt.Sprintf("Params: \nDexListFee:%s\nTransferOwnershipFee:%s\nRegisterOperatorFee:%s\nDelistMaxDepositPeriod:%s\n"+
-		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n",
-		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow)
+		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n"+
+		"MinMakerFeeRate:%s\nMaxTakerFeeRate:%s\n",
+		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow,
+		p.MinMakerFeeRate, p.MaxTakerFeeRate)
 }
@@ -40,6 +40,36 @@ func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, vali
 	return res, err
 }
 
+// QueryDelegationRewards returns the pending rewards of a delegator on a validator
+func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute string, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delAddr, valAddr)),
+	)
+	return res, err
+}
+
+// QueryDelegatorTotalRewards returns the pending rewards of a delegator on all the validators it added shares to
+func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute string, delAddr sdk.AccAddress) (
+	[]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorTotalRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delAddr)),
+	)
+	return res, err
+}
+
+// QueryValidatorOutstandingRewards returns the outstanding rewards of the delegators on a validator
+func QueryValidatorOutstandingRewards(cliCtx context.CLIContext, queryRoute string, valAddr sdk.ValAddress) (
+	[]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorOutstandingRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorOutstandingRewardsParams(valAddr)),
+	)
+	return res, err
+}
+
 // WithdrawValidatorRewardsAndCommission builds a two-message message slice to be
 // used to withdraw both validation's commission and self-delegation reward.
 func WithdrawValidatorRewardsAndCommission(validatorAddr sdk.ValAddress) ([]sdk.Msg, error) {
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+	"github.com/okex/exchain/x/staking/exported"
+)
+
+// initializeDelegation initializes the starting info of a delegator on a validator with the current shares
+func (k Keeper) initializeDelegation(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) {
+	shares, found := k.stakingKeeper.GetShares(ctx, del, val)
+	if !found {
+		return
+	}
+
+	// period has already been incremented - we want to store the period ended by this delegation action
+	previousPeriod := k.GetValidatorCurrentRewards(ctx, val).Period - 1
+
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, val, previousPeriod)
+
+	k.SetDelegatorStartingInfo(ctx, val, del, types.NewDelegatorStartingInfo(previousPeriod, shares,
+		uint64(ctx.BlockHeight())))
+}
+
+// calculateDelegationRewardsBetween calculates the rewards of the stake between two periods
+func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, val exported.ValidatorI,
+	startingPeriod, endingPeriod uint64, stake sdk.Dec) (rewards sdk.SysCoins) {
+	// sanity check
+	if startingPeriod > endingPeriod {
+		panic("startingPeriod cannot be greater than endingPeriod")
+	}
+
+	// sanity check
+	if stake.IsNegative() {
+		panic("stake should not be negative")
+	}
+
+	// return staking * (ending - starting)
+	starting := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), startingPeriod)
+	ending := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), endingPeriod)
+	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+	if difference.IsAnyNegative() {
+		panic("negative rewards should not be possible")
+	}
+	// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+	return difference.MulDecTruncate(stake)
+}
+
+// getDelegatorStartingInfo returns the starting info of a delegator on a validator. The delegators added shares
+// before the rewards distribution have no starting info until the shares are modified, so they start from period 0
+// with their current shares. The returned flag reports whether the starting info is stored.
+func (k Keeper) getDelegatorStartingInfo(ctx sdk.Context, val exported.ValidatorI, del sdk.AccAddress) (
+	startingInfo types.DelegatorStartingInfo, stored bool) {
+	if startingInfo, found := k.GetDelegatorStartingInfo(ctx, val.GetOperator(), del); found {
+		return startingInfo, true
+	}
+
+	// period 0 is kept only on the validators initialized after the delegators added shares
+	shares, found := k.stakingKeeper.GetShares(ctx, del, val.GetOperator())
+	if !found || k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), 0).ReferenceCount == 0 {
+		shares = sdk.ZeroDec()
+	}
+	return types.NewDelegatorStartingInfo(0, shares, 0), false
+}
+
+// calculateDelegationRewards calculates the rewards of a delegator on a validator up to the ending period
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del sdk.AccAddress,
+	endingPeriod uint64) (rewards sdk.SysCoins) {
+	startingInfo, _ := k.getDelegatorStartingInfo(ctx, val, del)
+	return k.calculateDelegationRewardsBetween(ctx, val, startingInfo.PreviousPeriod, endingPeriod, startingInfo.Stake)
+}
+
+// withdrawDelegationRewards withdraws the rewards of a delegator on a validator and removes the starting info, which
+// must be initialized again by the caller if the delegator still has shares on the validator
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del sdk.AccAddress) (
+	sdk.Coins, error) {
+	k.checkValidatorRewardsInitialized(ctx, val.GetOperator())
+
+	// end current period and calculate rewards
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	startingInfo, stored := k.getDelegatorStartingInfo(ctx, val, del)
+	rewardsRaw := k.calculateDelegationRewardsBetween(ctx, val, startingInfo.PreviousPeriod, endingPeriod,
+		startingInfo.Stake)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
+
+	// defensive edge case may happen on the very final digits
+	// of the decCoins due to operation order of the distribution mechanism.
+	rewards := rewardsRaw.Intersect(outstanding)
+
+	// truncate coins, return remainder to community pool
+	coins, remainder := rewards.TruncateDecimal()
+
+	// add coins to user account
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+		}
+	}
+
+	// update the outstanding rewards and the community pool only if the transaction was successful
+	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding.Sub(rewards))
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	k.SetFeePool(ctx, feePool)
+
+	// decrement reference count of starting period and remove delegator starting info, period 0 is kept for the
+	// delegators without starting info
+	if stored {
+		k.decrementReferenceCount(ctx, val.GetOperator(), startingInfo.PreviousPeriod)
+		k.deleteDelegatorStartingInfo(ctx, val.GetOperator(), del)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+		),
+	)
+
+	return coins, nil
+}
+
+// WithdrawDelegationRewards withdraws the rewards of a delegator on a validator
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.Coins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrUnknownValidator(valAddr.String())
+	}
+	if _, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr); !found {
+		return nil, types.ErrNoDelegationExists(delAddr.String(), valAddr.String())
+	}
+
+	// withdraw rewards
+	rewards, err := k.withdrawDelegationRewards(ctx, val, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+	return rewards, nil
+}
+
+// CalculateDelegationRewards calculates the pending rewards of a delegator on a validator until now
+func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrUnknownValidator(valAddr.String())
+	}
+	if _, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr); !found {
+		return nil, types.ErrNoDelegationExists(delAddr.String(), valAddr.String())
+	}
+
+	// end the current period on a cache context, so that the rewards in the current period are counted
+	cacheCtx, _ := ctx.CacheContext()
+	k.checkValidatorRewardsInitialized(cacheCtx, valAddr)
+	endingPeriod := k.incrementValidatorPeriod(cacheCtx, val)
+	return k.calculateDelegationRewards(cacheCtx, val, delAddr, endingPeriod), nil
+}
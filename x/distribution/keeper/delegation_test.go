@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+	"github.com/okex/exchain/x/staking"
+)
+
+func TestWithdrawDelegationRewards(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	h := staking.NewHandler(sk)
+
+	// 1. the validator lowers the commission rate to 0.5
+	rate := sdk.NewDecWithPrec(5, 1)
+	_, err := h(ctx, staking.NewMsgEditValidator(valOpAddr1, staking.Description{}, &rate))
+	require.Nil(t, err)
+	rate = sdk.NewDecWithPrec(4, 1)
+	_, err = h(ctx, staking.NewMsgEditValidator(valOpAddr1, staking.Description{}, &rate))
+	require.NotNil(t, err)
+
+	// 2. the delegator adds shares to the validator
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr1, []sdk.ValAddress{valOpAddr1}))
+	require.Nil(t, err)
+	_, found := k.GetDelegatorStartingInfo(ctx, valOpAddr1, delAddr1)
+	require.True(t, found)
+
+	// 3. allocate tokens to the validator, half of which goes to the delegators
+	tokens := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr2, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	require.Equal(t, NewTestSysCoins(50, 0), k.GetValidatorAccumulatedCommission(ctx, valOpAddr1))
+	require.Equal(t, NewTestSysCoins(50, 0), k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
+
+	// 4. the pending rewards are queried without changing the state
+	querier := NewQuerier(k)
+	bz, err := querier(ctx, []string{types.QueryDelegationRewards},
+		abci.RequestQuery{Data: k.cdc.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delAddr1, valOpAddr1))})
+	require.Nil(t, err)
+	var pending sdk.SysCoins
+	k.cdc.MustUnmarshalJSON(bz, &pending)
+	// the min self delegation holds one share of the validator
+	require.True(t, pending.AmountOf(sdk.DefaultBondDenom).LT(sdk.NewDec(50)))
+	require.True(t, pending.AmountOf(sdk.DefaultBondDenom).GT(sdk.NewDec(49)))
+	require.Equal(t, uint64(1), k.GetValidatorCurrentRewards(ctx, valOpAddr1).Period-1)
+
+	bz, err = querier(ctx, []string{types.QueryDelegatorTotalRewards},
+		abci.RequestQuery{Data: k.cdc.MustMarshalJSON(types.NewQueryDelegatorParams(delAddr1))})
+	require.Nil(t, err)
+	var total types.QueryDelegatorTotalRewardsResponse
+	k.cdc.MustUnmarshalJSON(bz, &total)
+	require.Equal(t, 1, len(total.Rewards))
+	require.Equal(t, pending, total.Total)
+
+	// 5. withdraw the rewards, the decimal part goes to the community pool
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, NewTestSysCoins(49, 0), rewards)
+	require.Equal(t, balance.Add(rewards...), ak.GetAccount(ctx, delAddr1).GetCoins())
+	_, err = k.WithdrawDelegationRewards(ctx, delAddr2, valOpAddr1)
+	require.NotNil(t, err)
+
+	// 6. the delegator withdraws the shares and the rewards are withdrawn by the hook
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), NewTestSysCoins(20, 0))
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr2, types.ModuleName, NewTestSysCoins(20, 0)))
+	balance = ak.GetAccount(ctx, delAddr1).GetCoins()
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr1, []sdk.ValAddress{valOpAddr2}))
+	require.Nil(t, err)
+	require.Equal(t, balance.Add(NewTestSysCoins(9, 0)...), ak.GetAccount(ctx, delAddr1).GetCoins())
+	_, found = k.GetDelegatorStartingInfo(ctx, valOpAddr1, delAddr1)
+	require.False(t, found)
+	_, found = k.GetDelegatorStartingInfo(ctx, valOpAddr2, delAddr1)
+	require.True(t, found)
+
+	for _, invariant := range []sdk.Invariant{NonNegativeOutstandingInvariant(k), ModuleAccountInvariant(k)} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
+
+func TestWithdrawDelegationRewardsWithoutStartingInfo(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	h := staking.NewHandler(sk)
+
+	rate := sdk.NewDecWithPrec(5, 1)
+	_, err := h(ctx, staking.NewMsgEditValidator(valOpAddr1, staking.Description{}, &rate))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr1, []sdk.ValAddress{valOpAddr1}))
+	require.Nil(t, err)
+
+	// 1. the delegator added shares before the rewards distribution, so neither the validator nor the delegator has
+	// the rewards records
+	k.deleteDelegatorStartingInfo(ctx, valOpAddr1, delAddr1)
+	k.deleteValidatorOutstandingRewards(ctx, valOpAddr1)
+	k.deleteValidatorHistoricalRewards(ctx, valOpAddr1)
+	k.deleteValidatorCurrentRewards(ctx, valOpAddr1)
+
+	// 2. allocate tokens to the validator, half of which goes to the delegators
+	tokens := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr2, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	require.Equal(t, uint16(2), k.GetValidatorHistoricalRewards(ctx, valOpAddr1, 0).ReferenceCount)
+
+	// 3. the delegator earns the rewards from period 0 with the current shares
+	pending, err := k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, pending.AmountOf(sdk.DefaultBondDenom).GT(sdk.NewDec(49)))
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, NewTestSysCoins(49, 0), rewards)
+	require.Equal(t, balance.Add(rewards...), ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// 4. the delegator starts from the withdrawal period, and period 0 is kept
+	info, found := k.GetDelegatorStartingInfo(ctx, valOpAddr1, delAddr1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), info.PreviousPeriod)
+	require.Equal(t, uint16(1), k.GetValidatorHistoricalRewards(ctx, valOpAddr1, 0).ReferenceCount)
+	rewards, err = k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	for _, invariant := range []sdk.Invariant{NonNegativeOutstandingInvariant(k), ModuleAccountInvariant(k)} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
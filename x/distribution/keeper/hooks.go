@@ -49,11 +49,45 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// remove commission record
 	h.k.deleteValidatorAccumulatedCommission(ctx, valAddr)
+
+	// the outstanding rewards left, which belong to the shares of the min self delegation, go to the community pool
+	outstanding := h.k.GetValidatorOutstandingRewards(ctx, valAddr)
+	if !outstanding.IsZero() {
+		feePool := h.k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(outstanding...)
+		h.k.SetFeePool(ctx, feePool)
+	}
+
+	// remove the delegator rewards records
+	h.k.deleteValidatorOutstandingRewards(ctx, valAddr)
+	h.k.deleteValidatorHistoricalRewards(ctx, valAddr)
+	h.k.deleteValidatorCurrentRewards(ctx, valAddr)
 }
 
-// AfterValidatorDestroyed nothing to do
+// AfterValidatorDestroyed ends the current period before the shares of the min self delegation are removed from the
+// validator
 func (h Hooks) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.checkValidatorRewardsInitialized(ctx, valAddr)
+	h.k.incrementValidatorPeriod(ctx, h.k.stakingKeeper.Validator(ctx, valAddr))
+}
+
+// BeforeDelegationCreated ends the current period of the validator before the delegator adds shares to it
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.checkValidatorRewardsInitialized(ctx, valAddr)
+	h.k.incrementValidatorPeriod(ctx, h.k.stakingKeeper.Validator(ctx, valAddr))
+}
+
+// BeforeDelegationSharesModified withdraws the rewards of the delegator before the shares are modified or removed
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	val := h.k.stakingKeeper.Validator(ctx, valAddr)
+	if _, err := h.k.withdrawDelegationRewards(ctx, val, delAddr); err != nil {
+		panic(err)
+	}
+}
 
+// AfterDelegationModified reinitializes the starting info of the delegator with the new shares
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.initializeDelegation(ctx, valAddr, delAddr)
 }
 
 // nolint - unused hooks
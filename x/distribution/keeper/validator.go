@@ -11,4 +11,91 @@ import (
 func (k Keeper) initializeValidator(ctx sdk.Context, val exported.ValidatorI) {
 	// set accumulated commissions
 	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), types.InitialValidatorAccumulatedCommission())
+
+	k.initializeValidatorRewards(ctx, val.GetOperator())
+}
+
+// initializeValidatorRewards initializes the delegator rewards records of a validator
+func (k Keeper) initializeValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	// set initial historical rewards (period 0) with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, valAddr, 0, types.NewValidatorHistoricalRewards(sdk.SysCoins{}, 1))
+
+	// set current rewards (starting at period 1)
+	k.SetValidatorCurrentRewards(ctx, valAddr, types.NewValidatorCurrentRewards(sdk.SysCoins{}, 1))
+
+	// set outstanding rewards
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{})
+}
+
+// checkValidatorRewardsInitialized initializes the delegator rewards records of a validator created before the
+// rewards of the delegators were distributed
+func (k Keeper) checkValidatorRewardsInitialized(ctx sdk.Context, valAddr sdk.ValAddress) {
+	if !k.hasValidatorOutstandingRewards(ctx, valAddr) {
+		k.initializeValidatorRewards(ctx, valAddr)
+		// the delegators added shares before the initialization start from period 0, which is kept for them
+		k.incrementReferenceCount(ctx, valAddr, 0)
+	}
+}
+
+// incrementValidatorPeriod increments the period of a validator, returning the period just ended
+func (k Keeper) incrementValidatorPeriod(ctx sdk.Context, val exported.ValidatorI) uint64 {
+	// fetch current rewards
+	rewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+
+	// calculate current ratio
+	var current sdk.SysCoins
+	if val.GetDelegatorShares().IsZero() {
+		// can't calculate ratio for zero-share validators
+		// ergo we instead add to the community pool
+		feePool := k.GetFeePool(ctx)
+		outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
+		feePool.CommunityPool = feePool.CommunityPool.Add(rewards.Rewards...)
+		outstanding = outstanding.Sub(rewards.Rewards)
+		k.SetFeePool(ctx, feePool)
+		k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
+
+		current = sdk.SysCoins{}
+	} else {
+		// note: necessary to truncate so we don't allow withdrawing more rewards than owed
+		current = rewards.Rewards.QuoDecTruncate(val.GetDelegatorShares())
+	}
+
+	// fetch historical rewards for last period
+	historical := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period-1).CumulativeRewardRatio
+
+	// decrement reference count
+	k.decrementReferenceCount(ctx, val.GetOperator(), rewards.Period-1)
+
+	// set new historical rewards with reference count of 1
+	k.SetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period,
+		types.NewValidatorHistoricalRewards(historical.Add(current...), 1))
+
+	// set current rewards, incrementing period by 1
+	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), types.NewValidatorCurrentRewards(sdk.SysCoins{}, rewards.Period+1))
+
+	return rewards.Period
+}
+
+// incrementReferenceCount increments the reference count for a historical rewards value
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if historical.ReferenceCount > 2 {
+		panic("reference count should never exceed 2")
+	}
+	historical.ReferenceCount++
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+}
+
+// decrementReferenceCount decrements the reference count for a historical rewards value, and deletes it if zero
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	historical := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if historical.ReferenceCount == 0 {
+		panic("cannot set negative reference count")
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.deleteValidatorHistoricalReward(ctx, valAddr, period)
+	} else {
+		k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+	}
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// DelegatorStartingInfo is the starting info of the rewards of a delegator on a validator, it's reset whenever the
+// shares of the delegator on the validator change
+type DelegatorStartingInfo struct {
+	PreviousPeriod uint64  `json:"previous_period" yaml:"previous_period"`
+	Stake          sdk.Dec `json:"stake" yaml:"stake"`
+	Height         uint64  `json:"height" yaml:"height"`
+}
+
+// NewDelegatorStartingInfo creates a new instance of DelegatorStartingInfo
+func NewDelegatorStartingInfo(previousPeriod uint64, stake sdk.Dec, height uint64) DelegatorStartingInfo {
+	return DelegatorStartingInfo{
+		PreviousPeriod: previousPeriod,
+		Stake:          stake,
+		Height:         height,
+	}
+}
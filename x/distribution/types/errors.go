@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 )
@@ -28,6 +30,8 @@ const (
 	CodeBadDistribution                             uint32 = 67816
 	CodeInvalidProposalAmount                       uint32 = 67817
 	CodeEmptyProposalRecipient                      uint32 = 67818
+	CodeNoDelegationExists                          uint32 = 67819
+	CodeUnknownValidator                            uint32 = 67820
 )
 
 func ErrNilDelegatorAddr() sdk.Error {
@@ -89,3 +93,12 @@ func ErrInvalidProposalAmount() sdk.Error {
 func ErrEmptyProposalRecipient() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeEmptyProposalRecipient, "invalid community pool spend proposal recipient")
 }
+
+func ErrNoDelegationExists(delAddr, valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoDelegationExists,
+		fmt.Sprintf("delegator %s has no shares on validator %s", delAddr, valAddr))
+}
+
+func ErrUnknownValidator(valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeUnknownValidator, fmt.Sprintf("validator %s does not exist", valAddr))
+}
@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeSetWithdrawAddress = "set_withdraw_address"
 	EventTypeCommission         = "commission"
+	EventTypeRewards            = "rewards"
+	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
@@ -85,3 +85,17 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgWithdrawDelegatorReward test ValidateBasic for MsgWithdrawDelegatorReward
+func TestMsgWithdrawDelegatorReward(t *testing.T) {
+	msg := NewMsgWithdrawDelegatorReward(delAddr1, valAddr1)
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	require.Equal(t, ModuleName, msg.Route())
+	require.Equal(t, "withdraw_delegator_reward", msg.Type())
+	require.Equal(t, []sdk.AccAddress{delAddr1}, msg.GetSigners())
+	require.Equal(t, sdk.MustSortJSON(bz), msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, NewMsgWithdrawDelegatorReward(emptyDelAddr, valAddr1).ValidateBasic())
+	require.Error(t, NewMsgWithdrawDelegatorReward(delAddr1, emptyValAddr).ValidateBasic())
+}
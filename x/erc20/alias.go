@@ -0,0 +1,25 @@
+package erc20
+
+import (
+	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+const (
+	StoreKey          = types.StoreKey
+	DefaultParamspace = types.DefaultParamspace
+	DefaultCodespace  = types.DefaultCodespace
+	ModuleName        = types.ModuleName
+	RouterKey         = types.RouterKey
+)
+
+var (
+	NewKeeper          = keeper.NewKeeper
+	NewBridgeContract  = keeper.NewBridgeContract
+	RegisterInvariants = keeper.RegisterInvariants
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+)
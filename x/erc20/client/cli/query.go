@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/erc20/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	erc20QueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	erc20QueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryTokenMapping(queryRoute, cdc),
+			GetCmdQueryTokenMappings(queryRoute, cdc),
+			GetCmdQueryContract(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return erc20QueryCmd
+}
+
+// GetCmdQueryTokenMapping gets the token mapping query command.
+func GetCmdQueryTokenMapping(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mapping [denom]",
+		Short: "query the token mapping of a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the erc20 contract mapped to the denom.
+
+Example:
+$ %s query erc20 token-mapping xxb
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryTokenMappingParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenMapping)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var tokenMapping types.TokenMapping
+			cdc.MustUnmarshalJSON(resp, &tokenMapping)
+			return cliCtx.PrintOutput(tokenMapping)
+		},
+	}
+}
+
+// GetCmdQueryTokenMappings gets the token mappings query command.
+func GetCmdQueryTokenMappings(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mappings",
+		Short: "query all the token mappings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the denoms and their erc20 contracts.
+
+Example:
+$ %s query erc20 token-mappings
+`, version.ClientName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenMappings)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tokenMappings []types.TokenMapping
+			cdc.MustUnmarshalJSON(resp, &tokenMappings)
+			return cliCtx.PrintOutput(tokenMappings)
+		},
+	}
+}
+
+// GetCmdQueryContract gets the token mapping of an erc20 contract query command.
+func GetCmdQueryContract(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract [contract-address]",
+		Short: "query the token mapping of an erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the denom mapped to the erc20 contract.
+
+Example:
+$ %s query erc20 contract 0x4B5e2Dc68C3D2a1d9bC8a8c4A5aF8b7CE1cE5e3A
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryContractParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryContract)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var tokenMapping types.TokenMapping
+			cdc.MustUnmarshalJSON(resp, &tokenMapping)
+			return cliCtx.PrintOutput(tokenMapping)
+		},
+	}
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current erc20 parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as erc20 parameters.
+
+Example:
+$ %s query erc20 params
+`, version.ClientName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryParameters)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(bz, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
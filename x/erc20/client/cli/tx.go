@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	erc20utils "github.com/okex/exchain/x/erc20/client/utils"
+	"github.com/okex/exchain/x/erc20/types"
+	"github.com/okex/exchain/x/gov"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	erc20TxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	erc20TxCmd.AddCommand(client.PostCommands(
+		GetCmdConvertNative(cdc),
+		GetCmdConvertERC20(cdc),
+	)...)
+	return erc20TxCmd
+}
+
+// GetCmdConvertNative gets the command to convert native coins into erc20 balances
+func GetCmdConvertNative(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-native [amount]",
+		Short: "convert native coins into the balance of their erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert native coins into the balance of their erc20 contract 1:1.
+
+Example:
+$ %s tx erc20 convert-native 10xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConvertNative(cliCtx.GetFromAddress(), amount)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConvertERC20 gets the command to convert erc20 balances into native coins
+func GetCmdConvertERC20(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-erc20 [amount]",
+		Short: "convert the balance of an erc20 contract into its native coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the balance of the erc20 contract mapped to the denom into native coins 1:1.
+
+Example:
+$ %s tx erc20 convert-erc20 10xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConvertERC20(cliCtx.GetFromAddress(), amount)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenMappingProposal implements a command handler for submitting a token mapping proposal transaction
+func GetCmdTokenMappingProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mapping [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to deploy the erc20 contract of a token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to deploy the erc20 contract of a token along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal token-mapping <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "map xxb",
+ "description": "deploy the erc20 contract of xxb",
+ "denom": "xxb",
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := erc20utils.ParseTokenMappingProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTokenMappingProposal(proposal.Title, proposal.Description, proposal.Denom)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdPauseTokenMappingProposal implements a command handler for submitting a pause token mapping proposal
+// transaction
+func GetCmdPauseTokenMappingProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause-token-mapping [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pause or resume the conversions of a token mapping",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume the conversions of a token mapping along with an initial
+deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal pause-token-mapping <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "pause xxb",
+ "description": "pause the conversions between xxb and its erc20 contract",
+ "denom": "xxb",
+ "is_paused": true,
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := erc20utils.ParsePauseTokenMappingProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPauseTokenMappingProposal(proposal.Title, proposal.Description, proposal.Denom,
+				proposal.IsPaused)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get all the token mappings
+	r.HandleFunc(
+		"/erc20/token_mappings",
+		queryTokenMappingsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the token mapping of a denom
+	r.HandleFunc(
+		"/erc20/token_mapping/{denom}",
+		queryWithParamsHandlerFn(cliCtx, types.QueryTokenMapping, func(vars map[string]string) interface{} {
+			return types.NewQueryTokenMappingParams(vars["denom"])
+		}),
+	).Methods("GET")
+
+	// get the token mapping of an erc20 contract
+	r.HandleFunc(
+		"/erc20/contract/{contract}",
+		queryWithParamsHandlerFn(cliCtx, types.QueryContract, func(vars map[string]string) interface{} {
+			return types.NewQueryContractParams(vars["contract"])
+		}),
+	).Methods("GET")
+
+	// get the current erc20 parameter values
+	r.HandleFunc(
+		"/erc20/parameters",
+		queryParamsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func queryWithParamsHandlerFn(cliCtx context.CLIContext, path string,
+	newParams func(vars map[string]string) interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(newParams(mux.Vars(r)))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+		res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTokenMappingsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryHandlerFn(cliCtx, types.QueryTokenMappings)
+}
+
+func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryHandlerFn(cliCtx, types.QueryParameters)
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
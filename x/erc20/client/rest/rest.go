@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+// RegisterRoutes registers erc20-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+// TokenMappingProposalRESTHandler defines erc20 proposal handler
+func TokenMappingProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// PauseTokenMappingProposalRESTHandler defines erc20 proposal handler
+func PauseTokenMappingProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
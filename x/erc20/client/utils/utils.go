@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// TokenMappingProposalJSON defines a TokenMappingProposal with a deposit used to parse token mapping proposals
+// from a JSON file.
+type TokenMappingProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Denom       string       `json:"denom" yaml:"denom"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// PauseTokenMappingProposalJSON defines a PauseTokenMappingProposal with a deposit used to parse pause token
+// mapping proposals from a JSON file.
+type PauseTokenMappingProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Denom       string       `json:"denom" yaml:"denom"`
+	IsPaused    bool         `json:"is_paused" yaml:"is_paused"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseTokenMappingProposalJSON parses json from proposal file to TokenMappingProposalJSON struct
+func ParseTokenMappingProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposal TokenMappingProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParsePauseTokenMappingProposalJSON parses json from proposal file to PauseTokenMappingProposalJSON struct
+func ParsePauseTokenMappingProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal PauseTokenMappingProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -0,0 +1,28 @@
+package erc20
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// InitGenesis initializes the params and the token mappings of the erc20 module. The erc20 contracts and the
+// locked coins are imported with the accounts by the evm and auth modules.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, tokenMapping := range data.TokenMappings {
+		k.SetTokenMapping(ctx, tokenMapping)
+	}
+
+	if moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis exports the params and the token mappings of the erc20 module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetTokenMappings(ctx))
+}
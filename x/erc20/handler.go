@@ -0,0 +1,63 @@
+package erc20
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/common/perf"
+	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// NewHandler creates an sdk.Handler for all the erc20 type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		var handlerFun func() (*sdk.Result, error)
+		var name string
+		switch msg := msg.(type) {
+		case types.MsgConvertNative:
+			name = "handleMsgConvertNative"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertNative(ctx, k, msg)
+			}
+		case types.MsgConvertERC20:
+			name = "handleMsgConvertERC20"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertERC20(ctx, k, msg)
+			}
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+
+		seq := perf.GetPerf().OnDeliverTxEnter(ctx, types.ModuleName, name)
+		defer perf.GetPerf().OnDeliverTxExit(ctx, types.ModuleName, name, seq)
+
+		return handlerFun()
+	}
+}
+
+func handleMsgConvertNative(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertNative) (*sdk.Result, error) {
+	if _, err := k.ConvertNativeToERC20(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConvertERC20(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertERC20) (*sdk.Result, error) {
+	if _, err := k.ConvertERC20ToNative(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// ConvertNativeToERC20 converts the native coin of the account into its erc20 balance
+func (k Keeper) ConvertNativeToERC20(ctx sdk.Context, account sdk.AccAddress, coin sdk.SysCoin) (types.TokenMapping, error) {
+	csdb := k.newCommitStateDB(ctx)
+	tokenMapping, err := k.convertNativeToERC20(csdb, ethcmn.BytesToAddress(account), coin)
+	if err != nil {
+		return tokenMapping, err
+	}
+	return tokenMapping, commitStateDB(csdb)
+}
+
+// ConvertERC20ToNative converts the erc20 balance of the account into its native coin
+func (k Keeper) ConvertERC20ToNative(ctx sdk.Context, account sdk.AccAddress, coin sdk.SysCoin) (types.TokenMapping, error) {
+	csdb := k.newCommitStateDB(ctx)
+	tokenMapping, err := k.convertERC20ToNative(csdb, ethcmn.BytesToAddress(account), coin)
+	if err != nil {
+		return tokenMapping, err
+	}
+	return tokenMapping, commitStateDB(csdb)
+}
+
+// convertNativeToERC20 locks the native coin of the account in the module account, and mints the same amount of
+// the erc20 contract to the account. The erc20 contract is deployed if the denom isn't mapped and the auto
+// deployment is enabled.
+func (k Keeper) convertNativeToERC20(csdb *evmtypes.CommitStateDB, account ethcmn.Address, coin sdk.SysCoin) (
+	tokenMapping types.TokenMapping, err error) {
+	err = csdb.ExecuteNative(func(ctx sdk.Context) (sdk.Events, error) {
+		var found bool
+		tokenMapping, found = k.GetTokenMapping(ctx, coin.Denom)
+		if !found && !k.GetParams(ctx).EnableAutoDeployment {
+			return nil, sdkerrors.Wrap(types.ErrAutoDeploymentDisabled, coin.Denom)
+		}
+		if tokenMapping.Paused {
+			return nil, sdkerrors.Wrap(types.ErrTokenMappingPaused, coin.Denom)
+		}
+		// the coins of a paused token or a frozen account can't escape into the erc20 balances
+		if err := k.tokenKeeper.CheckCoinsTransferable(ctx, account.Bytes(), sdk.SysCoins{coin}); err != nil {
+			return nil, err
+		}
+
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, account.Bytes(), types.ModuleName, sdk.SysCoins{coin})
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			if tokenMapping, err = k.deployERC20(ctx, csdb, coin.Denom); err != nil {
+				return nil, err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeConvertNative,
+			sdk.NewAttribute(types.AttributeKeyAccount, sdk.AccAddress(account.Bytes()).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, tokenMapping.Contract),
+		))
+		return ctx.EventManager().Events(), nil
+	}, account)
+	if err != nil {
+		return types.TokenMapping{}, err
+	}
+
+	mintERC20(csdb, tokenMapping.ContractAddress(), account, coin.Amount.BigInt())
+	return tokenMapping, nil
+}
+
+// convertERC20ToNative burns the amount of the erc20 contract from the account, and unlocks the same amount of
+// the native coin from the module account to the account
+func (k Keeper) convertERC20ToNative(csdb *evmtypes.CommitStateDB, account ethcmn.Address, coin sdk.SysCoin) (
+	tokenMapping types.TokenMapping, err error) {
+	err = csdb.ExecuteNative(func(ctx sdk.Context) (sdk.Events, error) {
+		var found bool
+		tokenMapping, found = k.GetTokenMapping(ctx, coin.Denom)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrTokenMappingNotFound, coin.Denom)
+		}
+		if tokenMapping.Paused {
+			return nil, sdkerrors.Wrap(types.ErrTokenMappingPaused, coin.Denom)
+		}
+
+		if err := burnERC20(csdb, tokenMapping.ContractAddress(), account, coin.Amount.BigInt()); err != nil {
+			return nil, err
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account.Bytes(), sdk.SysCoins{coin})
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(types.AttributeKeyAccount, sdk.AccAddress(account.Bytes()).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, tokenMapping.Contract),
+		))
+		return ctx.EventManager().Events(), nil
+	}, account)
+	if err != nil {
+		return types.TokenMapping{}, err
+	}
+	return tokenMapping, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// newCommitStateDB creates the state db of the evm to update the erc20 contracts out of the evm transactions
+func (k Keeper) newCommitStateDB(ctx sdk.Context) *evmtypes.CommitStateDB {
+	return evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx)
+}
+
+// commitStateDB writes the state db created by newCommitStateDB to the store
+func commitStateDB(csdb *evmtypes.CommitStateDB) error {
+	if err := csdb.Finalise(true); err != nil {
+		return err
+	}
+	_, err := csdb.Commit(true)
+	return err
+}
+
+// GetERC20TotalSupply returns the total supply of the erc20 contract in the store
+func (k Keeper) GetERC20TotalSupply(ctx sdk.Context, contract ethcmn.Address) *big.Int {
+	csdb := evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx)
+	return csdb.GetState(contract, types.TotalSupplyKey).Big()
+}
+
+// deployERC20 deploys the erc20 contract of the denom, and maps them
+func (k Keeper) deployERC20(ctx sdk.Context, csdb *evmtypes.CommitStateDB, denom string) (types.TokenMapping, error) {
+	contract := types.ERC20Address(denom)
+	if csdb.GetCodeSize(contract) != 0 || csdb.GetNonce(contract) != 0 {
+		return types.TokenMapping{}, sdkerrors.Wrapf(types.ErrContractAddressOccupied, "%s of %s", contract.Hex(), denom)
+	}
+
+	// the nonce of a contract starts from 1, see EIP-161
+	csdb.SetNonce(contract, 1)
+	csdb.SetCode(contract, types.ERC20Code)
+	csdb.SetState(contract, types.NameKey, types.ShortStringValue(denom))
+	csdb.SetState(contract, types.SymbolKey, types.ShortStringValue(denom))
+
+	tokenMapping := types.NewTokenMapping(denom, contract)
+	k.SetTokenMapping(ctx, tokenMapping)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeployERC20,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyContract, tokenMapping.Contract),
+	))
+	return tokenMapping, nil
+}
+
+// mintERC20 mints the amount of the erc20 contract to the account
+func mintERC20(csdb *evmtypes.CommitStateDB, contract, account ethcmn.Address, amount *big.Int) {
+	balance := csdb.GetState(contract, types.BalanceKey(account)).Big()
+	csdb.SetState(contract, types.BalanceKey(account), ethcmn.BigToHash(balance.Add(balance, amount)))
+	totalSupply := csdb.GetState(contract, types.TotalSupplyKey).Big()
+	csdb.SetState(contract, types.TotalSupplyKey, ethcmn.BigToHash(totalSupply.Add(totalSupply, amount)))
+	addTransferLog(csdb, contract, ethcmn.Address{}, account, amount)
+}
+
+// burnERC20 burns the amount of the erc20 contract from the account
+func burnERC20(csdb *evmtypes.CommitStateDB, contract, account ethcmn.Address, amount *big.Int) error {
+	balance := csdb.GetState(contract, types.BalanceKey(account)).Big()
+	if balance.Cmp(amount) < 0 {
+		return sdkerrors.Wrapf(types.ErrInsufficientERC20Balance, "%s < %s", balance, amount)
+	}
+	csdb.SetState(contract, types.BalanceKey(account), ethcmn.BigToHash(balance.Sub(balance, amount)))
+	totalSupply := csdb.GetState(contract, types.TotalSupplyKey).Big()
+	csdb.SetState(contract, types.TotalSupplyKey, ethcmn.BigToHash(totalSupply.Sub(totalSupply, amount)))
+	addTransferLog(csdb, contract, account, ethcmn.Address{}, amount)
+	return nil
+}
+
+func addTransferLog(csdb *evmtypes.CommitStateDB, contract, from, to ethcmn.Address, amount *big.Int) {
+	csdb.AddLog(&ethtypes.Log{
+		Address: contract,
+		Topics:  []ethcmn.Hash{types.TransferEventTopic, from.Hash(), to.Hash()},
+		Data:    ethcmn.BigToHash(amount).Bytes(),
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+const supplyInvariant = "supply"
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, supplyInvariant, k.SupplyInvariant())
+}
+
+// SupplyInvariant checks that the native coins locked in the module account cover the total supplies of their
+// erc20 contracts. Anyone is able to send coins to the module account, so the locked coins may be more than the
+// total supplies, and the coins of the unmapped denoms are ignored
+func (k Keeper) SupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		locked := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		k.IterateTokenMappings(ctx, func(tokenMapping types.TokenMapping) (stop bool) {
+			lockedAmount := locked.AmountOf(tokenMapping.Denom)
+			totalSupply := k.GetERC20TotalSupply(ctx, tokenMapping.ContractAddress())
+			if lockedAmount.BigInt().Cmp(totalSupply) < 0 {
+				count++
+				msg += fmt.Sprintf("\tinsufficient locked coins for denom %s: locked %s, erc20 total supply %s\n",
+					tokenMapping.Denom, lockedAmount.BigInt(), totalSupply)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, supplyInvariant,
+			fmt.Sprintf("erc20 insufficient locked coins found %d\n%s", count, msg),
+		), broken
+	}
+}
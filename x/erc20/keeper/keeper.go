@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// Keeper of the erc20 store
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramSubspace types.ParamSubspace
+	supplyKeeper  types.SupplyKeeper
+	tokenKeeper   types.TokenKeeper
+	evmKeeper     types.EvmKeeper
+	govKeeper     types.GovKeeper
+}
+
+// NewKeeper creates an erc20 keeper
+func NewKeeper(supplyKeeper types.SupplyKeeper, tokenKeeper types.TokenKeeper, evmKeeper types.EvmKeeper,
+	paramSubspace types.ParamSubspace, key sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:  supplyKeeper,
+		tokenKeeper:   tokenKeeper,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
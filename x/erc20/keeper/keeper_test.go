@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/app"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	tokentypes "github.com/okex/exchain/x/token/types"
+	"github.com/stretchr/testify/suite"
+)
+
+const erc20ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+		"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+		"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"convertNative","stateMutability":"nonpayable",
+		"inputs":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"contract","type":"address"}]},
+	{"type":"function","name":"convertERC20","stateMutability":"nonpayable",
+		"inputs":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]}
+]`
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.OKExChainApp
+	address ethcmn.Address
+	nonce   uint64
+	abi     abi.ABI
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-3", Time: time.Now().UTC()})
+	suite.address = ethcmn.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b4c1")
+	suite.nonce = 0
+
+	var err error
+	suite.abi, err = abi.JSON(strings.NewReader(erc20ABI))
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(100)), sdk.NewDecCoin("xxb", sdk.NewInt(100)))
+	acc := &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(sdk.AccAddress(suite.address.Bytes()), coins, nil, 0, 0),
+		CodeHash:    ethcrypto.Keccak256(nil),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// call calls the contract by the evm with the sender of the suite
+func (suite *KeeperTestSuite) call(to ethcmn.Address, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := suite.abi.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	st := evmtypes.StateTransition{
+		AccountNonce: suite.nonce,
+		Price:        big.NewInt(1),
+		GasLimit:     1000000,
+		Recipient:    &to,
+		Amount:       big.NewInt(0),
+		Payload:      input,
+		ChainID:      big.NewInt(1),
+		Csdb:         csdb,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.address,
+	}
+	// the bridge contract is a native contract
+	config := evmtypes.DefaultChainConfig()
+	config.NativeContractsBlock = sdk.ZeroInt()
+	_, resData, err, _, _ := st.TransitionDb(suite.ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	suite.nonce++
+	return suite.abi.Unpack(method, resData.Ret)
+}
+
+func (suite *KeeperTestSuite) erc20Balance(contract, account ethcmn.Address) *big.Int {
+	res, err := suite.call(contract, "balanceOf", account)
+	suite.Require().NoError(err)
+	return res[0].(*big.Int)
+}
+
+func (suite *KeeperTestSuite) coinBalance(account ethcmn.Address, denom string) sdk.Dec {
+	return suite.app.AccountKeeper.GetAccount(suite.ctx, account.Bytes()).GetCoins().AmountOf(denom)
+}
+
+func (suite *KeeperTestSuite) requireInvariant(broken bool) {
+	_, isBroken := suite.app.Erc20Keeper.SupplyInvariant()(suite.ctx)
+	suite.Require().Equal(broken, isBroken)
+}
+
+func (suite *KeeperTestSuite) TestConvert() {
+	k := suite.app.Erc20Keeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	// the erc20 contract is deployed by the first conversion
+	tokenMapping, err := k.ConvertNativeToERC20(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(30)))
+	suite.Require().NoError(err)
+	contract := types.ERC20Address("xxb")
+	suite.Require().Equal(contract, tokenMapping.ContractAddress())
+	denom, found := k.GetDenomByContract(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal("xxb", denom)
+
+	suite.Require().Equal(sdk.NewDec(70), suite.coinBalance(suite.address, "xxb"))
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), suite.erc20Balance(contract, suite.address))
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), k.GetERC20TotalSupply(suite.ctx, contract))
+	suite.requireInvariant(false)
+
+	_, err = k.ConvertERC20ToNative(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(80), suite.coinBalance(suite.address, "xxb"))
+	suite.Require().Equal(sdk.NewDec(20).BigInt(), suite.erc20Balance(contract, suite.address))
+	suite.requireInvariant(false)
+
+	// insufficient balances
+	_, err = k.ConvertERC20ToNative(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(21)))
+	suite.Require().Error(err)
+	_, err = k.ConvertNativeToERC20(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(81)))
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(80), suite.coinBalance(suite.address, "xxb"))
+	suite.Require().Equal(sdk.NewDec(20).BigInt(), suite.erc20Balance(contract, suite.address))
+
+	// unmapped denom
+	_, err = k.ConvertERC20ToNative(suite.ctx, sender, sdk.NewDecCoin("yyb", sdk.NewInt(1)))
+	suite.Require().Error(err)
+	suite.requireInvariant(false)
+
+	// the coins of a frozen account or a paused token can't be converted
+	token := tokentypes.Token{Symbol: "xxb", Owner: sender, Controllable: true}
+	suite.app.TokenKeeper.NewToken(suite.ctx, token)
+	suite.app.TokenKeeper.SetAddressFrozen(suite.ctx, "xxb", sender, true)
+	_, err = k.ConvertNativeToERC20(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(1)))
+	suite.Require().Error(err)
+	suite.app.TokenKeeper.SetAddressFrozen(suite.ctx, "xxb", sender, false)
+	token.Paused = true
+	suite.app.TokenKeeper.UpdateToken(suite.ctx, token)
+	_, err = k.ConvertNativeToERC20(suite.ctx, sender, sdk.NewDecCoin("xxb", sdk.NewInt(1)))
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(80), suite.coinBalance(suite.address, "xxb"))
+}
+
+func (suite *KeeperTestSuite) TestTokenMapping() {
+	k := suite.app.Erc20Keeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewDecCoin("xxb", sdk.NewInt(10))
+
+	params := k.GetParams(suite.ctx)
+	params.EnableAutoDeployment = false
+	k.SetParams(suite.ctx, params)
+	_, err := k.ConvertNativeToERC20(suite.ctx, sender, coin)
+	suite.Require().Error(err)
+
+	// the token must be issued to be mapped by governance
+	_, err = k.MapToken(suite.ctx, "xxb")
+	suite.Require().Error(err)
+	suite.app.TokenKeeper.NewToken(suite.ctx, tokentypes.Token{Symbol: "xxb", Owner: sender})
+	tokenMapping, err := k.MapToken(suite.ctx, "xxb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ERC20Address("xxb"), tokenMapping.ContractAddress())
+	_, err = k.MapToken(suite.ctx, "xxb")
+	suite.Require().Error(err)
+
+	res, err := suite.call(tokenMapping.ContractAddress(), "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal("xxb", res[0].(string))
+	res, err = suite.call(tokenMapping.ContractAddress(), "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(types.ERC20Decimals), res[0].(uint8))
+
+	_, err = k.ConvertNativeToERC20(suite.ctx, sender, coin)
+	suite.Require().NoError(err)
+
+	// the conversions are paused
+	suite.Require().NoError(k.SetTokenMappingPaused(suite.ctx, "xxb", true))
+	_, err = k.ConvertNativeToERC20(suite.ctx, sender, coin)
+	suite.Require().Error(err)
+	_, err = k.ConvertERC20ToNative(suite.ctx, sender, coin)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(k.SetTokenMappingPaused(suite.ctx, "xxb", false))
+	_, err = k.ConvertERC20ToNative(suite.ctx, sender, coin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(100), suite.coinBalance(suite.address, "xxb"))
+
+	suite.Require().Error(k.SetTokenMappingPaused(suite.ctx, "yyb", true))
+	suite.requireInvariant(false)
+}
+
+func (suite *KeeperTestSuite) TestERC20Contract() {
+	k := suite.app.Erc20Keeper
+	_, err := k.ConvertNativeToERC20(suite.ctx, suite.address.Bytes(), sdk.NewDecCoin("xxb", sdk.NewInt(30)))
+	suite.Require().NoError(err)
+	contract := types.ERC20Address("xxb")
+	recipient := ethcmn.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	_, err = suite.call(contract, "transfer", recipient, sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(20).BigInt(), suite.erc20Balance(contract, suite.address))
+	suite.Require().Equal(sdk.NewDec(10).BigInt(), suite.erc20Balance(contract, recipient))
+
+	_, err = suite.call(contract, "transfer", recipient, sdk.NewDec(21).BigInt())
+	suite.Require().Error(err)
+	_, err = suite.call(contract, "transfer", ethcmn.Address{}, sdk.NewDec(1).BigInt())
+	suite.Require().Error(err)
+
+	// the sender spends the allowance approved by itself
+	_, err = suite.call(contract, "transferFrom", suite.address, recipient, sdk.NewDec(1).BigInt())
+	suite.Require().Error(err)
+	_, err = suite.call(contract, "approve", suite.address, sdk.NewDec(5).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.call(contract, "transferFrom", suite.address, recipient, sdk.NewDec(3).BigInt())
+	suite.Require().NoError(err)
+	res, err := suite.call(contract, "allowance", suite.address, suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2).BigInt(), res[0].(*big.Int))
+	suite.Require().Equal(sdk.NewDec(13).BigInt(), suite.erc20Balance(contract, recipient))
+
+	res, err = suite.call(contract, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), res[0].(*big.Int))
+	suite.requireInvariant(false)
+}
+
+func (suite *KeeperTestSuite) TestBridgeContract() {
+	res, err := suite.call(types.BridgeContractAddress, "convertNative", "xxb", sdk.NewDec(30).BigInt())
+	suite.Require().NoError(err)
+	contract := types.ERC20Address("xxb")
+	suite.Require().Equal(contract, res[0].(ethcmn.Address))
+	suite.Require().Equal(sdk.NewDec(70), suite.coinBalance(suite.address, "xxb"))
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), suite.erc20Balance(contract, suite.address))
+	suite.requireInvariant(false)
+
+	_, err = suite.call(types.BridgeContractAddress, "convertERC20", "xxb", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(80), suite.coinBalance(suite.address, "xxb"))
+	suite.Require().Equal(sdk.NewDec(20).BigInt(), suite.erc20Balance(contract, suite.address))
+
+	_, err = suite.call(types.BridgeContractAddress, "convertERC20", "xxb", sdk.NewDec(21).BigInt())
+	suite.Require().Error(err)
+	_, err = suite.call(types.BridgeContractAddress, "convertNative", sdk.DefaultBondDenom, sdk.NewDec(1).BigInt())
+	suite.Require().Error(err)
+	suite.requireInvariant(false)
+}
+
+func (suite *KeeperTestSuite) TestSupplyInvariant() {
+	k := suite.app.Erc20Keeper
+	_, err := k.ConvertNativeToERC20(suite.ctx, suite.address.Bytes(), sdk.NewDecCoin("xxb", sdk.NewInt(30)))
+	suite.Require().NoError(err)
+	suite.requireInvariant(false)
+
+	// anyone is able to send coins to the module account, of the mapped denoms or not
+	err = suite.app.SupplyKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.address.Bytes(), types.ModuleName,
+		sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(1)), sdk.NewDecCoin("xxb", sdk.NewInt(1))))
+	suite.Require().NoError(err)
+	suite.requireInvariant(false)
+
+	// the locked coins don't cover the erc20 total supply
+	err = suite.app.SupplyKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(),
+		sdk.NewCoins(sdk.NewDecCoin("xxb", sdk.NewInt(2))))
+	suite.Require().NoError(err)
+	suite.requireInvariant(true)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+const bridgeABI = `[
+	{"type":"function","name":"convertNative","stateMutability":"nonpayable",
+		"inputs":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"contract","type":"address"}]},
+	{"type":"function","name":"convertERC20","stateMutability":"nonpayable",
+		"inputs":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var bridge = evmtypes.MustNativeABI(bridgeABI, map[string]uint64{
+	"convertNative": 60000,
+	"convertERC20":  60000,
+})
+
+// BridgeContract is the native contract converting the native coins of the caller into the balances of their
+// erc20 contracts and vice versa
+type BridgeContract struct {
+	keeper Keeper
+}
+
+var _ evmtypes.NativeContract = BridgeContract{}
+
+// NewBridgeContract creates the bridge contract with the keeper of the erc20 module
+func NewBridgeContract(keeper Keeper) BridgeContract {
+	return BridgeContract{keeper: keeper}
+}
+
+func (c BridgeContract) RequiredGas(input []byte) uint64 {
+	return bridge.RequiredGas(input)
+}
+
+func (c BridgeContract) Run(call *evmtypes.NativeCall, input []byte) ([]byte, error) {
+	method, args, err := bridge.Unpack(call, input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := args[0].(string)
+	if err := types.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+	coin, err := evmtypes.NativeCoin(denom, args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "convertNative":
+		tokenMapping, err := c.keeper.convertNativeToERC20(call.Csdb, call.Caller, coin)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(tokenMapping.ContractAddress())
+	case "convertERC20":
+		if _, err := c.keeper.convertERC20ToNative(call.Csdb, call.Caller, coin); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
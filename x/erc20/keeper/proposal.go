@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/erc20/types"
+	sdkGov "github.com/okex/exchain/x/gov"
+	govKeeper "github.com/okex/exchain/x/gov/keeper"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	switch content.(type) {
+	case types.TokenMappingProposal, types.PauseTokenMappingProposal:
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	switch content.(type) {
+	case types.TokenMappingProposal, types.PauseTokenMappingProposal:
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	switch content.(type) {
+	case types.TokenMappingProposal, types.PauseTokenMappingProposal:
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.TokenMappingProposal:
+		if !k.tokenKeeper.TokenExist(ctx, content.Denom) {
+			return sdkerrors.Wrap(types.ErrTokenNotExist, content.Denom)
+		}
+		if _, found := k.GetTokenMapping(ctx, content.Denom); found {
+			return sdkerrors.Wrap(types.ErrTokenMappingExists, content.Denom)
+		}
+		return nil
+	case types.PauseTokenMappingProposal:
+		if _, found := k.GetTokenMapping(ctx, content.Denom); !found {
+			return sdkerrors.Wrap(types.ErrTokenMappingNotFound, content.Denom)
+		}
+		return nil
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized %s proposal content type: %T", types.DefaultCodespace, content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// MapToken deploys the erc20 contract of the issued token and maps them
+func (k Keeper) MapToken(ctx sdk.Context, denom string) (types.TokenMapping, error) {
+	if !k.tokenKeeper.TokenExist(ctx, denom) {
+		return types.TokenMapping{}, sdkerrors.Wrap(types.ErrTokenNotExist, denom)
+	}
+	if _, found := k.GetTokenMapping(ctx, denom); found {
+		return types.TokenMapping{}, sdkerrors.Wrap(types.ErrTokenMappingExists, denom)
+	}
+
+	csdb := k.newCommitStateDB(ctx)
+	tokenMapping, err := k.deployERC20(ctx, csdb, denom)
+	if err != nil {
+		return tokenMapping, err
+	}
+	return tokenMapping, commitStateDB(csdb)
+}
+
+// SetTokenMappingPaused pauses or resumes the conversions of the token mapping of the denom
+func (k Keeper) SetTokenMappingPaused(ctx sdk.Context, denom string, paused bool) error {
+	tokenMapping, found := k.GetTokenMapping(ctx, denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrTokenMappingNotFound, denom)
+	}
+	tokenMapping.Paused = paused
+	k.SetTokenMapping(ctx, tokenMapping)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenMapping,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyContract, tokenMapping.Contract),
+		sdk.NewAttribute(types.AttributeKeyPaused, fmt.Sprintf("%t", paused)),
+	))
+	return nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"Insufficient parameters, at least 1 parameter is required")
+		}
+
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, keeper)
+		case types.QueryTokenMappings:
+			return queryTokenMappings(ctx, keeper)
+		case types.QueryTokenMapping:
+			return queryTokenMapping(ctx, req, keeper)
+		case types.QueryContract:
+			return queryContract(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query endpoint: %s", path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	return marshalJSON(keeper.GetParams(ctx))
+}
+
+func queryTokenMappings(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	return marshalJSON(keeper.GetTokenMappings(ctx))
+}
+
+func queryTokenMapping(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTokenMappingParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	tokenMapping, found := keeper.GetTokenMapping(ctx, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrTokenMappingNotFound, params.Denom)
+	}
+	return marshalJSON(tokenMapping)
+}
+
+func queryContract(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryContractParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if !ethcmn.IsHexAddress(params.Contract) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address %s", params.Contract)
+	}
+
+	denom, found := keeper.GetDenomByContract(ctx, ethcmn.HexToAddress(params.Contract))
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrTokenMappingNotFound, params.Contract)
+	}
+	tokenMapping, _ := keeper.GetTokenMapping(ctx, denom)
+	return marshalJSON(tokenMapping)
+}
+
+func marshalJSON(o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
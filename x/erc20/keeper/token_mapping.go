@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// GetTokenMapping gets the token mapping of the denom
+func (k Keeper) GetTokenMapping(ctx sdk.Context, denom string) (tokenMapping types.TokenMapping, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenMappingKey(denom))
+	if bz == nil {
+		return tokenMapping, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tokenMapping)
+	return tokenMapping, true
+}
+
+// GetDenomByContract gets the denom mapped to the erc20 contract
+func (k Keeper) GetDenomByContract(ctx sdk.Context, contract ethcmn.Address) (denom string, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractDenomKey(contract))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetTokenMapping sets the token mapping to the store
+func (k Keeper) SetTokenMapping(ctx sdk.Context, tokenMapping types.TokenMapping) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenMappingKey(tokenMapping.Denom), k.cdc.MustMarshalBinaryLengthPrefixed(tokenMapping))
+	store.Set(types.GetContractDenomKey(tokenMapping.ContractAddress()), []byte(tokenMapping.Denom))
+}
+
+// IterateTokenMappings iterates over all the token mappings
+func (k Keeper) IterateTokenMappings(ctx sdk.Context, handler func(tokenMapping types.TokenMapping) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TokenMappingPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenMapping types.TokenMapping
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tokenMapping)
+		if handler(tokenMapping) {
+			break
+		}
+	}
+}
+
+// GetTokenMappings gets all the token mappings
+func (k Keeper) GetTokenMappings(ctx sdk.Context) []types.TokenMapping {
+	tokenMappings := make([]types.TokenMapping, 0)
+	k.IterateTokenMappings(ctx, func(tokenMapping types.TokenMapping) (stop bool) {
+		tokenMappings = append(tokenMappings, tokenMapping)
+		return false
+	})
+	return tokenMappings
+}
@@ -0,0 +1,23 @@
+package erc20
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/erc20/types"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewProposalHandler handles "gov" type message in "erc20"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.TokenMappingProposal:
+			_, err := k.MapToken(ctx, content.Denom)
+			return err
+		case types.PauseTokenMappingProposal:
+			return k.SetTokenMappingPaused(ctx, content.Denom, content.IsPaused)
+		default:
+			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgConvertNative{}, "okexchain/erc20/MsgConvertNative", nil)
+	cdc.RegisterConcrete(MsgConvertERC20{}, "okexchain/erc20/MsgConvertERC20", nil)
+	cdc.RegisterConcrete(TokenMappingProposal{}, "okexchain/erc20/TokenMappingProposal", nil)
+	cdc.RegisterConcrete(PauseTokenMappingProposal{}, "okexchain/erc20/PauseTokenMappingProposal", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,276 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// The erc20 contract of a native denom is a standard erc20 token with 18 decimals, the same as the precision of
+// the native coins. Its source is contracts/NativeERC20.sol, and its runtime code is assembled by buildERC20Code
+// instead of compiled by solc, so the two must be changed together. The storage layout is the one of solidity
+// for the following state variables, so the balances are minted and burned by the module through the storage of
+// the contract directly:
+//
+//	mapping(address => uint256) balances;                     // slot 0
+//	mapping(address => mapping(address => uint256)) allowances; // slot 1
+//	uint256 totalSupply;                                      // slot 2
+//	string name;                                              // slot 3, the denom
+//	string symbol;                                            // slot 4, the denom
+const (
+	ERC20Decimals = 18
+
+	// MaxERC20DenomLength is the max length of the denom mapped to an erc20 contract, which is stored as a
+	// short string of solidity
+	MaxERC20DenomLength = 31
+)
+
+const (
+	balancesSlot = iota
+	allowancesSlot
+	totalSupplySlot
+	nameSlot
+	symbolSlot
+)
+
+var (
+	// BridgeContractAddress is the address of the native contract converting between the native coins and
+	// their erc20 balances
+	BridgeContractAddress = ethcmn.HexToAddress("0x0000000000000000000000000000000000001003")
+
+	// ERC20Code is the runtime code of the erc20 contracts of the native denoms
+	ERC20Code = buildERC20Code()
+
+	// TransferEventTopic is the topic of the event Transfer(address indexed from, address indexed to, uint256 value)
+	TransferEventTopic = ethcrypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// ApprovalEventTopic is the topic of the event Approval(address indexed owner, address indexed spender, uint256 value)
+	ApprovalEventTopic = ethcrypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+	// TotalSupplyKey is the storage key of the total supply of an erc20 contract
+	TotalSupplyKey = ethcmn.BigToHash(big.NewInt(totalSupplySlot))
+	// NameKey is the storage key of the name of an erc20 contract
+	NameKey = ethcmn.BigToHash(big.NewInt(nameSlot))
+	// SymbolKey is the storage key of the symbol of an erc20 contract
+	SymbolKey = ethcmn.BigToHash(big.NewInt(symbolSlot))
+
+	addressMask = bytes.Repeat([]byte{0xff}, ethcmn.AddressLength)
+)
+
+// ERC20Address returns the address of the erc20 contract of the denom
+func ERC20Address(denom string) ethcmn.Address {
+	return ethcmn.BytesToAddress(ethcrypto.Keccak256([]byte(ModuleName), []byte(denom)))
+}
+
+// BalanceKey returns the storage key of the erc20 balance of the account
+func BalanceKey(account ethcmn.Address) ethcmn.Hash {
+	return ethcrypto.Keccak256Hash(account.Hash().Bytes(), ethcmn.BigToHash(big.NewInt(balancesSlot)).Bytes())
+}
+
+// AllowanceKey returns the storage key of the erc20 allowance of the spender approved by the owner
+func AllowanceKey(owner, spender ethcmn.Address) ethcmn.Hash {
+	inner := ethcrypto.Keccak256Hash(owner.Hash().Bytes(), ethcmn.BigToHash(big.NewInt(allowancesSlot)).Bytes())
+	return ethcrypto.Keccak256Hash(spender.Hash().Bytes(), inner.Bytes())
+}
+
+// ShortStringValue returns the storage value of the short string of solidity, which holds the string in the
+// higher-order bytes and twice the length in the lowest-order byte
+func ShortStringValue(s string) ethcmn.Hash {
+	if len(s) > MaxERC20DenomLength {
+		panic(fmt.Sprintf("string %s is longer than %d bytes", s, MaxERC20DenomLength))
+	}
+	var value ethcmn.Hash
+	copy(value[:], s)
+	value[ethcmn.HashLength-1] = byte(len(s) * 2)
+	return value
+}
+
+// assembler builds evm code with the jump destinations referred by labels
+type assembler struct {
+	code   []byte
+	labels map[string]int
+	refs   map[int]string
+}
+
+func (a *assembler) op(ops ...vm.OpCode) *assembler {
+	for _, op := range ops {
+		a.code = append(a.code, byte(op))
+	}
+	return a
+}
+
+// push pushes the value with the smallest PUSH
+func (a *assembler) push(value []byte) *assembler {
+	for len(value) > 1 && value[0] == 0 {
+		value = value[1:]
+	}
+	if len(value) == 0 {
+		value = []byte{0}
+	}
+	a.op(vm.PUSH1 + vm.OpCode(len(value)-1))
+	a.code = append(a.code, value...)
+	return a
+}
+
+func (a *assembler) pushUint(value uint64) *assembler {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], value)
+	return a.push(b[:])
+}
+
+// pushLabel pushes the position of the label, which is filled in by build
+func (a *assembler) pushLabel(label string) *assembler {
+	a.op(vm.PUSH2)
+	a.refs[len(a.code)] = label
+	a.code = append(a.code, 0, 0)
+	return a
+}
+
+// label marks the jump destination of the label
+func (a *assembler) label(label string) *assembler {
+	a.labels[label] = len(a.code)
+	return a.op(vm.JUMPDEST)
+}
+
+func (a *assembler) jump(label string) *assembler {
+	return a.pushLabel(label).op(vm.JUMP)
+}
+
+func (a *assembler) jumpi(label string) *assembler {
+	return a.pushLabel(label).op(vm.JUMPI)
+}
+
+func (a *assembler) build() []byte {
+	for pos, label := range a.refs {
+		dest, ok := a.labels[label]
+		if !ok {
+			panic(fmt.Sprintf("undefined label %s", label))
+		}
+		binary.BigEndian.PutUint16(a.code[pos:], uint16(dest))
+	}
+	return a.code
+}
+
+// requireArgs reverts if the call data is shorter than the selector and n arguments
+func (a *assembler) requireArgs(n uint64) *assembler {
+	return a.pushUint(4+32*n).op(vm.CALLDATASIZE, vm.LT).jumpi("revert")
+}
+
+// uintArg pushes the i-th argument
+func (a *assembler) uintArg(i uint64) *assembler {
+	return a.pushUint(4 + 32*i).op(vm.CALLDATALOAD)
+}
+
+// addressArg pushes the i-th argument, and reverts if it isn't an address
+func (a *assembler) addressArg(i uint64) *assembler {
+	return a.uintArg(i).op(vm.DUP1).push(addressMask).op(vm.AND, vm.DUP2, vm.EQ, vm.ISZERO).jumpi("revert")
+}
+
+// balanceKey replaces the account on the top of the stack with the storage key of its balance
+func (a *assembler) balanceKey() *assembler {
+	return a.pushUint(0).op(vm.MSTORE).
+		pushUint(balancesSlot).pushUint(0x20).op(vm.MSTORE).
+		pushUint(0x40).pushUint(0).op(vm.SHA3)
+}
+
+// allowanceKey replaces the owner on the top of the stack and the spender under it with the storage key of
+// the allowance
+func (a *assembler) allowanceKey() *assembler {
+	return a.pushUint(0).op(vm.MSTORE).
+		pushUint(allowancesSlot).pushUint(0x20).op(vm.MSTORE).
+		pushUint(0x40).pushUint(0).op(vm.SHA3).
+		pushUint(0x20).op(vm.MSTORE).
+		pushUint(0).op(vm.MSTORE).
+		pushUint(0x40).pushUint(0).op(vm.SHA3)
+}
+
+// returnWord returns the word on the top of the stack
+func (a *assembler) returnWord() *assembler {
+	return a.pushUint(0).op(vm.MSTORE).pushUint(0x20).pushUint(0).op(vm.RETURN)
+}
+
+func selector(signature string) []byte {
+	return ethcrypto.Keccak256([]byte(signature))[:4]
+}
+
+func buildERC20Code() []byte {
+	a := &assembler{labels: make(map[string]int), refs: make(map[int]string)}
+
+	// the methods aren't payable
+	a.op(vm.CALLVALUE).jumpi("revert")
+	a.requireArgs(0)
+	a.pushUint(0).op(vm.CALLDATALOAD).pushUint(0xe0).op(vm.SHR)
+	methods := []struct{ signature, label string }{
+		{"name()", "name"},
+		{"symbol()", "symbol"},
+		{"decimals()", "decimals"},
+		{"totalSupply()", "totalSupply"},
+		{"balanceOf(address)", "balanceOf"},
+		{"allowance(address,address)", "allowance"},
+		{"transfer(address,uint256)", "transfer"},
+		{"approve(address,uint256)", "approve"},
+		{"transferFrom(address,address,uint256)", "transferFrom"},
+	}
+	for _, method := range methods {
+		a.op(vm.DUP1).push(selector(method.signature)).op(vm.EQ).jumpi(method.label)
+	}
+	a.label("revert").pushUint(0).op(vm.DUP1, vm.REVERT)
+
+	a.label("name").pushUint(nameSlot).jump("returnString")
+	a.label("symbol").pushUint(symbolSlot).jump("returnString")
+	// returns the short string in the slot on the top of the stack
+	a.label("returnString").op(vm.SLOAD).
+		pushUint(0x20).pushUint(0).op(vm.MSTORE).
+		op(vm.DUP1).pushUint(0xff).op(vm.AND).pushUint(1).op(vm.SHR).pushUint(0x20).op(vm.MSTORE).
+		pushUint(0xff).op(vm.NOT, vm.AND).pushUint(0x40).op(vm.MSTORE).
+		pushUint(0x60).pushUint(0).op(vm.RETURN)
+
+	a.label("decimals").pushUint(ERC20Decimals).returnWord()
+	a.label("totalSupply").pushUint(totalSupplySlot).op(vm.SLOAD).returnWord()
+	a.label("balanceOf").requireArgs(1).addressArg(0).balanceKey().op(vm.SLOAD).returnWord()
+	a.label("allowance").requireArgs(2).addressArg(1).addressArg(0).allowanceKey().op(vm.SLOAD).returnWord()
+
+	a.label("transfer").requireArgs(2).
+		pushLabel("returnTrue").op(vm.CALLER).addressArg(0).uintArg(1).jump("transferTokens")
+
+	a.label("approve").requireArgs(2).
+		// [amount, spender, owner]
+		uintArg(1).addressArg(0).op(vm.CALLER).
+		op(vm.DUP2, vm.DUP2).allowanceKey().op(vm.DUP4, vm.SWAP1, vm.SSTORE).
+		op(vm.DUP3).pushUint(0).op(vm.MSTORE).
+		push(ApprovalEventTopic.Bytes()).pushUint(0x20).pushUint(0).op(vm.LOG3).
+		jump("returnTrue")
+
+	a.label("transferFrom").requireArgs(3).
+		// [key, allowance]
+		op(vm.CALLER).addressArg(0).allowanceKey().op(vm.DUP1, vm.SLOAD).
+		// the max allowance is never spent
+		op(vm.DUP1, vm.NOT, vm.ISZERO).jumpi("allowanceSpent").
+		uintArg(2).op(vm.DUP2, vm.LT).jumpi("revert").
+		uintArg(2).op(vm.SWAP1, vm.SUB, vm.DUP2, vm.SSTORE).pushUint(0).
+		label("allowanceSpent").op(vm.POP, vm.POP).
+		pushLabel("returnTrue").addressArg(0).addressArg(1).uintArg(2).jump("transferTokens")
+
+	a.label("returnTrue").pushUint(1).returnWord()
+
+	// transfers the amount from the account to the other one, and jumps back to the return label:
+	// [return, from, to, amount]
+	a.label("transferTokens").
+		op(vm.DUP2, vm.ISZERO).jumpi("revert").
+		// [return, from, to, amount, fromKey, fromBalance]
+		op(vm.DUP3).balanceKey().op(vm.DUP1, vm.SLOAD).
+		op(vm.DUP3, vm.DUP2, vm.LT).jumpi("revert").
+		op(vm.DUP3, vm.SWAP1, vm.SUB, vm.SWAP1, vm.SSTORE).
+		// [return, from, to, amount, toKey, toBalance]
+		op(vm.DUP2).balanceKey().op(vm.DUP1, vm.SLOAD).
+		op(vm.DUP3, vm.ADD, vm.SWAP1, vm.SSTORE).
+		pushUint(0).op(vm.MSTORE, vm.SWAP1).
+		push(TransferEventTopic.Bytes()).pushUint(0x20).pushUint(0).op(vm.LOG3).
+		op(vm.JUMP)
+
+	return a.build()
+}
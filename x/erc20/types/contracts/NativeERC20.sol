@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity ^0.8.0;
+
+// NativeERC20 is the source of the erc20 contracts of the native denoms. The runtime code deployed by the
+// erc20 module isn't compiled from it by solc, it's assembled by buildERC20Code in x/erc20/types/contract.go,
+// which must be kept in line with this contract:
+//   - the storage layout is the one below, since the module mints and burns the balances through the storage
+//   - the name and the symbol are the denom, which is a short string of at most 31 bytes
+//   - the reverts carry no data
+//   - the max allowance is never spent
+//
+// The balances and the total supply are only changed by the module, so the balance of the recipient can't
+// overflow as long as the total supply doesn't.
+contract NativeERC20 {
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    mapping(address => uint256) private balances;
+    mapping(address => mapping(address => uint256)) private allowances;
+    uint256 private _totalSupply;
+    string private _name;
+    string private _symbol;
+
+    function name() external view returns (string memory) {
+        return _name;
+    }
+
+    function symbol() external view returns (string memory) {
+        return _symbol;
+    }
+
+    function decimals() external pure returns (uint8) {
+        return 18;
+    }
+
+    function totalSupply() external view returns (uint256) {
+        return _totalSupply;
+    }
+
+    function balanceOf(address account) external view returns (uint256) {
+        return balances[account];
+    }
+
+    function allowance(address owner, address spender) external view returns (uint256) {
+        return allowances[owner][spender];
+    }
+
+    function transfer(address to, uint256 amount) external returns (bool) {
+        _transfer(msg.sender, to, amount);
+        return true;
+    }
+
+    function approve(address spender, uint256 amount) external returns (bool) {
+        allowances[msg.sender][spender] = amount;
+        emit Approval(msg.sender, spender, amount);
+        return true;
+    }
+
+    function transferFrom(address from, address to, uint256 amount) external returns (bool) {
+        uint256 allowed = allowances[from][msg.sender];
+        if (allowed != type(uint256).max) {
+            require(allowed >= amount);
+            allowances[from][msg.sender] = allowed - amount;
+        }
+        _transfer(from, to, amount);
+        return true;
+    }
+
+    function _transfer(address from, address to, uint256 amount) private {
+        require(to != address(0));
+        uint256 balance = balances[from];
+        require(balance >= amount);
+        unchecked {
+            balances[from] = balance - amount;
+            balances[to] += amount;
+        }
+        emit Transfer(from, to, amount);
+    }
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+)
+
+var (
+	// ErrInvalidDenom returns an error if the denom can't be mapped to an erc20 contract
+	ErrInvalidDenom = sdkerrors.Register(ModuleName, 2, "invalid denom")
+
+	// ErrTokenMappingNotFound returns an error if the denom or the contract isn't mapped
+	ErrTokenMappingNotFound = sdkerrors.Register(ModuleName, 3, "token mapping not found")
+
+	// ErrTokenMappingExists returns an error if the denom is mapped already
+	ErrTokenMappingExists = sdkerrors.Register(ModuleName, 4, "token mapping exists")
+
+	// ErrTokenMappingPaused returns an error if the conversions of the token mapping are paused
+	ErrTokenMappingPaused = sdkerrors.Register(ModuleName, 5, "token mapping is paused")
+
+	// ErrAutoDeploymentDisabled returns an error if the denom isn't mapped and the auto deployment is disabled
+	ErrAutoDeploymentDisabled = sdkerrors.Register(ModuleName, 6, "auto deployment of erc20 contract is disabled")
+
+	// ErrContractAddressOccupied returns an error if the address of the erc20 contract is used by another account
+	ErrContractAddressOccupied = sdkerrors.Register(ModuleName, 7, "address of erc20 contract is occupied")
+
+	// ErrInsufficientERC20Balance returns an error if the erc20 balance is less than the amount to convert
+	ErrInsufficientERC20Balance = sdkerrors.Register(ModuleName, 8, "insufficient erc20 balance")
+
+	// ErrTokenNotExist returns an error if the token of the denom isn't issued
+	ErrTokenNotExist = sdkerrors.Register(ModuleName, 9, "token does not exist")
+
+	// ErrUnexpectedProposalType returns an error when the proposal type is not supported in erc20 module
+	ErrUnexpectedProposalType = sdkerrors.Register(ModuleName, 10, "unsupported proposal type of erc20 module")
+)
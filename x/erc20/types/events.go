@@ -0,0 +1,16 @@
+package types
+
+// erc20 module event types
+const (
+	EventTypeConvertNative = "convert_native"
+	EventTypeConvertERC20  = "convert_erc20"
+	EventTypeDeployERC20   = "deploy_erc20"
+	EventTypeTokenMapping  = "token_mapping"
+
+	AttributeKeyAccount  = "account"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyContract = "contract"
+	AttributeKeyPaused   = "paused"
+
+	AttributeValueCategory = ModuleName
+)
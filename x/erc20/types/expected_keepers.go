@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// ParamSubspace defines the expected Subspace interface
+type ParamSubspace interface {
+	WithKeyTable(table params.KeyTable) params.Subspace
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetParamSet(ctx sdk.Context, ps params.ParamSet)
+	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+}
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+	CheckCoinsTransferable(ctx sdk.Context, from sdk.AccAddress, coins sdk.SysCoins) error
+}
+
+// EvmKeeper defines the expected evm keeper
+type EvmKeeper interface {
+	GenerateCSDBParams() evmtypes.CommitStateDBParams
+}
+
+// GovKeeper defines the expected gov keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
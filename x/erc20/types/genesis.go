@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+)
+
+// GenesisState - all erc20 state that must be provided at genesis
+type GenesisState struct {
+	Params        Params         `json:"params" yaml:"params"`
+	TokenMappings []TokenMapping `json:"token_mappings" yaml:"token_mappings"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, tokenMappings []TokenMapping) GenesisState {
+	return GenesisState{
+		Params:        params,
+		TokenMappings: tokenMappings,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:        DefaultParams(),
+		TokenMappings: []TokenMapping{},
+	}
+}
+
+// ValidateGenesis validates the erc20 genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	denoms := make(map[string]struct{}, len(data.TokenMappings))
+	contracts := make(map[ethcmn.Address]struct{}, len(data.TokenMappings))
+	for _, tm := range data.TokenMappings {
+		if err := ValidateDenom(tm.Denom); err != nil {
+			return err
+		}
+		if !ethcmn.IsHexAddress(tm.Contract) {
+			return fmt.Errorf("invalid contract address %s of denom %s", tm.Contract, tm.Denom)
+		}
+		if _, ok := denoms[tm.Denom]; ok {
+			return fmt.Errorf("duplicated token mapping of denom %s", tm.Denom)
+		}
+		if _, ok := contracts[tm.ContractAddress()]; ok {
+			return fmt.Errorf("duplicated token mapping of contract %s", tm.Contract)
+		}
+		denoms[tm.Denom] = struct{}{}
+		contracts[tm.ContractAddress()] = struct{}{}
+	}
+	return nil
+}
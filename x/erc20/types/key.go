@@ -0,0 +1,34 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "erc20"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	TokenMappingPrefix  = []byte{0x01}
+	ContractDenomPrefix = []byte{0x02}
+)
+
+// GetTokenMappingKey builds the key for the token mapping of the denom
+func GetTokenMappingKey(denom string) []byte {
+	return append(TokenMappingPrefix, []byte(denom)...)
+}
+
+// GetContractDenomKey builds the key for the denom mapped to the contract
+func GetContractDenomKey(contract ethcmn.Address) []byte {
+	return append(ContractDenomPrefix, contract.Bytes()...)
+}
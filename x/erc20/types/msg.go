@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	convertNativeMsgType = "convert_native"
+	convertERC20MsgType  = "convert_erc20"
+)
+
+// MsgConvertNative converts the native coin of the sender into the erc20 balance of the sender
+type MsgConvertNative struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertNative{}
+
+// NewMsgConvertNative creates a new instance of MsgConvertNative
+func NewMsgConvertNative(sender sdk.AccAddress, amount sdk.SysCoin) MsgConvertNative {
+	return MsgConvertNative{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvertNative) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertNative) Type() string {
+	return convertNativeMsgType
+}
+
+func (m MsgConvertNative) ValidateBasic() sdk.Error {
+	return validateConversion(m.Sender, m.Amount)
+}
+
+func (m MsgConvertNative) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgConvertNative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// MsgConvertERC20 converts the erc20 balance of the sender into the native coin of the sender
+type MsgConvertERC20 struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertERC20{}
+
+// NewMsgConvertERC20 creates a new instance of MsgConvertERC20
+func NewMsgConvertERC20(sender sdk.AccAddress, amount sdk.SysCoin) MsgConvertERC20 {
+	return MsgConvertERC20{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvertERC20) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertERC20) Type() string {
+	return convertERC20MsgType
+}
+
+func (m MsgConvertERC20) ValidateBasic() sdk.Error {
+	return validateConversion(m.Sender, m.Amount)
+}
+
+func (m MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+func validateConversion(sender sdk.AccAddress, amount sdk.SysCoin) error {
+	if sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender is required")
+	}
+	if err := ValidateDenom(amount.Denom); err != nil {
+		return err
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", amount)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyEnableAutoDeployment = []byte("EnableAutoDeployment")
+)
+
+// ParamKeyTable for erc20 module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params - used for initializing default parameter for erc20 at genesis
+type Params struct {
+	// EnableAutoDeployment is set if the erc20 contract of a denom is deployed by its first conversion, otherwise
+	// the denom is mapped by the proposal
+	EnableAutoDeployment bool `json:"enable_auto_deployment" yaml:"enable_auto_deployment"`
+}
+
+// String implements the stringer interface for Params
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Enable Auto Deployment: %t`,
+		p.EnableAutoDeployment)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyEnableAutoDeployment, Value: &p.EnableAutoDeployment, ValidatorFn: common.ValidateBool("enable auto deployment")},
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return Params{
+		EnableAutoDeployment: true,
+	}
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeTokenMapping defines the type for a TokenMappingProposal
+	proposalTypeTokenMapping = "TokenMapping"
+	// proposalTypePauseTokenMapping defines the type for a PauseTokenMappingProposal
+	proposalTypePauseTokenMapping = "PauseTokenMapping"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeTokenMapping)
+	govtypes.RegisterProposalType(proposalTypePauseTokenMapping)
+	govtypes.RegisterProposalTypeCodec(TokenMappingProposal{}, "okexchain/erc20/TokenMappingProposal")
+	govtypes.RegisterProposalTypeCodec(PauseTokenMappingProposal{}, "okexchain/erc20/PauseTokenMappingProposal")
+}
+
+var (
+	_ govtypes.Content = (*TokenMappingProposal)(nil)
+	_ govtypes.Content = (*PauseTokenMappingProposal)(nil)
+)
+
+// TokenMappingProposal - structure for the proposal to deploy the erc20 contract of a denom and map them
+type TokenMappingProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+}
+
+// NewTokenMappingProposal creates a new instance of TokenMappingProposal
+func NewTokenMappingProposal(title, description, denom string) TokenMappingProposal {
+	return TokenMappingProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// GetTitle returns title of a token mapping proposal object
+func (tp TokenMappingProposal) GetTitle() string {
+	return tp.Title
+}
+
+// GetDescription returns description of a token mapping proposal object
+func (tp TokenMappingProposal) GetDescription() string {
+	return tp.Description
+}
+
+// ProposalRoute returns route key of a token mapping proposal object
+func (tp TokenMappingProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a token mapping proposal object
+func (tp TokenMappingProposal) ProposalType() string {
+	return proposalTypeTokenMapping
+}
+
+// ValidateBasic validates a token mapping proposal
+func (tp TokenMappingProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(tp.Title, tp.Description); err != nil {
+		return err
+	}
+
+	if tp.ProposalType() != proposalTypeTokenMapping {
+		return govtypes.ErrInvalidProposalType(tp.ProposalType())
+	}
+
+	return ValidateDenom(tp.Denom)
+}
+
+// String returns a human readable string representation of a TokenMappingProposal
+func (tp TokenMappingProposal) String() string {
+	return fmt.Sprintf(`TokenMappingProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Denom:					%s`,
+		tp.Title, tp.Description, tp.ProposalType(), tp.Denom)
+}
+
+// PauseTokenMappingProposal - structure for the proposal to pause or resume the conversions of a token mapping
+type PauseTokenMappingProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+	IsPaused    bool   `json:"is_paused" yaml:"is_paused"`
+}
+
+// NewPauseTokenMappingProposal creates a new instance of PauseTokenMappingProposal
+func NewPauseTokenMappingProposal(title, description, denom string, isPaused bool) PauseTokenMappingProposal {
+	return PauseTokenMappingProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		IsPaused:    isPaused,
+	}
+}
+
+// GetTitle returns title of a pause token mapping proposal object
+func (pp PauseTokenMappingProposal) GetTitle() string {
+	return pp.Title
+}
+
+// GetDescription returns description of a pause token mapping proposal object
+func (pp PauseTokenMappingProposal) GetDescription() string {
+	return pp.Description
+}
+
+// ProposalRoute returns route key of a pause token mapping proposal object
+func (pp PauseTokenMappingProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a pause token mapping proposal object
+func (pp PauseTokenMappingProposal) ProposalType() string {
+	return proposalTypePauseTokenMapping
+}
+
+// ValidateBasic validates a pause token mapping proposal
+func (pp PauseTokenMappingProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(pp.Title, pp.Description); err != nil {
+		return err
+	}
+
+	if pp.ProposalType() != proposalTypePauseTokenMapping {
+		return govtypes.ErrInvalidProposalType(pp.ProposalType())
+	}
+
+	return ValidateDenom(pp.Denom)
+}
+
+// String returns a human readable string representation of a PauseTokenMappingProposal
+func (pp PauseTokenMappingProposal) String() string {
+	return fmt.Sprintf(`PauseTokenMappingProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Denom:					%s
+ IsPaused:				%t`,
+		pp.Title, pp.Description, pp.ProposalType(), pp.Denom, pp.IsPaused)
+}
+
+func validateProposalContent(title, description string) sdk.Error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	return nil
+}
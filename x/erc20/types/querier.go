@@ -0,0 +1,34 @@
+package types
+
+const (
+	QueryParameters    = "parameters"
+	QueryTokenMappings = "token-mappings"
+	QueryTokenMapping  = "token-mapping"
+	QueryContract      = "contract"
+)
+
+// QueryTokenMappingParams defines the params for the following queries:
+// - 'custom/erc20/token-mapping'
+type QueryTokenMappingParams struct {
+	Denom string
+}
+
+// NewQueryTokenMappingParams creates a new instance of QueryTokenMappingParams
+func NewQueryTokenMappingParams(denom string) QueryTokenMappingParams {
+	return QueryTokenMappingParams{
+		Denom: denom,
+	}
+}
+
+// QueryContractParams defines the params for the following queries:
+// - 'custom/erc20/contract'
+type QueryContractParams struct {
+	Contract string
+}
+
+// NewQueryContractParams creates a new instance of QueryContractParams
+func NewQueryContractParams(contract string) QueryContractParams {
+	return QueryContractParams{
+		Contract: contract,
+	}
+}
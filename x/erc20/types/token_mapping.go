@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+// TokenMapping is the mapping between a native denom and its erc20 contract
+type TokenMapping struct {
+	Denom    string `json:"denom" yaml:"denom"`
+	Contract string `json:"contract" yaml:"contract"`
+	// Paused is set if the conversions between the native coins and the erc20 balances are paused
+	Paused bool `json:"paused" yaml:"paused"`
+}
+
+// NewTokenMapping creates a new instance of TokenMapping
+func NewTokenMapping(denom string, contract ethcmn.Address) TokenMapping {
+	return TokenMapping{
+		Denom:    denom,
+		Contract: contract.Hex(),
+	}
+}
+
+// ContractAddress returns the address of the erc20 contract
+func (tm TokenMapping) ContractAddress() ethcmn.Address {
+	return ethcmn.HexToAddress(tm.Contract)
+}
+
+// String returns a human readable string representation of TokenMapping
+func (tm TokenMapping) String() string {
+	return fmt.Sprintf(`TokenMapping:
+  Denom:    %s
+  Contract: %s
+  Paused:   %t`,
+		tm.Denom, tm.Contract, tm.Paused)
+}
+
+// ValidateDenom validates the native denom mapped to an erc20 contract. The denom of the evm is excluded, since
+// it's the balance of the accounts in the evm already.
+func ValidateDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if denom == sdk.DefaultBondDenom {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "%s is the denom of the evm", denom)
+	}
+	if len(denom) > MaxERC20DenomLength {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "%s is longer than %d", denom, MaxERC20DenomLength)
+	}
+	return nil
+}
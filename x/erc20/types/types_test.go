@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDenom(t *testing.T) {
+	require.NoError(t, ValidateDenom("xxb"))
+	require.NoError(t, ValidateDenom("xxb-e2f"))
+	require.Error(t, ValidateDenom(strings.Repeat("a", MaxERC20DenomLength+1)))
+	require.Error(t, ValidateDenom(sdk.DefaultBondDenom))
+	require.Error(t, ValidateDenom("XXB"))
+}
+
+func TestMsgValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(ethcmn.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b4c1").Bytes())
+	coin := sdk.NewDecCoin("xxb", sdk.NewInt(1))
+
+	require.NoError(t, NewMsgConvertNative(sender, coin).ValidateBasic())
+	require.NoError(t, NewMsgConvertERC20(sender, coin).ValidateBasic())
+	require.Error(t, NewMsgConvertNative(nil, coin).ValidateBasic())
+	require.Error(t, NewMsgConvertNative(sender, sdk.NewDecCoin("xxb", sdk.ZeroInt())).ValidateBasic())
+	require.Error(t, NewMsgConvertERC20(sender, sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1))).ValidateBasic())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	xxb := NewTokenMapping("xxb", ERC20Address("xxb"))
+	yyb := NewTokenMapping("yyb", ERC20Address("yyb"))
+	require.NoError(t, ValidateGenesis(NewGenesisState(DefaultParams(), []TokenMapping{xxb, yyb})))
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), []TokenMapping{xxb, xxb})))
+
+	yyb.Contract = xxb.Contract
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), []TokenMapping{xxb, yyb})))
+	yyb.Contract = "0x"
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), []TokenMapping{yyb})))
+}
+
+// TestERC20Code pins the runtime code of the erc20 contracts, which is the code of the contracts deployed before,
+// so any change of buildERC20Code is a consensus change which must be done with contracts/NativeERC20.sol
+func TestERC20Code(t *testing.T) {
+	require.Equal(t, "0xbaa6a41f6ac8dd66379cc5ee2e985ac1e9b85b0bd7ec0888c926966ff5ce4f1c",
+		ethcrypto.Keccak256Hash(ERC20Code).Hex())
+}
+
+func TestShortStringValue(t *testing.T) {
+	value := ShortStringValue("xxb")
+	require.Equal(t, []byte("xxb"), value[:3])
+	require.Equal(t, byte(6), value[ethcmn.HashLength-1])
+	require.Panics(t, func() { ShortStringValue(strings.Repeat("a", MaxERC20DenomLength+1)) })
+}
@@ -14,10 +14,10 @@ import (
 const EPOCH = 252
 
 func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) stakingtypes.MsgCreateValidator {
-	//	commission := staking.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(10000))
 	return staking.NewMsgCreateValidator(address, pubKey,
-		staking.NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		staking.NewDescription("my moniker", "my identity", "my website", "my details"), commission, msd,
 	)
 }
 
@@ -123,6 +123,14 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 	}
 	StopTxLog(bam.ParseChainID)
 
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+	if err := msg.ValidateTxType(config, ctx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
 	// Verify signature and retrieve sender address
 
 	StartTxLog(bam.VerifySig)
@@ -139,13 +147,16 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 	StopTxLog(bam.Txhash)
 
 	StartTxLog(bam.SaveTx)
+	baseFee := k.GetBaseFee(ctx)
 	st := types.StateTransition{
 		AccountNonce: msg.Data.AccountNonce,
-		Price:        msg.Data.Price,
+		Price:        msg.EffectiveGasPrice(baseFee),
 		GasLimit:     msg.Data.GasLimit,
 		Recipient:    msg.Data.Recipient,
 		Amount:       msg.Data.Amount,
 		Payload:      msg.Data.Payload,
+		AccessList:   msg.Data.AccessList,
+		BaseFee:      baseFee,
 		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
 		ChainID:      chainIDEpoch,
 		TxHash:       &ethHash,
@@ -165,11 +176,6 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 		k.TxCount++
 	}
 
-	config, found := k.GetChainConfig(ctx)
-	if !found {
-		return nil, types.ErrChainConfigNotFound
-	}
-
 	StopTxLog(bam.SaveTx)
 
 	defer func() {
@@ -183,7 +189,7 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 			sendAcc := pm.AccountKeeper.GetAccount(infCtx, sender.Bytes())
 			//fix sender's balance in watcher with refund fees
 			gasConsumed := ctx.GasMeter().GasConsumed()
-			fixedFees := refund.CaculateRefundFees(ctx, gasConsumed, msg.GetFee(), msg.Data.Price)
+			fixedFees := refund.CaculateRefundFees(ctx, gasConsumed, msg.GetEffectiveFee(baseFee), st.Price)
 			coins := sendAcc.GetCoins().Add2(fixedFees)
 			_ = sendAcc.SetCoins(coins)
 			if sendAcc != nil {
@@ -224,7 +230,7 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 
 	if !st.Simulate {
 		if innerTxs != nil {
-			k.AddInnerTx(st.TxHash.Hex(), innerTxs)
+			k.AddInnerTx(*st.TxHash, innerTxs)
 		}
 		if erc20s != nil {
 			k.AddContract(erc20s)
@@ -300,6 +306,8 @@ func handleMsgEthermint(ctx sdk.Context, k *Keeper, msg types.MsgEthermint) (*sd
 		GasLimit:     msg.GasLimit,
 		Amount:       msg.Amount.BigInt(),
 		Payload:      msg.Payload,
+		AccessList:   msg.AccessList,
+		BaseFee:      k.GetBaseFee(ctx),
 		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
 		ChainID:      chainIDEpoch,
 		TxHash:       &ethHash,
@@ -331,7 +339,7 @@ func handleMsgEthermint(ctx sdk.Context, k *Keeper, msg types.MsgEthermint) (*sd
 
 	if !st.Simulate {
 		if innerTxs != nil {
-			k.AddInnerTx(st.TxHash.Hex(), innerTxs)
+			k.AddInnerTx(*st.TxHash, innerTxs)
 		}
 		if erc20s != nil {
 			k.AddContract(erc20s)
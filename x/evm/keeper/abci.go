@@ -14,6 +14,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/okex/exchain/x/evm/types"
 )
 
@@ -34,7 +35,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 	k.SetHeightHash(ctx, uint64(height), common.BytesToHash(lastHash))
 	k.SetBlockHash(ctx, lastHash, height)
-	k.InitInnerBlock(common.BytesToHash(currentHash).Hex())
+	k.InitInnerBlock(req.Header.GetHeight())
 
 	// reset counters that are used on CommitStateDB.Prepare
 	k.Bloom = big.NewInt(0)
@@ -60,6 +61,15 @@ func (k Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.Valid
 	bloom := ethtypes.BytesToBloom(k.Bloom.Bytes())
 	k.SetBlockBloom(ctx, req.Height, bloom)
 
+	// set the base fee of the next block to store after the London fork
+	baseFee := k.GetBlockBaseFee(ctx, req.Height)
+	if nextBaseFee := k.GetBlockBaseFee(ctx, req.Height+1); nextBaseFee != nil {
+		if baseFee != nil {
+			nextBaseFee = types.CalcBaseFee(baseFee, blockGasUsed(ctx), blockGasTarget(ctx))
+		}
+		k.SetBlockBaseFee(ctx, req.Height+1, nextBaseFee)
+	}
+
 	if types.GetEnableBloomFilter() {
 		// the hash of current block is stored when executing BeginBlock of next block.
 		// so update section in the next block.
@@ -104,11 +114,29 @@ func (k Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.Valid
 		params := k.GetParams(ctx)
 		k.Watcher.SaveParams(params)
 
-		k.Watcher.SaveBlock(bloom)
+		k.Watcher.SaveBlock(bloom, baseFee)
 		k.Watcher.Commit()
 	}
 
-	k.UpdateInnerBlockData()
+	k.UpdateInnerBlockData(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
+
+// blockGasUsed returns the gas consumed by the transactions of the block
+func blockGasUsed(ctx sdk.Context) uint64 {
+	if ctx.BlockGasMeter() == nil {
+		return 0
+	}
+	return ctx.BlockGasMeter().GasConsumed()
+}
+
+// blockGasTarget returns the gas target of the block as EIP-1559, which is zero if the max gas of
+// the block is unlimited
+func blockGasTarget(ctx sdk.Context) uint64 {
+	consensusParams := ctx.ConsensusParams()
+	if consensusParams == nil || consensusParams.Block == nil || consensusParams.Block.MaxGas <= 0 {
+		return 0
+	}
+	return uint64(consensusParams.Block.MaxGas) / params.ElasticityMultiplier
+}
@@ -4,7 +4,9 @@ import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/evm/watcher"
 	"github.com/spf13/viper"
@@ -63,6 +65,37 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(int64(10), bloom.Big().Int64())
 }
 
+func (suite *KeeperTestSuite) TestEndBlockBaseFee() {
+	config, found := suite.app.EvmKeeper.GetChainConfig(suite.ctx)
+	suite.Require().True(found)
+	config.BerlinBlock = sdk.NewInt(100)
+	config.LondonBlock = sdk.NewInt(100)
+	suite.app.EvmKeeper.SetChainConfig(suite.ctx, config)
+
+	// no base fee before the London fork
+	_ = suite.app.EvmKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{Height: 98})
+	suite.Require().Nil(suite.app.EvmKeeper.GetBlockBaseFee(suite.ctx, 99))
+
+	// the base fee of the fork block is the initial base fee
+	_ = suite.app.EvmKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{Height: 99})
+	suite.Require().Equal(big.NewInt(params.InitialBaseFee), suite.app.EvmKeeper.GetBlockBaseFee(suite.ctx, 100))
+
+	// the base fee decreases after an empty block
+	ctx := suite.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 20000000}})
+	_ = suite.app.EvmKeeper.EndBlock(ctx, abci.RequestEndBlock{Height: 100})
+	suite.Require().Equal(big.NewInt(875000000), suite.app.EvmKeeper.GetBlockBaseFee(suite.ctx, 101))
+
+	// the base fee increases after a full block
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(20000000))
+	ctx.BlockGasMeter().ConsumeGas(20000000, "test")
+	_ = suite.app.EvmKeeper.EndBlock(ctx, abci.RequestEndBlock{Height: 101})
+	suite.Require().Equal(big.NewInt(984375000), suite.app.EvmKeeper.GetBlockBaseFee(suite.ctx, 102))
+
+	// the base fee of the next block is used for the check txs
+	suite.Require().Equal(big.NewInt(875000000), suite.app.EvmKeeper.GetBaseFee(suite.ctx.WithBlockHeight(101)))
+	suite.Require().Equal(big.NewInt(984375000), suite.app.EvmKeeper.GetBaseFee(suite.ctx.WithBlockHeight(101).WithIsCheckTx(true)))
+}
+
 func (suite *KeeperTestSuite) TestEndBlockWatcher() {
 	// update the counters
 	suite.app.EvmKeeper.Bloom.SetInt64(10)
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	"github.com/okex/exchain/libs/cosmos-sdk/store"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -114,6 +115,8 @@ func NewSimulateKeeper(
 		LogSize:       0,
 		Watcher:       watcher.NewWatcher(),
 		Ada:           ada,
+
+		innerBlockData: defaultBlockInnerData(),
 	}
 }
 
@@ -219,6 +222,41 @@ func (k Keeper) SetBlockBloom(ctx sdk.Context, height int64, bloom ethtypes.Bloo
 	store.Set(types.BloomKey(height), bloom.Bytes())
 }
 
+// GetBlockBaseFee gets the base fee of the block at the given height, nil before the London fork
+func (k Keeper) GetBlockBaseFee(ctx sdk.Context, height int64) *big.Int {
+	config, found := k.GetChainConfig(ctx)
+	if !found || !config.IsLondon(height) {
+		return nil
+	}
+
+	store := k.Ada.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFee)
+	bz := store.Get(types.BaseFeeKey(height))
+	if len(bz) == 0 {
+		// the first block of the London fork
+		return new(big.Int).SetUint64(ethparams.InitialBaseFee)
+	}
+
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetBlockBaseFee sets the mapping from block height to base fee
+func (k Keeper) SetBlockBaseFee(ctx sdk.Context, height int64, baseFee *big.Int) {
+	store := k.Ada.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFee)
+	store.Set(types.BaseFeeKey(height), baseFee.Bytes())
+}
+
+// GetBaseFee returns the base fee of the block being executed, nil before the London fork.
+// The check state is based on the latest committed block, so the base fee of the next block is
+// returned in CheckTx since the transactions in the mempool are included by the next block.
+// No gas is consumed for reading the base fee, so the gas used of the transactions is unchanged.
+func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
+	height := ctx.BlockHeight()
+	if ctx.IsCheckTx() {
+		height++
+	}
+	return k.GetBlockBaseFee(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), height)
+}
+
 // GetAccountStorage return state storage associated with an account
 func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) (types.Storage, error) {
 	storage := types.Storage{}
@@ -249,17 +287,33 @@ func (k Keeper) GetChainConfig(ctx sdk.Context) (types.ChainConfig, bool) {
 	if err := config.UnmarshalFromAmino(bz[4:]); err != nil {
 		k.cdc.MustUnmarshalBinaryBare(bz, &config)
 	}
+	setUnforkedBlocks(&config)
 	return config, true
 }
 
 // SetChainConfig sets the mapping from block consensus hash to block height
 func (k Keeper) SetChainConfig(ctx sdk.Context, config types.ChainConfig) {
 	store := k.Ada.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainConfig)
+	setUnforkedBlocks(&config)
 	bz := k.cdc.MustMarshalBinaryBare(config)
 	// get to an empty key that's already prefixed by KeyPrefixChainConfig
 	store.Set([]byte{}, bz)
 }
 
+// setUnforkedBlocks sets the nil fork blocks of the chain configs stored before the forks were added as not forked,
+// since nil Ints are encoded as zero
+func setUnforkedBlocks(config *types.ChainConfig) {
+	if config.BerlinBlock.IsNil() {
+		config.BerlinBlock = sdk.NewInt(-1)
+	}
+	if config.LondonBlock.IsNil() {
+		config.LondonBlock = sdk.NewInt(-1)
+	}
+	if config.NativeContractsBlock.IsNil() {
+		config.NativeContractsBlock = sdk.NewInt(-1)
+	}
+}
+
 // SetGovKeeper sets keeper of gov
 func (k *Keeper) SetGovKeeper(gk GovKeeper) {
 	k.govKeeper = gk
@@ -1,22 +1,77 @@
 package keeper
 
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
 func initInnerDB() error {
-	return nil
+	return types.InitInnerTxDB()
 }
 
-type BlockInnerData = interface{}
+// BlockInnerData is the inner txs of the transactions delivered in the current block, which are saved
+// into the inner tx db at the end of the block
+type BlockInnerData = *blockInnerTxs
+
+type blockInnerTxs struct {
+	mtx      sync.Mutex
+	height   int64
+	txHashes []common.Hash
+	txs      map[common.Hash]*types.TxInnerTxs
+}
 
 func defaultBlockInnerData() BlockInnerData {
-	return nil
+	return &blockInnerTxs{txs: make(map[common.Hash]*types.TxInnerTxs)}
 }
 
 // InitInnerBlock init inner block data
-func (k *Keeper) InitInnerBlock(...interface{}) {}
+func (k *Keeper) InitInnerBlock(height int64) {
+	if !types.IsInnerTxEnabled() {
+		return
+	}
 
-func (k *Keeper) UpdateInnerBlockData(...interface{}) {}
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+	k.innerBlockData.height = height
+	k.innerBlockData.txHashes = nil
+	k.innerBlockData.txs = make(map[common.Hash]*types.TxInnerTxs)
+}
 
-// AddInnerTx add inner tx
-func (k *Keeper) AddInnerTx(...interface{}) {}
+// UpdateInnerBlockData saves the inner txs of the current block into the inner tx db. The inner tx db is
+// an index of the node, so the failure of saving is logged instead of halting the node.
+func (k *Keeper) UpdateInnerBlockData(ctx sdk.Context) {
+	if !types.IsInnerTxEnabled() {
+		return
+	}
+
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+	txs := make([]*types.TxInnerTxs, len(k.innerBlockData.txHashes))
+	for i, txHash := range k.innerBlockData.txHashes {
+		txs[i] = k.innerBlockData.txs[txHash]
+	}
+	if err := types.SaveBlockInnerTxs(k.innerBlockData.height, txs); err != nil {
+		k.Logger(ctx).Error("failed to save inner txs", "height", k.innerBlockData.height, "error", err)
+	}
+}
+
+// AddInnerTx add inner tx, the inner txs of a transaction executed more than once are overwritten by the latest ones
+func (k *Keeper) AddInnerTx(txHash common.Hash, innerTxs []*types.InnerTx) {
+	k.innerBlockData.mtx.Lock()
+	defer k.innerBlockData.mtx.Unlock()
+	if _, ok := k.innerBlockData.txs[txHash]; !ok {
+		k.innerBlockData.txHashes = append(k.innerBlockData.txHashes, txHash)
+	}
+	k.innerBlockData.txs[txHash] = &types.TxInnerTxs{
+		BlockNumber: hexutil.Uint64(k.innerBlockData.height),
+		TxHash:      txHash,
+		InnerTxs:    innerTxs,
+	}
+}
 
 // AddContract add erc20 contract
 func (k *Keeper) AddContract(...interface{}) {}
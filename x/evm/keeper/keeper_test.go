@@ -18,6 +18,7 @@ import (
 	"github.com/okex/exchain/x/evm/types"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
@@ -174,3 +175,58 @@ func (suite *KeeperTestSuite) TestChainConfig() {
 	suite.Require().True(found)
 	suite.Require().Equal(config, newConfig)
 }
+
+func (suite *KeeperTestSuite) TestInnerTxs() {
+	viper.Set("home", suite.T().TempDir())
+	viper.Set(types.FlagEnableInnerTx, true)
+	suite.Require().NoError(types.InitInnerTxDB())
+	defer func() {
+		types.CloseInnerTxDB()
+		viper.Set(types.FlagEnableInnerTx, false)
+		suite.Require().NoError(types.InitInnerTxDB())
+	}()
+
+	sender := ethcmn.HexToAddress(addrHex)
+	contract := ethcmn.HexToAddress("0x1111111111111111111111111111111111111111")
+	callee := ethcmn.HexToAddress("0x2222222222222222222222222222222222222222")
+	transferHash := ethcmn.HexToHash("0x01")
+	callHash := ethcmn.HexToHash(hex)
+	callInnerTxs := []*types.InnerTx{
+		{Depth: 0, CallType: types.InnerTxCallTypeCall, From: sender, To: contract, Value: (*hexutil.Big)(big.NewInt(10))},
+		{Depth: 1, CallType: types.InnerTxCallTypeCall, From: contract, To: callee, Value: (*hexutil.Big)(big.NewInt(5))},
+	}
+
+	suite.app.EvmKeeper.InitInnerBlock(10)
+	// the transaction without any message call made by contracts is recorded too
+	suite.app.EvmKeeper.AddInnerTx(transferHash, callInnerTxs[:1])
+	suite.app.EvmKeeper.AddInnerTx(callHash, callInnerTxs)
+	suite.app.EvmKeeper.UpdateInnerBlockData(suite.ctx)
+
+	expTransferTx := &types.TxInnerTxs{BlockNumber: 10, TxHash: transferHash, InnerTxs: callInnerTxs[:1]}
+	expTx := &types.TxInnerTxs{BlockNumber: 10, TxHash: callHash, InnerTxs: callInnerTxs}
+	tx, err := types.GetTxInnerTxs(callHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTx, tx)
+	tx, err = types.GetTxInnerTxs(transferHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTransferTx, tx)
+
+	txs, err := types.GetBlockInnerTxs(10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.TxInnerTxs{expTransferTx, expTx}, txs)
+	txs, err = types.GetBlockInnerTxs(11)
+	suite.Require().NoError(err)
+	suite.Require().Empty(txs)
+
+	txs, err = types.GetAddressInnerTxs(callee, 0, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.TxInnerTxs{expTx}, txs)
+	for _, addr := range []ethcmn.Address{sender, contract} {
+		txs, err = types.GetAddressInnerTxs(addr, 0, 10)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]*types.TxInnerTxs{expTx, expTransferTx}, txs)
+	}
+	txs, err = types.GetAddressInnerTxs(callee, 1, 10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(txs)
+}
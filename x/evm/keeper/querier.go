@@ -3,20 +3,26 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/okex/exchain/app/types"
 	"github.com/okex/exchain/app/utils"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
 )
 
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		if len(path) < 1 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
 				"Insufficient parameters, at least 1 parameter is required")
@@ -55,9 +61,92 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryContractBlockedList(ctx, keeper)
 		case types.QueryContractMethodBlockedList:
 			return queryContractMethodBlockedList(ctx, keeper)
-		default:
-			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
+		case types.QueryCreateAccessList:
+			return queryCreateAccessList(ctx, req, keeper)
+		case types.QueryBaseFee:
+			return queryBaseFee(ctx, path, keeper)
+		case types.QueryTraceTxs:
+			if !viper.GetBool(types.FlagDebugAPI) {
+				break
+			}
+			return queryTraceTxs(ctx, req, keeper)
+		case types.QueryTraceCall:
+			if !viper.GetBool(types.FlagDebugAPI) {
+				break
+			}
+			return queryTraceCall(ctx, req, keeper)
 		}
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
+	}
+}
+
+// queryCreateAccessList executes the call repeatedly with the access list collected by the last
+// execution, until the access list doesn't change any more.
+func queryCreateAccessList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var msg types.MsgEthermint
+	if err := json.Unmarshal(req.Data, &msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	config, found := keeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	from := ethcmn.BytesToAddress(msg.From)
+	to := ethcrypto.CreateAddress(from, msg.AccountNonce)
+	if msg.Recipient != nil {
+		to = ethcmn.BytesToAddress(msg.Recipient.Bytes())
+	}
+	rules := config.EthereumConfig(chainIDEpoch).Rules(big.NewInt(ctx.BlockHeight()))
+	precompiles := types.ActivePrecompiles(config, rules, ctx.BlockHeight())
+
+	txHash := ethcmn.Hash{}
+	prevTracer := vm.NewAccessListTracer(msg.AccessList, from, to, precompiles)
+	for {
+		accessList := prevTracer.AccessList()
+		tracer := vm.NewAccessListTracer(accessList, from, to, precompiles)
+
+		simCtx, _ := ctx.CacheContext()
+		simCtx = simCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		st := types.StateTransition{
+			AccountNonce: msg.AccountNonce,
+			Price:        msg.Price.BigInt(),
+			GasLimit:     msg.GasLimit,
+			Recipient:    msg.To(),
+			Amount:       msg.Amount.BigInt(),
+			Payload:      msg.Payload,
+			AccessList:   accessList,
+			BaseFee:      keeper.GetBaseFee(simCtx),
+			Csdb:         types.CreateEmptyCommitStateDB(keeper.GenerateCSDBParams(), simCtx),
+			ChainID:      chainIDEpoch,
+			TxHash:       &txHash,
+			Sender:       from,
+			Simulate:     true,
+			Tracer:       tracer,
+		}
+		_, _, err, _, _ := st.TransitionDb(simCtx, config)
+
+		if tracer.Equal(prevTracer) {
+			res := types.QueryResAccessList{
+				AccessList: accessList,
+				GasUsed:    simCtx.GasMeter().GasConsumed(),
+			}
+			if err != nil {
+				res.Error = err.Error()
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+			}
+			return bz, nil
+		}
+		prevTracer = tracer
 	}
 }
 
@@ -235,6 +324,26 @@ func queryBlockBloom(ctx sdk.Context, path []string, keeper Keeper) ([]byte, err
 	return bz, nil
 }
 
+func queryBaseFee(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+
+	num, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrStrConvertFailed, fmt.Sprintf("could not unmarshal block height: %s", err))
+	}
+
+	res := types.QueryResBaseFee{BaseFee: (*hexutil.Big)(keeper.GetBlockBaseFee(ctx, num))}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryAccount(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error) {
 	if len(path) < 2 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
@@ -1,13 +1,18 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
 
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 )
@@ -78,3 +83,184 @@ func (suite *KeeperTestSuite) TestQuerier() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryCreateAccessList() {
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	// PUSH1 0x01 SLOAD STOP
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	suite.stateDB.WithContext(suite.ctx).SetCode(contract, []byte{0x60, 0x01, 0x54, 0x00})
+	_, err := suite.stateDB.WithContext(suite.ctx).Commit(false)
+	suite.Require().NoError(err)
+
+	to := sdk.AccAddress(contract.Bytes())
+	msg := types.NewMsgEthermint(0, &to, sdk.ZeroInt(), 100000, sdk.NewInt(1), nil, suite.address.Bytes())
+	bz, err := json.Marshal(msg)
+	suite.Require().NoError(err)
+
+	res, err := suite.querier(suite.ctx, []string{types.QueryCreateAccessList}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+
+	var out types.QueryResAccessList
+	suite.Require().NoError(json.Unmarshal(res, &out))
+	suite.Require().Empty(out.Error)
+	suite.Require().Equal(ethtypes.AccessList{
+		{Address: contract, StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(1))}},
+	}, out.AccessList)
+	suite.Require().NotZero(out.GasUsed)
+}
+
+func (suite *KeeperTestSuite) TestQueryTraceCall() {
+	viper.Set(types.FlagDebugAPI, true)
+	defer viper.Set(types.FlagDebugAPI, false)
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	// PUSH1 0x01 SLOAD STOP
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	suite.stateDB.WithContext(suite.ctx).SetCode(contract, []byte{0x60, 0x01, 0x54, 0x00})
+	_, err := suite.stateDB.WithContext(suite.ctx).Commit(false)
+	suite.Require().NoError(err)
+
+	to := sdk.AccAddress(contract.Bytes())
+	msg := types.NewMsgEthermint(0, &to, sdk.ZeroInt(), 100000, sdk.ZeroInt(), nil, suite.address.Bytes())
+	callTracer := "callTracer"
+	jsTracer := "{steps: 0, step: function() { this.steps++ }, fault: function() {}, result: function() { return this.steps }}"
+	invalidTracer := "invalid"
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		malleate    func(result json.RawMessage)
+		expError    bool
+	}{
+		{
+			"struct logger",
+			nil,
+			func(result json.RawMessage) {
+				var out types.TraceExecutionResult
+				suite.Require().NoError(json.Unmarshal(result, &out))
+				suite.Require().False(out.Failed)
+				suite.Require().Len(out.StructLogs, 3)
+				suite.Require().Equal("SLOAD", out.StructLogs[1].Op)
+			},
+			false,
+		},
+		{
+			"call tracer",
+			&types.TraceConfig{Tracer: &callTracer},
+			func(result json.RawMessage) {
+				var out map[string]interface{}
+				suite.Require().NoError(json.Unmarshal(result, &out))
+				suite.Require().Equal("CALL", out["type"])
+				suite.Require().Equal(strings.ToLower(contract.Hex()), out["to"])
+			},
+			false,
+		},
+		{
+			"javascript tracer",
+			&types.TraceConfig{Tracer: &jsTracer},
+			func(result json.RawMessage) {
+				suite.Require().Equal("3", string(result))
+			},
+			false,
+		},
+		{
+			"invalid tracer",
+			&types.TraceConfig{Tracer: &invalidTracer},
+			func(json.RawMessage) {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := json.Marshal(types.QueryTraceCallParams{
+			Msg:         msg,
+			BlockHeight: suite.ctx.BlockHeight(),
+			BlockTime:   suite.ctx.BlockTime(),
+			TraceConfig: tc.traceConfig,
+		})
+		suite.Require().NoError(err)
+
+		res, err := suite.querier(suite.ctx, []string{types.QueryTraceCall}, abci.RequestQuery{Data: bz})
+		suite.Require().NoError(err, tc.msg)
+
+		var out types.QueryResTxTrace
+		suite.Require().NoError(json.Unmarshal(res, &out))
+		if tc.expError {
+			suite.Require().NotEmpty(out.Error, tc.msg)
+			continue
+		}
+		suite.Require().Empty(out.Error, tc.msg)
+		tc.malleate(out.Result)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTraceTxs() {
+	viper.Set(types.FlagDebugAPI, true)
+	defer viper.Set(types.FlagDebugAPI, false)
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+	suite.stateDB.WithContext(suite.ctx).SetBalance(sender, big.NewInt(100000))
+	_, err = suite.stateDB.WithContext(suite.ctx).Commit(false)
+	suite.Require().NoError(err)
+
+	to := ethcmn.BytesToAddress([]byte("recipient"))
+	var txs []types.TraceTx
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		msg := types.NewMsgEthereumTx(nonce, &to, big.NewInt(10), 21000, big.NewInt(1), nil)
+		suite.Require().NoError(msg.Sign(big.NewInt(3), priv.ToECDSA()))
+		bz, err := msg.MarshalBinary()
+		suite.Require().NoError(err)
+		txs = append(txs, types.TraceTx{Tx: bz, Hash: ethcmn.BytesToHash([]byte{byte(nonce)})})
+	}
+
+	// the second transaction is valid only if the first one is replayed
+	bz, err := json.Marshal(types.QueryTraceTxsParams{
+		Txs:         txs,
+		TraceFrom:   1,
+		BlockHeight: suite.ctx.BlockHeight(),
+		BlockTime:   suite.ctx.BlockTime(),
+	})
+	suite.Require().NoError(err)
+	res, err := suite.querier(suite.ctx, []string{types.QueryTraceTxs}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+
+	var out []types.QueryResTxTrace
+	suite.Require().NoError(json.Unmarshal(res, &out))
+	suite.Require().Len(out, 1)
+	suite.Require().Empty(out[0].Error)
+	var result types.TraceExecutionResult
+	suite.Require().NoError(json.Unmarshal(out[0].Result, &result))
+	suite.Require().Equal(uint64(21000), result.Gas)
+	suite.Require().False(result.Failed)
+
+	// the nonce of the second transaction is invalid without the first one
+	bz, err = json.Marshal(types.QueryTraceTxsParams{
+		Txs:         txs[1:],
+		BlockHeight: suite.ctx.BlockHeight(),
+		BlockTime:   suite.ctx.BlockTime(),
+	})
+	suite.Require().NoError(err)
+	res, err = suite.querier(suite.ctx, []string{types.QueryTraceTxs}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(res, &out))
+	suite.Require().Len(out, 1)
+	suite.Require().Contains(out[0].Error, "invalid nonce")
+
+	// the replayed transactions are not committed
+	suite.Require().Equal(uint64(0), suite.stateDB.WithContext(suite.ctx).GetNonce(sender))
+
+	// the trace queries are not served if the debug api is disabled
+	viper.Set(types.FlagDebugAPI, false)
+	_, err = suite.querier(suite.ctx, []string{types.QueryTraceTxs}, abci.RequestQuery{Data: bz})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/evm/watcher"
+)
+
+// defaultTraceTimeout is the max time of tracing a single transaction with a javascript tracer
+const defaultTraceTimeout = 5 * time.Second
+
+// queryTraceTxs replays the evm transactions of a block on the state of its parent block, and traces
+// the transactions from params.TraceFrom. The state is queried at the height of the parent block.
+// The cosmos transactions and the begin block of the block are not replayed, since executing them
+// would touch the caches of other modules shared with the delivered blocks.
+func queryTraceTxs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTraceTxsParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(params.BlockHeight).WithBlockTime(params.BlockTime)
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	config, found := keeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+	baseFee := keeper.GetBlockBaseFee(ctx, params.BlockHeight)
+	csdb := types.CreateEmptyCommitStateDB(keeper.traceCSDBParams(), ctx)
+
+	var results []types.QueryResTxTrace
+	for i, traceTx := range params.Txs {
+		var msg types.MsgEthereumTx
+		if err := msg.UnmarshalBinary(traceTx.Tx); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		sigCache, err := msg.VerifySig(chainIDEpoch, params.BlockHeight, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash := traceTx.Hash
+		st := types.StateTransition{
+			AccountNonce: msg.Data.AccountNonce,
+			Price:        msg.EffectiveGasPrice(baseFee),
+			GasLimit:     msg.Data.GasLimit,
+			Recipient:    msg.Data.Recipient,
+			Amount:       msg.Data.Amount,
+			Payload:      msg.Data.Payload,
+			AccessList:   msg.Data.AccessList,
+			BaseFee:      baseFee,
+			Csdb:         csdb,
+			ChainID:      chainIDEpoch,
+			TxHash:       &txHash,
+			Sender:       sigCache.GetFrom(),
+			Simulate:     true,
+		}
+		csdb.Prepare(txHash, params.BlockHash, i)
+
+		// the predecessors are executed without tracing
+		if i < params.TraceFrom {
+			_, _ = applyTraceTransition(ctx, st, config, true)
+			continue
+		}
+
+		tracerCtx := &tracers.Context{BlockHash: params.BlockHash, TxIndex: i, TxHash: txHash}
+		results = append(results, traceTransition(ctx, st, config, params.TraceConfig, tracerCtx, true))
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryTraceCall traces a call on the state of the block at the query height
+func queryTraceCall(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTraceCallParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(params.BlockHeight).WithBlockTime(params.BlockTime)
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	config, found := keeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	msg := params.Msg
+	from := ethcmn.BytesToAddress(msg.From)
+	csdb := types.CreateEmptyCommitStateDB(keeper.traceCSDBParams(), ctx)
+	txHash := ethcmn.Hash{}
+	st := types.StateTransition{
+		AccountNonce: csdb.GetNonce(from),
+		Price:        msg.Price.BigInt(),
+		GasLimit:     msg.GasLimit,
+		Recipient:    msg.To(),
+		Amount:       msg.Amount.BigInt(),
+		Payload:      msg.Payload,
+		AccessList:   msg.AccessList,
+		BaseFee:      keeper.GetBlockBaseFee(ctx, params.BlockHeight),
+		Csdb:         csdb,
+		ChainID:      chainIDEpoch,
+		TxHash:       &txHash,
+		Sender:       from,
+		Simulate:     true,
+	}
+
+	res := traceTransition(ctx, st, config, params.TraceConfig, new(tracers.Context), false)
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// traceTransition executes the state transition with the tracer specified by the trace config,
+// and returns the result of the tracer
+func traceTransition(ctx sdk.Context, st types.StateTransition, config types.ChainConfig,
+	traceConfig *types.TraceConfig, tracerCtx *tracers.Context, checkNonce bool) types.QueryResTxTrace {
+	tracer, stop, err := newTracer(traceConfig, tracerCtx)
+	if err != nil {
+		return types.QueryResTxTrace{Error: err.Error()}
+	}
+	defer stop()
+
+	st.Tracer = tracer
+	gasUsed, err := applyTraceTransition(ctx, st, config, checkNonce)
+	if err != nil {
+		return types.QueryResTxTrace{Error: err.Error()}
+	}
+
+	var result json.RawMessage
+	switch tracer := tracer.(type) {
+	case *vm.StructLogger:
+		returnVal := fmt.Sprintf("%x", tracer.Output())
+		result, err = json.Marshal(&types.TraceExecutionResult{
+			Gas:         gasUsed,
+			Failed:      tracer.Error() != nil,
+			ReturnValue: returnVal,
+			StructLogs:  types.FormatLogs(tracer.StructLogs()),
+		})
+	case *tracers.Tracer:
+		result, err = tracer.GetResult()
+	default:
+		err = fmt.Errorf("bad tracer type %T", tracer)
+	}
+	if err != nil {
+		return types.QueryResTxTrace{Error: err.Error()}
+	}
+	return types.QueryResTxTrace{Result: result}
+}
+
+// newTracer creates the struct logger if no tracer is specified, otherwise the javascript tracer which
+// is stopped if it runs out of the timeout. The returned function must be called after tracing.
+func newTracer(traceConfig *types.TraceConfig, tracerCtx *tracers.Context) (vm.Tracer, func(), error) {
+	if traceConfig == nil {
+		return vm.NewStructLogger(nil), func() {}, nil
+	}
+	if traceConfig.Tracer == nil {
+		return vm.NewStructLogger(traceConfig.LogConfig), func() {}, nil
+	}
+
+	// the timeout is capped by the default one, since the tracing is executed by the node
+	timeout := defaultTraceTimeout
+	if traceConfig.Timeout != nil {
+		requested, err := time.ParseDuration(*traceConfig.Timeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		if requested < timeout {
+			timeout = requested
+		}
+	}
+	tracer, err := tracers.New(*traceConfig.Tracer, tracerCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	timer := time.AfterFunc(timeout, func() {
+		tracer.Stop(errors.New("execution timeout"))
+	})
+	return tracer, func() { timer.Stop() }, nil
+}
+
+// applyTraceTransition executes the state transition as the transaction is delivered: the sender pays for
+// the gas limit and its nonce is increased before the execution as the ante handler does, and the unused
+// gas is refunded after the execution. The state is finalised to be read by the following transitions.
+// It returns the gas used by the transition, the failure of the evm execution is left to the tracer.
+func applyTraceTransition(ctx sdk.Context, st types.StateTransition, config types.ChainConfig, checkNonce bool) (uint64, error) {
+	csdb := st.Csdb
+	if nonce := csdb.GetNonce(st.Sender); checkNonce && nonce != st.AccountNonce {
+		return 0, fmt.Errorf("invalid nonce; got %d, expected %d", st.AccountNonce, nonce)
+	}
+	fee := new(big.Int).Mul(st.Price, new(big.Int).SetUint64(st.GasLimit))
+	if balance := csdb.GetBalance(st.Sender); balance.Cmp(fee) < 0 {
+		return 0, fmt.Errorf("insufficient funds for gas * price: address %s have %s want %s",
+			st.Sender.String(), balance, fee)
+	}
+	csdb.SubBalance(st.Sender, fee)
+	csdb.SetNonce(st.Sender, st.AccountNonce+1)
+
+	txCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, _, _, _ = st.TransitionDb(txCtx, config)
+	gasUsed := txCtx.GasMeter().GasConsumed()
+	if gasUsed > st.GasLimit {
+		gasUsed = st.GasLimit
+	}
+	csdb.AddBalance(st.Sender, new(big.Int).Mul(st.Price, new(big.Int).SetUint64(st.GasLimit-gasUsed)))
+
+	return gasUsed, csdb.WithContext(ctx).Finalise(true)
+}
+
+// traceCSDBParams returns the csdb params for tracing, the watcher is disabled since the replayed
+// transactions must not be saved
+func (k Keeper) traceCSDBParams() types.CommitStateDBParams {
+	params := k.GenerateCSDBParams()
+	params.Watcher = &watcher.Watcher{}
+	return params
+}
@@ -45,6 +45,11 @@ type ChainConfig struct {
 
 	YoloV2Block sdk.Int `json:"yoloV2_block" yaml:"yoloV2_block"` // YOLO v1: https://github.com/ethereum/EIPs/pull/2657 (Ephemeral testnet)
 	EWASMBlock  sdk.Int `json:"ewasm_block" yaml:"ewasm_block"`   // EWASM switch block (< 0 no fork, 0 = already activated)
+
+	BerlinBlock sdk.Int `json:"berlin_block" yaml:"berlin_block"` // Berlin switch block (< 0 or nil no fork, 0 = already on berlin)
+	LondonBlock sdk.Int `json:"london_block" yaml:"london_block"` // London switch block (< 0 or nil no fork, 0 = already on london)
+
+	NativeContractsBlock sdk.Int `json:"native_contracts_block" yaml:"native_contracts_block"` // native contracts switch block (< 0 or nil no fork, 0 = already enabled)
 }
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
@@ -64,6 +69,8 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		PetersburgBlock:     getBlockValue(cc.PetersburgBlock),
 		IstanbulBlock:       getBlockValue(cc.IstanbulBlock),
 		MuirGlacierBlock:    getBlockValue(cc.MuirGlacierBlock),
+		BerlinBlock:         getBlockValue(cc.BerlinBlock),
+		LondonBlock:         getBlockValue(cc.LondonBlock),
 	}
 }
 
@@ -77,6 +84,21 @@ func (cc ChainConfig) IsHomestead() bool {
 	return getBlockValue(cc.HomesteadBlock) != nil
 }
 
+// IsBerlin returns whether the Berlin version is enabled at the given height.
+func (cc ChainConfig) IsBerlin(height int64) bool {
+	return isForked(getBlockValue(cc.BerlinBlock), height)
+}
+
+// IsLondon returns whether the London version is enabled at the given height.
+func (cc ChainConfig) IsLondon(height int64) bool {
+	return isForked(getBlockValue(cc.LondonBlock), height)
+}
+
+// IsNativeContracts returns whether the native contracts are enabled at the given height.
+func (cc ChainConfig) IsNativeContracts(height int64) bool {
+	return isForked(getBlockValue(cc.NativeContractsBlock), height)
+}
+
 // String implements the fmt.Stringer interface
 func (cc ChainConfig) String() string {
 	out, _ := yaml.Marshal(cc)
@@ -100,17 +122,26 @@ func DefaultChainConfig() ChainConfig {
 		MuirGlacierBlock:    sdk.ZeroInt(),
 		YoloV2Block:         sdk.NewInt(-1),
 		EWASMBlock:          sdk.NewInt(-1),
+		BerlinBlock:         sdk.NewInt(-1),
+		LondonBlock:         sdk.NewInt(-1),
+
+		NativeContractsBlock: sdk.NewInt(-1),
 	}
 }
 
 func getBlockValue(block sdk.Int) *big.Int {
-	if block.IsNegative() {
+	// the chain configs stored before the Berlin, London and native contracts forks were added have nil values
+	if block.IsNil() || block.IsNegative() {
 		return nil
 	}
 
 	return block.BigInt()
 }
 
+func isForked(fork *big.Int, height int64) bool {
+	return fork != nil && fork.Cmp(big.NewInt(height)) <= 0
+}
+
 // Validate performs a basic validation of the ChainConfig params. The function will return an error
 // if any of the block values is uninitialized (i.e nil) or if the EIP150Hash is an invalid hash.
 func (cc ChainConfig) Validate() error {
@@ -153,6 +184,11 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.EWASMBlock); err != nil {
 		return sdkerrors.Wrap(err, "eWASMBlock")
 	}
+	// nil Berlin and London blocks are allowed for the chain configs stored before they were added
+	berlinBlock, londonBlock := getBlockValue(cc.BerlinBlock), getBlockValue(cc.LondonBlock)
+	if londonBlock != nil && (berlinBlock == nil || berlinBlock.Cmp(londonBlock) > 0) {
+		return sdkerrors.Wrap(ErrInvalidChainConfig, "london fork must be enabled after berlin fork")
+	}
 
 	return nil
 }
@@ -168,11 +204,11 @@ func (config *ChainConfig) UnmarshalFromAmino(data []byte) error {
 			break
 		}
 
-		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		pos, aminoType, n, err := parseChainConfigField(data)
 		if err != nil {
 			return err
 		}
-		data = data[1:]
+		data = data[n:]
 
 		if aminoType == amino.Typ3_ByteLength {
 			var n int
@@ -265,6 +301,24 @@ func (config *ChainConfig) UnmarshalFromAmino(data []byte) error {
 				return err
 			}
 			config.EWASMBlock = integer
+		case 15:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.BerlinBlock = integer
+		case 16:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.LondonBlock = integer
+		case 17:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return err
+			}
+			config.NativeContractsBlock = integer
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -296,3 +350,17 @@ func validateBlock(block sdk.Int) error {
 
 	return nil
 }
+
+// parseChainConfigField parses the field number and type of the chain config field, the field
+// numbers of the Berlin and London blocks take more than one byte.
+func parseChainConfigField(data []byte) (int, amino.Typ3, int, error) {
+	if data[0]&0x80 == 0 {
+		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		return pos, aminoType, 1, err
+	}
+	key, n, err := amino.DecodeUvarint(data)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return int(key >> 3), amino.Typ3(key & 0x07), n, nil
+}
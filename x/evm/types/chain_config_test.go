@@ -35,6 +35,8 @@ func TestChainConfigValidate(t *testing.T) {
 				MuirGlacierBlock:    sdk.OneInt(),
 				YoloV2Block:         sdk.OneInt(),
 				EWASMBlock:          sdk.OneInt(),
+				BerlinBlock:         sdk.OneInt(),
+				LondonBlock:         sdk.OneInt(),
 			},
 			false,
 		},
@@ -231,6 +233,48 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"london before berlin",
+			ChainConfig{
+				HomesteadBlock:      sdk.OneInt(),
+				DAOForkBlock:        sdk.OneInt(),
+				EIP150Block:         sdk.OneInt(),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         sdk.OneInt(),
+				EIP158Block:         sdk.OneInt(),
+				ByzantiumBlock:      sdk.OneInt(),
+				ConstantinopleBlock: sdk.OneInt(),
+				PetersburgBlock:     sdk.OneInt(),
+				IstanbulBlock:       sdk.OneInt(),
+				MuirGlacierBlock:    sdk.OneInt(),
+				YoloV2Block:         sdk.OneInt(),
+				EWASMBlock:          sdk.OneInt(),
+				BerlinBlock:         sdk.NewInt(2),
+				LondonBlock:         sdk.OneInt(),
+			},
+			true,
+		},
+		{
+			"london without berlin",
+			ChainConfig{
+				HomesteadBlock:      sdk.OneInt(),
+				DAOForkBlock:        sdk.OneInt(),
+				EIP150Block:         sdk.OneInt(),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         sdk.OneInt(),
+				EIP158Block:         sdk.OneInt(),
+				ByzantiumBlock:      sdk.OneInt(),
+				ConstantinopleBlock: sdk.OneInt(),
+				PetersburgBlock:     sdk.OneInt(),
+				IstanbulBlock:       sdk.OneInt(),
+				MuirGlacierBlock:    sdk.OneInt(),
+				YoloV2Block:         sdk.OneInt(),
+				EWASMBlock:          sdk.OneInt(),
+				BerlinBlock:         sdk.NewInt(-1),
+				LondonBlock:         sdk.OneInt(),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -259,6 +303,9 @@ istanbul_block: "0"
 muir_glacier_block: "0"
 yoloV2_block: "-1"
 ewasm_block: "-1"
+berlin_block: "-1"
+london_block: "-1"
+native_contracts_block: "-1"
 `
 	require.Equal(t, configStr, DefaultChainConfig().String())
 }
@@ -83,12 +83,12 @@ func UnmarshalChainConfigFromAmino(_ *amino.Codec, data []byte) (*ChainConfig, i
 			break
 		}
 
-		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		pos, aminoType, n, err := parseChainConfigField(data)
 		if err != nil {
 			return nil, read, err
 		}
-		data = data[1:]
-		read += 1
+		data = data[n:]
+		read += n
 
 		if aminoType == amino.Typ3_ByteLength {
 			var n int
@@ -182,6 +182,24 @@ func UnmarshalChainConfigFromAmino(_ *amino.Codec, data []byte) (*ChainConfig, i
 				return nil, read, err
 			}
 			config.EWASMBlock = integer
+		case 15:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.BerlinBlock = integer
+		case 16:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.LondonBlock = integer
+		case 17:
+			integer, err := sdk.NewIntFromAmino(subData)
+			if err != nil {
+				return nil, read, err
+			}
+			config.NativeContractsBlock = integer
 		default:
 			return nil, read, fmt.Errorf("unexpect feild num %d", pos)
 		}
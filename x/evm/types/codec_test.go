@@ -24,6 +24,10 @@ func TestUnmarshalChainConfigFromAmino(t *testing.T) {
 		MuirGlacierBlock:    sdk.ZeroInt(),
 		YoloV2Block:         sdk.OneInt(),
 		EWASMBlock:          sdk.OneInt(),
+		BerlinBlock:         sdk.OneInt(),
+		LondonBlock:         sdk.NewInt(2),
+
+		NativeContractsBlock: sdk.NewInt(3),
 	}
 	cdc := amino.NewCodec()
 	RegisterCodec(cdc)
@@ -58,6 +62,8 @@ func BenchmarkUnmarshalChainConfigFromAmino(b *testing.B) {
 		MuirGlacierBlock:    sdk.OneInt(),
 		YoloV2Block:         sdk.OneInt(),
 		EWASMBlock:          sdk.OneInt(),
+		BerlinBlock:         sdk.OneInt(),
+		LondonBlock:         sdk.NewInt(2),
 	}
 	cdc := amino.NewCodec()
 	RegisterCodec(cdc)
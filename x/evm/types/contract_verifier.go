@@ -29,6 +29,10 @@ func (cv ContractVerifier) Verify(stateDB vm.StateDB, op vm.OpCode, from, to com
 	if !ok {
 		panic(ErrContractBlockedVerify{"unknown stateDB expected CommitStateDB"})
 	}
+	// the call to a native contract of the state db is saved for the precompiled contract
+	if contract, ok := csdb.nativeContracts[to]; ok && op != vm.SELFDESTRUCT {
+		return prepareNativeCall(csdb, contract, op, from, input, value)
+	}
 	//check whether contract has been blocked
 	if !cv.params.EnableContractBlockedList {
 		return nil
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// CalcBaseFee calculates the base fee of the next block with the base fee and the gas used of the
+// parent block as EIP-1559. The base fee is unchanged if the gas target of the parent block is zero,
+// i.e. the max gas of the block is unlimited.
+func CalcBaseFee(parentBaseFee *big.Int, parentGasUsed, parentGasTarget uint64) *big.Int {
+	if parentGasTarget == 0 || parentGasUsed == parentGasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	target := new(big.Int).SetUint64(parentGasTarget)
+	denominator := big.NewInt(params.BaseFeeChangeDenominator)
+	if parentGasUsed > parentGasTarget {
+		// the base fee increases at least 1 if the parent block used more gas than its target
+		delta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, target)
+		delta.Div(delta, denominator)
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		return delta.Add(delta, parentBaseFee)
+	}
+
+	// the base fee decreases if the parent block used less gas than its target
+	delta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
+	delta.Mul(delta, parentBaseFee)
+	delta.Div(delta, target)
+	delta.Div(delta, denominator)
+	baseFee := delta.Sub(parentBaseFee, delta)
+	if baseFee.Sign() < 0 {
+		return new(big.Int)
+	}
+	return baseFee
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	testCases := []struct {
+		parentBaseFee   int64
+		parentGasUsed   uint64
+		parentGasTarget uint64
+		expBaseFee      int64
+	}{
+		{1000000000, 10000000, 10000000, 1000000000}, // usage == target
+		{1000000000, 9000000, 10000000, 987500000},   // usage below target
+		{1000000000, 11000000, 10000000, 1012500000}, // usage above target
+		{1, 10000001, 10000000, 2},                   // increases at least 1
+		{1000000000, 0, 10000000, 875000000},         // empty block
+		{1000000000, 20000000, 0, 1000000000},        // unlimited block gas
+	}
+
+	for i, tc := range testCases {
+		baseFee := CalcBaseFee(big.NewInt(tc.parentBaseFee), tc.parentGasUsed, tc.parentGasTarget)
+		require.Equal(t, big.NewInt(tc.expBaseFee), baseFee, "test %d", i)
+	}
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	json "github.com/json-iterator/go"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	innerTxDir = "innertx"
+
+	FlagEnableInnerTx = "evm-innertx-enable"
+)
+
+// prefixes of the keys in the inner tx db
+var (
+	innerTxPrefixTx      = []byte{0x01} // tx hash -> TxInnerTxs
+	innerTxPrefixBlock   = []byte{0x02} // block height -> tx hashes of the block
+	innerTxPrefixAddress = []byte{0x03} // address + block height + tx hash -> tx hash
+)
+
+var (
+	innerTxDB     dbm.DB
+	enableInnerTx bool
+
+	errInnerTxDisabled = errors.New("inner tx is disabled, please restart the node with --" + FlagEnableInnerTx)
+)
+
+// TxInnerTxs is the inner txs recorded during the execution of an evm transaction
+type TxInnerTxs struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	InnerTxs    []*InnerTx     `json:"internalTransactions"`
+}
+
+// InitInnerTxDB opens the db of the inner txs if the inner txs are enabled
+func InitInnerTxDB() error {
+	enableInnerTx = viper.GetBool(FlagEnableInnerTx)
+	if !enableInnerTx {
+		return nil
+	}
+
+	var err error
+	dataDir := filepath.Join(viper.GetString("home"), "data")
+	innerTxDB, err = sdk.NewLevelDB(innerTxDir, dataDir)
+	return err
+}
+
+func CloseInnerTxDB() {
+	if innerTxDB != nil {
+		innerTxDB.Close()
+	}
+}
+
+// IsInnerTxEnabled returns whether the inner txs of the evm transactions are recorded
+func IsInnerTxEnabled() bool {
+	return enableInnerTx && innerTxDB != nil
+}
+
+// SaveBlockInnerTxs saves the inner txs of the transactions in a block, the transactions are indexed by
+// the block height and all the addresses involved in their inner txs
+func SaveBlockInnerTxs(height int64, txs []*TxInnerTxs) error {
+	if !IsInnerTxEnabled() || len(txs) == 0 {
+		return nil
+	}
+
+	batch := innerTxDB.NewBatch()
+	defer batch.Close()
+
+	txHashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		bz, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+		batch.Set(innerTxTxKey(tx.TxHash), bz)
+		txHashes[i] = tx.TxHash
+
+		indexed := make(map[common.Address]struct{})
+		for _, innerTx := range tx.InnerTxs {
+			for _, addr := range []common.Address{innerTx.From, innerTx.To} {
+				if _, ok := indexed[addr]; ok || addr == (common.Address{}) {
+					continue
+				}
+				indexed[addr] = struct{}{}
+				batch.Set(innerTxAddressKey(addr, height, tx.TxHash), tx.TxHash.Bytes())
+			}
+		}
+	}
+
+	bz, err := json.Marshal(txHashes)
+	if err != nil {
+		return err
+	}
+	batch.Set(innerTxBlockKey(height), bz)
+	return batch.WriteSync()
+}
+
+// GetTxInnerTxs returns the inner txs of the transaction, nil is returned if the transaction has no inner tx
+func GetTxInnerTxs(txHash common.Hash) (*TxInnerTxs, error) {
+	if !IsInnerTxEnabled() {
+		return nil, errInnerTxDisabled
+	}
+	bz, err := innerTxDB.Get(innerTxTxKey(txHash))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var tx TxInnerTxs
+	if err := json.Unmarshal(bz, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// GetBlockInnerTxs returns the inner txs of the transactions in the block
+func GetBlockInnerTxs(height int64) ([]*TxInnerTxs, error) {
+	if !IsInnerTxEnabled() {
+		return nil, errInnerTxDisabled
+	}
+	bz, err := innerTxDB.Get(innerTxBlockKey(height))
+	if err != nil || bz == nil {
+		return []*TxInnerTxs{}, err
+	}
+
+	var txHashes []common.Hash
+	if err := json.Unmarshal(bz, &txHashes); err != nil {
+		return nil, err
+	}
+	return getTxsInnerTxs(txHashes)
+}
+
+// GetAddressInnerTxs returns the inner txs of the transactions whose inner txs involve the address, from
+// the latest transaction to the earliest one. The first offset transactions are skipped and at most limit
+// transactions are returned.
+func GetAddressInnerTxs(addr common.Address, offset, limit int) ([]*TxInnerTxs, error) {
+	if !IsInnerTxEnabled() {
+		return nil, errInnerTxDisabled
+	}
+
+	prefix := append(innerTxPrefixAddress, addr.Bytes()...)
+	it, err := innerTxDB.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var txHashes []common.Hash
+	for ; it.Valid() && len(txHashes) < limit; it.Next() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+		txHashes = append(txHashes, common.BytesToHash(it.Value()))
+	}
+	return getTxsInnerTxs(txHashes)
+}
+
+func getTxsInnerTxs(txHashes []common.Hash) ([]*TxInnerTxs, error) {
+	txs := make([]*TxInnerTxs, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		tx, err := GetTxInnerTxs(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func innerTxTxKey(txHash common.Hash) []byte {
+	return append(innerTxPrefixTx, txHash.Bytes()...)
+}
+
+func innerTxBlockKey(height int64) []byte {
+	return append(innerTxPrefixBlock, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func innerTxAddressKey(addr common.Address, height int64, txHash common.Hash) []byte {
+	key := make([]byte, 0, len(innerTxPrefixAddress)+common.AddressLength+8+common.HashLength)
+	key = append(key, innerTxPrefixAddress...)
+	key = append(key, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, txHash.Bytes()...)
+}
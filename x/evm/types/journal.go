@@ -152,6 +152,12 @@ type (
 		prev    sdk.Dec
 	}
 
+	coinBalanceChange struct {
+		account *ethcmn.Address
+		denom   string
+		prev    sdk.Dec
+	}
+
 	nonceChange struct {
 		account *ethcmn.Address
 		prev    uint64
@@ -192,6 +198,9 @@ type (
 		address *ethcmn.Address
 		slot    *ethcmn.Hash
 	}
+
+	// Changes to the store by the native contracts.
+	nativeWriteChange struct{}
 )
 
 func (ch createObjectChange) revert(s *CommitStateDB) {
@@ -252,6 +261,14 @@ func (ch balanceChange) dirtied() *ethcmn.Address {
 	return ch.account
 }
 
+func (ch coinBalanceChange) revert(s *CommitStateDB) {
+	s.getStateObject(*ch.account).setBalance(ch.denom, ch.prev)
+}
+
+func (ch coinBalanceChange) dirtied() *ethcmn.Address {
+	return ch.account
+}
+
 func (ch nonceChange) revert(s *CommitStateDB) {
 	s.getStateObject(*ch.account).setNonce(ch.prev)
 }
@@ -362,3 +379,11 @@ func (ch accessListAddSlotChange) revert(s *CommitStateDB) {
 func (ch accessListAddSlotChange) dirtied() *ethcmn.Address {
 	return nil
 }
+
+func (ch nativeWriteChange) revert(s *CommitStateDB) {
+	s.nativeWrites = s.nativeWrites[:len(s.nativeWrites)-1]
+}
+
+func (ch nativeWriteChange) dirtied() *ethcmn.Address {
+	return nil
+}
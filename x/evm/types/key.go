@@ -28,6 +28,7 @@ var (
 	KeyPrefixHeightHash                  = []byte{0x07}
 	KeyPrefixContractDeploymentWhitelist = []byte{0x08}
 	KeyPrefixContractBlockedList         = []byte{0x09}
+	KeyPrefixBaseFee                     = []byte{0x0A}
 )
 
 // HeightHashKey returns the key for the given chain epoch and height.
@@ -44,6 +45,11 @@ func BloomKey(height int64) []byte {
 	return sdk.Uint64ToBigEndian(uint64(height))
 }
 
+// BaseFeeKey defines the store key for the base fee of a block
+func BaseFeeKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address ethcmn.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
@@ -54,6 +54,9 @@ type MsgEthermint struct {
 
 	// From address (formerly derived from signature)
 	From sdk.AccAddress `json:"from"`
+
+	// EIP-2930 access list, only used by the simulated calls
+	AccessList ethtypes.AccessList `json:"accessList,omitempty"`
 }
 
 // NewMsgEthermint returns a reference to a new Ethermint transaction
@@ -183,6 +186,12 @@ func (msg *MsgEthermint) UnmarshalFromAmino(data []byte) error {
 		case 7:
 			msg.From = make([]byte, dataLen)
 			copy(msg.From, subData)
+		case 8:
+			var tuple ethtypes.AccessTuple
+			if err := unmarshalAccessTupleFromAmino(&tuple, subData); err != nil {
+				return err
+			}
+			msg.AccessList = append(msg.AccessList, tuple)
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -250,6 +259,37 @@ func NewMsgEthereumTxContract(
 	return newMsgEthereumTx(nonce, nil, amount, gasLimit, gasPrice, payload)
 }
 
+// NewMsgEthereumTxWithAccessList returns a reference to a new EIP-2930 access list
+// transaction message. A nil recipient means contract creation.
+func NewMsgEthereumTxWithAccessList(
+	chainID *big.Int, nonce uint64, to *ethcmn.Address, amount *big.Int,
+	gasLimit uint64, gasPrice *big.Int, payload []byte, accessList ethtypes.AccessList,
+) MsgEthereumTx {
+	msg := newMsgEthereumTx(nonce, to, amount, gasLimit, gasPrice, payload)
+	msg.Data.Type = ethtypes.AccessListTxType
+	msg.Data.ChainID = new(big.Int)
+	if chainID != nil {
+		msg.Data.ChainID.Set(chainID)
+	}
+	msg.Data.AccessList = accessList
+	return msg
+}
+
+// NewMsgEthereumTxWithDynamicFee returns a reference to a new EIP-1559 dynamic fee
+// transaction message. A nil recipient means contract creation.
+func NewMsgEthereumTxWithDynamicFee(
+	chainID *big.Int, nonce uint64, to *ethcmn.Address, amount *big.Int, gasLimit uint64,
+	gasTipCap, gasFeeCap *big.Int, payload []byte, accessList ethtypes.AccessList,
+) MsgEthereumTx {
+	msg := NewMsgEthereumTxWithAccessList(chainID, nonce, to, amount, gasLimit, gasFeeCap, payload, accessList)
+	msg.Data.Type = ethtypes.DynamicFeeTxType
+	msg.Data.GasTipCap = new(big.Int)
+	if gasTipCap != nil {
+		msg.Data.GasTipCap.Set(gasTipCap)
+	}
+	return msg
+}
+
 func newMsgEthereumTx(
 	nonce uint64, to *ethcmn.Address, amount *big.Int,
 	gasLimit uint64, gasPrice *big.Int, payload []byte,
@@ -306,9 +346,89 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 		return sdkerrors.Wrapf(types.ErrInvalidValue, "amount cannot be negative %s", msg.Data.Amount)
 	}
 
+	switch msg.Data.Type {
+	case ethtypes.LegacyTxType:
+	case ethtypes.AccessListTxType:
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() <= 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "chain id of typed transaction must be positive")
+		}
+	case ethtypes.DynamicFeeTxType:
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() <= 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "chain id of typed transaction must be positive")
+		}
+		if msg.Data.GasTipCap == nil || msg.Data.GasTipCap.Sign() == -1 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "max priority fee per gas cannot be nil or negative")
+		}
+		if msg.Data.GasTipCap.Cmp(msg.Data.Price) > 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "max priority fee per gas higher than max fee per gas (%s > %s)",
+				msg.Data.GasTipCap, msg.Data.Price)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidValue, "unsupported transaction type %d", msg.Data.Type)
+	}
+
+	return nil
+}
+
+// TxType returns the EIP-2718 type of the transaction, zero for legacy transactions.
+func (msg MsgEthereumTx) TxType() uint8 {
+	return msg.Data.Type
+}
+
+// ValidateTxType returns an error if the typed transaction is not enabled by the chain config at the height:
+// the access list transactions are accepted from the Berlin fork, and the dynamic fee ones from the London fork.
+func (msg MsgEthereumTx) ValidateTxType(config ChainConfig, height int64) error {
+	switch msg.Data.Type {
+	case ethtypes.AccessListTxType:
+		if !config.IsBerlin(height) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "access list transaction is not supported before the berlin fork")
+		}
+	case ethtypes.DynamicFeeTxType:
+		if !config.IsLondon(height) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "dynamic fee transaction is not supported before the london fork")
+		}
+	}
 	return nil
 }
 
+// AccessList returns the EIP-2930 access list of the transaction, nil for legacy transactions.
+func (msg MsgEthereumTx) AccessList() ethtypes.AccessList {
+	return msg.Data.AccessList
+}
+
+// GasTipCap returns the max priority fee per gas of the transaction, which is the gas price
+// for the transactions other than EIP-1559 dynamic fee transactions.
+func (msg MsgEthereumTx) GasTipCap() *big.Int {
+	if msg.Data.Type == ethtypes.DynamicFeeTxType {
+		return msg.Data.GasTipCap
+	}
+	return msg.Data.Price
+}
+
+// EffectiveGasPrice returns the gas price paid by the transaction with the base fee of the block,
+// which is min(gasTipCap + baseFee, gasFeeCap) for EIP-1559 dynamic fee transactions and the gas
+// price for the others. The max fee per gas is returned if the base fee is nil.
+func (msg MsgEthereumTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	if msg.Data.Type != ethtypes.DynamicFeeTxType || baseFee == nil {
+		return msg.Data.Price
+	}
+
+	gasPrice := new(big.Int).Add(msg.Data.GasTipCap, baseFee)
+	if gasPrice.Cmp(msg.Data.Price) > 0 {
+		return msg.Data.Price
+	}
+	return gasPrice
+}
+
+// GetEffectiveFee returns the fee paid by the transaction with the base fee of the block,
+// as the effective gas price * gas limit
+func (msg MsgEthereumTx) GetEffectiveFee(baseFee *big.Int) sdk.Coins {
+	gasFee := new(big.Int).Mul(msg.EffectiveGasPrice(baseFee), new(big.Int).SetUint64(msg.Data.GasLimit))
+	fee := make(sdk.Coins, 1)
+	fee[0] = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewDecFromBigIntWithPrec(gasFee, sdk.Precision))
+	return fee
+}
+
 // To returns the recipient address of the transaction. It returns nil if the
 // transaction is a contract creation.
 func (msg MsgEthereumTx) To() *ethcmn.Address {
@@ -342,8 +462,34 @@ func (msg MsgEthereumTx) GetSignBytes() []byte {
 }
 
 // RLPSignBytes returns the RLP hash of an Ethereum transaction message with a
-// given chainID used for signing.
+// given chainID used for signing. Typed transactions are hashed with their type prefix.
 func (msg MsgEthereumTx) RLPSignBytes(chainID *big.Int) ethcmn.Hash {
+	if msg.Data.Type == ethtypes.AccessListTxType {
+		return prefixedRlpHash(msg.Data.Type, []interface{}{
+			chainID,
+			msg.Data.AccountNonce,
+			msg.Data.Price,
+			msg.Data.GasLimit,
+			msg.Data.Recipient,
+			msg.Data.Amount,
+			msg.Data.Payload,
+			msg.Data.AccessList,
+		})
+	}
+	if msg.Data.Type == ethtypes.DynamicFeeTxType {
+		return prefixedRlpHash(msg.Data.Type, []interface{}{
+			chainID,
+			msg.Data.AccountNonce,
+			msg.Data.GasTipCap,
+			msg.Data.Price,
+			msg.Data.GasLimit,
+			msg.Data.Recipient,
+			msg.Data.Amount,
+			msg.Data.Payload,
+			msg.Data.AccessList,
+		})
+	}
+
 	return rlpHash([]interface{}{
 		msg.Data.AccountNonce,
 		msg.Data.Price,
@@ -368,32 +514,213 @@ func (msg MsgEthereumTx) HomesteadSignHash() ethcmn.Hash {
 	})
 }
 
-// EncodeRLP implements the rlp.Encoder interface.
+// accessListTxData is the RLP layout of the EIP-2930 transaction payload
+type accessListTxData struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *ethcmn.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   ethtypes.AccessList
+
+	// signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// dynamicFeeTxData is the RLP layout of the EIP-1559 transaction payload
+type dynamicFeeTxData struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	GasTipCap    *big.Int
+	GasFeeCap    *big.Int
+	GasLimit     uint64
+	Recipient    *ethcmn.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   ethtypes.AccessList
+
+	// signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// EncodeRLP implements the rlp.Encoder interface. Typed transactions are
+// encoded as an RLP string of their EIP-2718 envelope.
 func (msg *MsgEthereumTx) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &msg.Data)
+	if msg.Data.Type == ethtypes.LegacyTxType {
+		return rlp.Encode(w, &msg.Data)
+	}
+
+	bz, err := msg.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, bz)
 }
 
 // DecodeRLP implements the rlp.Decoder interface.
 func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
-	_, size, err := s.Kind()
+	kind, size, err := s.Kind()
 	if err != nil {
 		// return error if stream is too large
 		return err
 	}
 
-	if err := s.Decode(&msg.Data); err != nil {
-		return err
+	switch kind {
+	case rlp.List:
+		if err := s.Decode(&msg.Data); err != nil {
+			return err
+		}
+		msg.size.Store(ethcmn.StorageSize(rlp.ListSize(size)))
+	case rlp.String:
+		bz, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		if err := msg.decodeTyped(bz); err != nil {
+			return err
+		}
+		msg.size.Store(ethcmn.StorageSize(rlp.ListSize(size)))
+	default:
+		return rlp.ErrExpectedList
+	}
+	return nil
+}
+
+// MarshalBinary returns the canonical encoding of the transaction: the RLP list for
+// legacy transactions and the EIP-2718 envelope (type || rlp payload) for typed ones.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	switch msg.Data.Type {
+	case ethtypes.LegacyTxType:
+		return rlp.EncodeToBytes(&msg.Data)
+	case ethtypes.AccessListTxType:
+		payload, err := rlp.EncodeToBytes(&accessListTxData{
+			ChainID:      msg.Data.ChainID,
+			AccountNonce: msg.Data.AccountNonce,
+			Price:        msg.Data.Price,
+			GasLimit:     msg.Data.GasLimit,
+			Recipient:    msg.Data.Recipient,
+			Amount:       msg.Data.Amount,
+			Payload:      msg.Data.Payload,
+			AccessList:   msg.Data.AccessList,
+			V:            msg.Data.V,
+			R:            msg.Data.R,
+			S:            msg.Data.S,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{msg.Data.Type}, payload...), nil
+	case ethtypes.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes(&dynamicFeeTxData{
+			ChainID:      msg.Data.ChainID,
+			AccountNonce: msg.Data.AccountNonce,
+			GasTipCap:    msg.Data.GasTipCap,
+			GasFeeCap:    msg.Data.Price,
+			GasLimit:     msg.Data.GasLimit,
+			Recipient:    msg.Data.Recipient,
+			Amount:       msg.Data.Amount,
+			Payload:      msg.Data.Payload,
+			AccessList:   msg.Data.AccessList,
+			V:            msg.Data.V,
+			R:            msg.Data.R,
+			S:            msg.Data.S,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{msg.Data.Type}, payload...), nil
+	default:
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+}
+
+// UnmarshalBinary decodes the canonical encoding of the transaction, which is sent
+// by eth_sendRawTransaction.
+func (msg *MsgEthereumTx) UnmarshalBinary(bz []byte) error {
+	if len(bz) > 0 && bz[0] > 0x7f {
+		// legacy transactions are RLP lists
+		if err := rlp.DecodeBytes(bz, &msg.Data); err != nil {
+			return err
+		}
+		msg.size.Store(ethcmn.StorageSize(len(bz)))
+		return nil
 	}
 
-	msg.size.Store(ethcmn.StorageSize(rlp.ListSize(size)))
+	if err := msg.decodeTyped(bz); err != nil {
+		return err
+	}
+	msg.size.Store(ethcmn.StorageSize(len(bz)))
 	return nil
 }
 
+// decodeTyped decodes the EIP-2718 envelope of a typed transaction
+func (msg *MsgEthereumTx) decodeTyped(bz []byte) error {
+	if len(bz) == 0 {
+		return errors.New("typed transaction too short")
+	}
+
+	switch bz[0] {
+	case ethtypes.AccessListTxType:
+		var data accessListTxData
+		if err := rlp.DecodeBytes(bz[1:], &data); err != nil {
+			return err
+		}
+		msg.Data = TxData{
+			AccountNonce: data.AccountNonce,
+			Price:        data.Price,
+			GasLimit:     data.GasLimit,
+			Recipient:    data.Recipient,
+			Amount:       data.Amount,
+			Payload:      data.Payload,
+			V:            data.V,
+			R:            data.R,
+			S:            data.S,
+			Type:         ethtypes.AccessListTxType,
+			ChainID:      data.ChainID,
+			AccessList:   data.AccessList,
+		}
+		return nil
+	case ethtypes.DynamicFeeTxType:
+		var data dynamicFeeTxData
+		if err := rlp.DecodeBytes(bz[1:], &data); err != nil {
+			return err
+		}
+		msg.Data = TxData{
+			AccountNonce: data.AccountNonce,
+			Price:        data.GasFeeCap,
+			GasLimit:     data.GasLimit,
+			Recipient:    data.Recipient,
+			Amount:       data.Amount,
+			Payload:      data.Payload,
+			V:            data.V,
+			R:            data.R,
+			S:            data.S,
+			Type:         ethtypes.DynamicFeeTxType,
+			ChainID:      data.ChainID,
+			AccessList:   data.AccessList,
+			GasTipCap:    data.GasTipCap,
+		}
+		return nil
+	default:
+		return ethtypes.ErrTxTypeNotSupported
+	}
+}
+
 // Sign calculates a secp256k1 ECDSA signature and signs the transaction. It
 // takes a private key and chainID to sign an Ethereum transaction according to
-// EIP155 standard. It mutates the transaction as it populates the V, R, S
-// fields of the Transaction's Signature.
+// EIP155 standard, or EIP-2930 and EIP-1559 for typed transactions. It mutates the
+// transaction as it populates the V, R, S fields of the Transaction's Signature.
 func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
+	if msg.Data.Type != ethtypes.LegacyTxType && (msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0) {
+		return fmt.Errorf("invalid chain id for signer: have %s want %s", msg.Data.ChainID, chainID)
+	}
+
 	txHash := msg.RLPSignBytes(chainID)
 
 	sig, err := ethcrypto.Sign(txHash[:], priv)
@@ -410,7 +737,10 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 
 	var v *big.Int
 
-	if chainID.Sign() == 0 {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		// typed transactions carry the y parity of the signature as V
+		v = big.NewInt(int64(sig[64]))
+	} else if chainID.Sign() == 0 {
 		v = new(big.Int).SetBytes([]byte{sig[64] + 27})
 	} else {
 		v = big.NewInt(int64(sig[64] + 35))
@@ -429,7 +759,15 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 // A derived address is returned upon success or an error if recovery fails.
 func (msg *MsgEthereumTx) VerifySig(chainID *big.Int, height int64, sigCtx sdk.SigCache) (sdk.SigCache, error) {
 	var signer ethtypes.Signer
-	if isProtectedV(msg.Data.V) {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		if msg.Data.Type != ethtypes.AccessListTxType && msg.Data.Type != ethtypes.DynamicFeeTxType {
+			return nil, ethtypes.ErrTxTypeNotSupported
+		}
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0 {
+			return nil, fmt.Errorf("invalid chain id for signer: have %s want %s", msg.Data.ChainID, chainID)
+		}
+		signer = ethtypes.NewLondonSigner(chainID)
+	} else if isProtectedV(msg.Data.V) {
 		signer = ethtypes.NewEIP155Signer(chainID)
 	} else {
 		if sdk.HigherThanMercury(height) {
@@ -458,7 +796,12 @@ func (msg *MsgEthereumTx) VerifySig(chainID *big.Int, height int64, sigCtx sdk.S
 
 	V := new(big.Int)
 	var sigHash ethcmn.Hash
-	if isProtectedV(msg.Data.V) {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		// the y parity of typed transactions is 0 or 1
+		V = new(big.Int).Add(msg.Data.V, big.NewInt(27))
+
+		sigHash = msg.RLPSignBytes(chainID)
+	} else if isProtectedV(msg.Data.V) {
 		// do not allow recovery for transactions with an unprotected chainID
 		if chainID.Sign() == 0 {
 			return nil, errors.New("chainID cannot be zero")
@@ -506,6 +849,9 @@ func (msg MsgEthereumTx) Fee() *big.Int {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (msg *MsgEthereumTx) ChainID() *big.Int {
+	if msg.Data.Type != ethtypes.LegacyTxType {
+		return msg.Data.ChainID
+	}
 	return deriveChainID(msg.Data.V)
 }
 
@@ -578,7 +924,7 @@ func (msg MsgEthereumTx) GetTxInfo(ctx sdk.Context) mempool.ExTxInfo {
 	return exTxInfo
 }
 
-// GetGasPrice return gas price
+// GetGasPrice return gas price, which is the max fee per gas for dynamic fee transactions
 func (msg MsgEthereumTx) GetGasPrice() *big.Int {
 	return msg.Data.Price
 }
@@ -90,6 +90,16 @@ func TestMsgEthermintEncodingAndDecoding(t *testing.T) {
 	require.Equal(t, msg.Price, msg2.Price)
 	require.Equal(t, msg.Payload, msg2.Payload)
 	require.Equal(t, msg.From, msg2.From)
+
+	// access list
+	msg.AccessList = ethtypes.AccessList{{Address: ethcmn.BytesToAddress(addr), StorageKeys: []ethcmn.Hash{{0x1}}}}
+	raw, err = ModuleCdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+
+	var msg3 MsgEthermint
+	v, err := ModuleCdc.UnmarshalBinaryBareWithRegisteredUnmarshaller(raw, &msg3)
+	require.NoError(t, err)
+	require.Equal(t, msg, v.(MsgEthermint))
 }
 
 func newSdkAddress() sdk.AccAddress {
@@ -224,6 +234,156 @@ func TestMsgEthereumTxSig(t *testing.T) {
 	require.Nil(t, signerCache)
 }
 
+func TestMsgEthereumTxAccessList(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+	accessList := ethtypes.AccessList{
+		{Address: to, StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(1)), ethcmn.BigToHash(big.NewInt(2))}},
+	}
+
+	msg := NewMsgEthereumTxWithAccessList(chainID, 1, &to, big.NewInt(10), 100000, big.NewInt(20), []byte("test"), accessList)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, uint8(ethtypes.AccessListTxType), msg.TxType())
+	require.Equal(t, accessList, msg.AccessList())
+
+	// the access list transactions are rejected before the Berlin fork
+	config := DefaultChainConfig()
+	require.Error(t, msg.ValidateTxType(config, 10))
+	config.BerlinBlock = sdk.NewInt(10)
+	require.Error(t, msg.ValidateTxType(config, 9))
+	require.NoError(t, msg.ValidateTxType(config, 10))
+	legacy := NewMsgEthereumTx(1, &to, big.NewInt(10), 100000, big.NewInt(20), []byte("test"))
+	require.NoError(t, legacy.ValidateTxType(DefaultChainConfig(), 10))
+
+	// the chain id of the signer must match the transaction
+	require.Error(t, msg.Sign(big.NewInt(4), priv.ToECDSA()))
+	require.NoError(t, msg.Sign(chainID, priv.ToECDSA()))
+	require.True(t, msg.Data.V.Cmp(big.NewInt(1)) <= 0)
+	require.Equal(t, chainID, msg.ChainID())
+
+	// the envelope is the same as the one of go-ethereum
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.AccessListTx{
+		ChainID:    chainID,
+		Nonce:      1,
+		GasPrice:   big.NewInt(20),
+		Gas:        100000,
+		To:         &to,
+		Value:      big.NewInt(10),
+		Data:       []byte("test"),
+		AccessList: accessList,
+	}), ethtypes.NewEIP2930Signer(chainID), priv.ToECDSA())
+	require.NoError(t, err)
+	expected, err := ethTx.MarshalBinary()
+	require.NoError(t, err)
+	raw, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, expected, raw)
+
+	// raw transaction decoding and sender recovery
+	var decoded MsgEthereumTx
+	require.NoError(t, decoded.UnmarshalBinary(raw))
+	require.Equal(t, msg.Data, decoded.Data)
+	signerCache, err := decoded.VerifySig(chainID, 0, sdk.EmptyContext().SigCache())
+	require.NoError(t, err)
+	require.Equal(t, from, signerCache.GetFrom())
+
+	_, err = decoded.VerifySig(big.NewInt(4), 0, nil)
+	require.Error(t, err)
+
+	// typed transactions are wrapped as RLP strings
+	bz, err := rlp.EncodeToBytes(&msg)
+	require.NoError(t, err)
+	var rlpDecoded MsgEthereumTx
+	require.NoError(t, rlp.DecodeBytes(bz, &rlpDecoded))
+	require.Equal(t, msg.Data, rlpDecoded.Data)
+
+	// amino encoding keeps the typed transaction fields
+	aminoBz, err := ModuleCdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+	var aminoDecoded MsgEthereumTx
+	v, err := ModuleCdc.UnmarshalBinaryBareWithRegisteredUnmarshaller(aminoBz, &aminoDecoded)
+	require.NoError(t, err)
+	require.Equal(t, msg.Data, v.(MsgEthereumTx).Data)
+
+	// unsupported transaction type
+	require.Error(t, decoded.UnmarshalBinary(append([]byte{ethtypes.DynamicFeeTxType + 1}, raw[1:]...)))
+	msg.Data.Type = ethtypes.DynamicFeeTxType + 1
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgEthereumTxDynamicFee(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+	accessList := ethtypes.AccessList{{Address: to, StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(1))}}}
+
+	msg := NewMsgEthereumTxWithDynamicFee(chainID, 1, &to, big.NewInt(10), 100000, big.NewInt(2), big.NewInt(20), []byte("test"), accessList)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, uint8(ethtypes.DynamicFeeTxType), msg.TxType())
+	require.Equal(t, big.NewInt(2), msg.GasTipCap())
+
+	// the dynamic fee transactions are rejected before the London fork
+	config := DefaultChainConfig()
+	config.BerlinBlock = sdk.NewInt(0)
+	require.Error(t, msg.ValidateTxType(config, 10))
+	config.LondonBlock = sdk.NewInt(10)
+	require.NoError(t, msg.ValidateTxType(config, 10))
+	require.NoError(t, msg.Sign(chainID, priv.ToECDSA()))
+
+	// the envelope is the same as the one of go-ethereum
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      1,
+		GasTipCap:  big.NewInt(2),
+		GasFeeCap:  big.NewInt(20),
+		Gas:        100000,
+		To:         &to,
+		Value:      big.NewInt(10),
+		Data:       []byte("test"),
+		AccessList: accessList,
+	}), ethtypes.NewLondonSigner(chainID), priv.ToECDSA())
+	require.NoError(t, err)
+	expected, err := ethTx.MarshalBinary()
+	require.NoError(t, err)
+	raw, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, expected, raw)
+
+	var decoded MsgEthereumTx
+	require.NoError(t, decoded.UnmarshalBinary(raw))
+	require.Equal(t, msg.Data, decoded.Data)
+	signerCache, err := decoded.VerifySig(chainID, 0, sdk.EmptyContext().SigCache())
+	require.NoError(t, err)
+	require.Equal(t, from, signerCache.GetFrom())
+
+	aminoBz, err := ModuleCdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+	var aminoDecoded MsgEthereumTx
+	v, err := ModuleCdc.UnmarshalBinaryBareWithRegisteredUnmarshaller(aminoBz, &aminoDecoded)
+	require.NoError(t, err)
+	require.Equal(t, msg.Data, v.(MsgEthereumTx).Data)
+
+	// the effective gas price is min(baseFee + maxPriorityFeePerGas, maxFeePerGas)
+	require.Equal(t, big.NewInt(20), msg.EffectiveGasPrice(nil))
+	require.Equal(t, big.NewInt(12), msg.EffectiveGasPrice(big.NewInt(10)))
+	require.Equal(t, big.NewInt(20), msg.EffectiveGasPrice(big.NewInt(19)))
+	require.Equal(t, sdk.NewDecFromBigIntWithPrec(big.NewInt(1200000), sdk.Precision),
+		msg.GetEffectiveFee(big.NewInt(10)).AmountOf(sdk.DefaultBondDenom))
+
+	// the legacy transactions pay the gas price whatever the base fee is
+	legacy := NewMsgEthereumTx(1, &to, big.NewInt(10), 100000, big.NewInt(20), []byte("test"))
+	require.Equal(t, big.NewInt(20), legacy.EffectiveGasPrice(big.NewInt(10)))
+
+	// maxPriorityFeePerGas must not exceed maxFeePerGas
+	msg = NewMsgEthereumTxWithDynamicFee(chainID, 1, &to, big.NewInt(10), 100000, big.NewInt(21), big.NewInt(20), []byte("test"), nil)
+	require.Error(t, msg.ValidateBasic())
+	msg = NewMsgEthereumTxWithDynamicFee(chainID, 1, &to, big.NewInt(10), 100000, big.NewInt(-1), big.NewInt(20), []byte("test"), nil)
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgEthereumTx_ChainID(t *testing.T) {
 	chainID := big.NewInt(3)
 	priv, _ := ethsecp256k1.GenerateKey()
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// addresses of the native contracts
+var (
+	NativeTokenContractAddress   = ethcmn.HexToAddress("0x0000000000000000000000000000000000001000")
+	NativeStakingContractAddress = ethcmn.HexToAddress("0x0000000000000000000000000000000000001001")
+	NativeSwapContractAddress    = ethcmn.HexToAddress("0x0000000000000000000000000000000000001002")
+)
+
+var (
+	errNativeWriteStatic = errors.New("native contract method not allowed in static call")
+	errNativeValue       = errors.New("native contract method is not payable")
+)
+
+// NativeContract is a precompiled contract at a fixed address whose methods are implemented by the native
+// modules. The methods are called with the standard ABI encoding.
+type NativeContract interface {
+	// RequiredGas returns the gas of calling the method with the input
+	RequiredGas(input []byte) uint64
+	// Run executes the method with the input in the context of the call
+	Run(call *NativeCall, input []byte) ([]byte, error)
+}
+
+// NativeCall is the context of a message call to a native contract
+type NativeCall struct {
+	Csdb   *CommitStateDB
+	Caller ethcmn.Address
+	Value  *big.Int
+	// ReadOnly is set if the native contract is called by STATICCALL
+	ReadOnly bool
+
+	// contract is the native contract called, which is one of the native contracts of the state db
+	contract NativeContract
+}
+
+// nativeContracts is the registry of the native contracts by their addresses, from which the native contracts
+// of every state transition are taken, see NativeContracts
+var (
+	nativeContractsMtx sync.RWMutex
+	nativeContracts    = make(map[ethcmn.Address]NativeContract)
+)
+
+// nativeCalls holds the pending calls to the native contracts. The precompiled contracts of go-ethereum are
+// only given the input, so the call is prepared by the contract verifier of the evm with the native contract
+// of its own state db, which is invoked with the same input right before running the precompiled contract,
+// and keyed by the input. The calls are removed once they're run or the state transition is done.
+var nativeCalls sync.Map
+
+// RegisterNativeContract registers the native contract at the address, the registered one is replaced if
+// the address is registered already. The native contracts are only called after they're enabled by the
+// chain config, see ChainConfig.IsNativeContracts.
+func RegisterNativeContract(addr ethcmn.Address, contract NativeContract) {
+	nativeContractsMtx.Lock()
+	defer nativeContractsMtx.Unlock()
+
+	if _, registered := nativeContracts[addr]; !registered {
+		installNativePrecompile(addr)
+	}
+	nativeContracts[addr] = contract
+}
+
+// installNativePrecompile makes go-ethereum dispatch the calls to the address to the native contracts.
+// go-ethereum v1.10.8 only looks up the precompiled contracts in its package tables and can't be given the
+// precompiled contracts of an evm, so a stateless dispatcher is installed there once for the address. It only
+// runs the call prepared by the evm for the native contract of its state db, and is inert otherwise: it
+// requires no gas and returns nothing, which is the same as calling an account without code.
+func installNativePrecompile(addr ethcmn.Address) {
+	precompile := nativePrecompile{}
+	vm.PrecompiledContractsHomestead[addr] = precompile
+	vm.PrecompiledContractsByzantium[addr] = precompile
+	vm.PrecompiledContractsIstanbul[addr] = precompile
+	vm.PrecompiledContractsBerlin[addr] = precompile
+}
+
+// NativeContractAddresses returns the addresses of the registered native contracts in ascending order
+func NativeContractAddresses() []ethcmn.Address {
+	nativeContractsMtx.RLock()
+	defer nativeContractsMtx.RUnlock()
+
+	addrs := make([]ethcmn.Address, 0, len(nativeContracts))
+	for addr := range nativeContracts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
+
+// NativeContracts returns the native contracts called by the evm at the height by their addresses, which is
+// nil if the native contracts are not enabled at the height
+func NativeContracts(config ChainConfig, height int64) map[ethcmn.Address]NativeContract {
+	if !config.IsNativeContracts(height) {
+		return nil
+	}
+
+	nativeContractsMtx.RLock()
+	defer nativeContractsMtx.RUnlock()
+
+	contracts := make(map[ethcmn.Address]NativeContract, len(nativeContracts))
+	for addr, contract := range nativeContracts {
+		contracts[addr] = contract
+	}
+	return contracts
+}
+
+// ActivePrecompiles returns the addresses of the precompiled contracts warmed by the access list, which
+// includes the native contracts if they're enabled at the height
+func ActivePrecompiles(config ChainConfig, rules params.Rules, height int64) []ethcmn.Address {
+	precompiles := vm.ActivePrecompiles(rules)
+	if config.IsNativeContracts(height) {
+		precompiles = append(precompiles, NativeContractAddresses()...)
+	}
+	return precompiles
+}
+
+// prepareNativeCall saves the call to the native contract which is picked up by the precompiled contract.
+// DELEGATECALL and CALLCODE are not allowed since the native contracts have no code to run in the context
+// of the caller.
+func prepareNativeCall(csdb *CommitStateDB, contract NativeContract, op vm.OpCode, from ethcmn.Address,
+	input []byte, value *big.Int) error {
+	if op != vm.CALL && op != vm.STATICCALL {
+		return fmt.Errorf("%s to native contract is not allowed", op)
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	nativeCalls.Store(&input[0], &NativeCall{
+		Csdb:     csdb,
+		Caller:   from,
+		Value:    value,
+		ReadOnly: op == vm.STATICCALL,
+		contract: contract,
+	})
+	csdb.nativeCallKeys = append(csdb.nativeCallKeys, &input[0])
+	return nil
+}
+
+// nativePrecompile is the precompiled contract dispatching the prepared calls to the native contracts
+type nativePrecompile struct{}
+
+func (nativePrecompile) RequiredGas(input []byte) uint64 {
+	if len(input) == 0 {
+		return 0
+	}
+	call, ok := nativeCalls.Load(&input[0])
+	if !ok {
+		return 0
+	}
+	return call.(*NativeCall).contract.RequiredGas(input)
+}
+
+func (nativePrecompile) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	call, ok := nativeCalls.LoadAndDelete(&input[0])
+	if !ok {
+		// the native contract is not one of the state db
+		return nil, nil
+	}
+
+	nativeCall := call.(*NativeCall)
+	ret, err := nativeCall.contract.Run(nativeCall, input)
+	if err != nil {
+		// the error is returned as the revert reason, so the gas left is not consumed
+		return nativeRevertReason(err), vm.ErrExecutionReverted
+	}
+	return ret, nil
+}
+
+// NativeABI is the ABI of a native contract with the gas of its methods
+type NativeABI struct {
+	abi.ABI
+	gas map[string]uint64
+}
+
+// MustNativeABI parses the ABI of a native contract, and panics if it is invalid
+func MustNativeABI(def string, gas map[string]uint64) NativeABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(err)
+	}
+	return NativeABI{ABI: parsed, gas: gas}
+}
+
+// RequiredGas returns the gas of the method called by the input, zero is returned for an unknown method
+// which fails when it's run
+func (a NativeABI) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := a.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	return a.gas[method.Name]
+}
+
+// Unpack returns the method called by the input and its arguments. The methods are not payable, and only
+// the constant ones are allowed in a static call.
+func (a NativeABI) Unpack(call *NativeCall, input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, errors.New("invalid input of native contract")
+	}
+	method, err := a.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	if call.Value != nil && call.Value.Sign() != 0 {
+		return nil, nil, errNativeValue
+	}
+	if call.ReadOnly && !method.IsConstant() {
+		return nil, nil, errNativeWriteStatic
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// nativeRevertReason encodes the error as the revert reason of solidity, Error(string)
+func nativeRevertReason(err error) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	reason, _ := abi.Arguments{{Type: stringType}}.Pack(err.Error())
+	return append(ethcmn.Hex2Bytes("08c379a0"), reason...)
+}
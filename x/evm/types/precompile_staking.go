@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+const nativeStakingABI = `[
+	{"type":"function","name":"deposit","stateMutability":"nonpayable",
+		"inputs":[{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable",
+		"inputs":[{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"addShares","stateMutability":"nonpayable",
+		"inputs":[{"name":"validators","type":"string[]"}],
+		"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var nativeStaking = MustNativeABI(nativeStakingABI, map[string]uint64{
+	"deposit":   50000,
+	"withdraw":  50000,
+	"addShares": 50000,
+})
+
+// NativeStakingContract is the native contract depositing, withdrawing and adding shares to validators
+// for the caller by the messages of the staking module
+type NativeStakingContract struct {
+	handler sdk.Handler
+}
+
+var _ NativeContract = NativeStakingContract{}
+
+// NewNativeStakingContract creates the native staking contract with the handler of the staking module
+func NewNativeStakingContract(handler sdk.Handler) NativeStakingContract {
+	return NativeStakingContract{handler: handler}
+}
+
+func (c NativeStakingContract) RequiredGas(input []byte) uint64 {
+	return nativeStaking.RequiredGas(input)
+}
+
+func (c NativeStakingContract) Run(call *NativeCall, input []byte) ([]byte, error) {
+	method, args, err := nativeStaking.Unpack(call, input)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.AccAddress(call.Caller.Bytes())
+	var msg sdk.Msg
+	switch method.Name {
+	case "deposit", "withdraw":
+		coin, err := NativeCoin(sdk.DefaultBondDenom, args[0].(*big.Int))
+		if err != nil {
+			return nil, err
+		}
+		if method.Name == "deposit" {
+			msg = stakingtypes.NewMsgDeposit(delegator, coin)
+		} else {
+			msg = stakingtypes.NewMsgWithdraw(delegator, coin)
+		}
+	case "addShares":
+		validators := args[0].([]string)
+		valAddrs := make([]sdk.ValAddress, len(validators))
+		for i, validator := range validators {
+			if valAddrs[i], err = sdk.ValAddressFromBech32(validator); err != nil {
+				return nil, err
+			}
+		}
+		msg = stakingtypes.NewMsgAddShares(delegator, valAddrs)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+
+	if _, err := call.Csdb.ExecuteNativeMsg(c.handler, msg, call.Caller); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	ammswaptypes "github.com/okex/exchain/x/ammswap/types"
+)
+
+const nativeSwapABI = `[
+	{"type":"function","name":"swap","stateMutability":"nonpayable",
+		"inputs":[{"name":"soldDenom","type":"string"},{"name":"soldAmount","type":"uint256"},
+			{"name":"boughtDenom","type":"string"},{"name":"minBoughtAmount","type":"uint256"},
+			{"name":"deadline","type":"uint256"}],
+		"outputs":[{"name":"boughtAmount","type":"uint256"}]}
+]`
+
+var nativeSwap = MustNativeABI(nativeSwapABI, map[string]uint64{
+	"swap": 80000,
+})
+
+// NativeSwapContract is the native contract swapping the tokens of the caller by the messages of the
+// ammswap module
+type NativeSwapContract struct {
+	handler sdk.Handler
+}
+
+var _ NativeContract = NativeSwapContract{}
+
+// NewNativeSwapContract creates the native swap contract with the handler of the ammswap module
+func NewNativeSwapContract(handler sdk.Handler) NativeSwapContract {
+	return NativeSwapContract{handler: handler}
+}
+
+func (c NativeSwapContract) RequiredGas(input []byte) uint64 {
+	return nativeSwap.RequiredGas(input)
+}
+
+func (c NativeSwapContract) Run(call *NativeCall, input []byte) ([]byte, error) {
+	method, args, err := nativeSwap.Unpack(call, input)
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != "swap" {
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+
+	soldDenom, boughtDenom := args[0].(string), args[2].(string)
+	soldCoin, err := NativeCoin(soldDenom, args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	minBought := args[3].(*big.Int)
+	if err := sdk.ValidateDenom(boughtDenom); err != nil || minBought.BitLen() > 255 {
+		return nil, fmt.Errorf("invalid min bought amount %s%s", minBought, boughtDenom)
+	}
+	minBoughtCoin := sdk.NewDecCoinFromDec(boughtDenom, sdk.NewDecFromBigIntWithPrec(minBought, sdk.Precision))
+	deadline := args[4].(*big.Int)
+	if !deadline.IsInt64() {
+		return nil, fmt.Errorf("invalid deadline %s", deadline)
+	}
+
+	trader := sdk.AccAddress(call.Caller.Bytes())
+	msg := ammswaptypes.NewMsgTokenToToken(soldCoin, minBoughtCoin, deadline.Int64(), trader, trader)
+	before := call.Csdb.GetCoinBalance(call.Caller, boughtDenom)
+	if _, err := call.Csdb.ExecuteNativeMsg(c.handler, msg, call.Caller); err != nil {
+		return nil, err
+	}
+	bought := new(big.Int).Sub(call.Csdb.GetCoinBalance(call.Caller, boughtDenom), before)
+	return method.Outputs.Pack(bought)
+}
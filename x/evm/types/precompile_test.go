@@ -0,0 +1,176 @@
+package types_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const testNativeABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"deposit","stateMutability":"nonpayable",
+		"inputs":[{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// nativeForwarderCode returns the code forwarding its call data to the native contract, which reverts after
+// the call if revert is set
+func nativeForwarderCode(native ethcmn.Address, revert bool) []byte {
+	code := "0x366000600037" + "600060003660006000" + "73" + native.Hex()[2:] + "5af150"
+	if revert {
+		return hexutil.MustDecode(code + "60006000fd")
+	}
+	return hexutil.MustDecode(code + "00")
+}
+
+// nativeChainConfig returns the chain config which enables the native contracts at the height
+func nativeChainConfig(height int64) types.ChainConfig {
+	config := types.DefaultChainConfig()
+	config.NativeContractsBlock = sdk.NewInt(height)
+	return config
+}
+
+func (suite *StateDBTestSuite) transitNative(to ethcmn.Address, nonce uint64, input []byte) (*types.ResultData, error) {
+	return suite.transitNativeWithConfig(to, nonce, input, nativeChainConfig(0))
+}
+
+func (suite *StateDBTestSuite) transitNativeWithConfig(to ethcmn.Address, nonce uint64, input []byte,
+	config types.ChainConfig) (*types.ResultData, error) {
+	st := types.StateTransition{
+		AccountNonce: nonce,
+		Price:        sdk.NewDec(10).BigInt(),
+		GasLimit:     1000000,
+		Recipient:    &to,
+		Amount:       big.NewInt(0),
+		Payload:      input,
+		ChainID:      big.NewInt(1),
+		Csdb:         suite.stateDB,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.address,
+		Simulate:     suite.ctx.IsCheckTx(),
+	}
+	_, resData, err, _, _ := st.TransitionDb(suite.ctx, config)
+	return resData, err
+}
+
+func (suite *StateDBTestSuite) TestNativeTokenContract() {
+	nativeABI, err := abi.JSON(strings.NewReader(testNativeABI))
+	suite.Require().NoError(err)
+
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000)), sdk.NewDecCoin("xxb", sdk.NewInt(100))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+
+	recipient := ethcmn.HexToAddress("0x1111111111111111111111111111111111111111")
+	forwarder := ethcmn.HexToAddress("0x2222222222222222222222222222222222222222")
+	suite.stateDB.SetCode(forwarder, nativeForwarderCode(types.NativeTokenContractAddress, true))
+	suite.stateDB.SetCoinBalance(forwarder, "xxb", sdk.NewDec(50).BigInt())
+	_, err = suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	// the native contracts are called as the accounts without code before they're enabled
+	input, err := nativeABI.Pack("transfer", recipient, "xxb", sdk.NewDec(30).BigInt())
+	suite.Require().NoError(err)
+	resData, err := suite.transitNativeWithConfig(forwarder, 0, input, nativeChainConfig(suite.ctx.BlockHeight()+1))
+	suite.Require().Error(err)
+	suite.Require().Empty(resData)
+	resData, err = suite.transitNativeWithConfig(types.NativeTokenContractAddress, 0, input, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Empty(resData.Ret)
+	suite.Require().Equal(sdk.NewDec(100), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf("xxb"))
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, recipient.Bytes()))
+
+	// transfer 30xxb to the recipient
+	_, err = suite.transitNative(types.NativeTokenContractAddress, 1, input)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(70), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf("xxb"))
+	suite.Require().Equal(sdk.NewDec(30), suite.app.AccountKeeper.GetAccount(suite.ctx, recipient.Bytes()).GetCoins().AmountOf("xxb"))
+
+	input, err = nativeABI.Pack("balanceOf", recipient, "xxb")
+	suite.Require().NoError(err)
+	resData, err = suite.transitNative(types.NativeTokenContractAddress, 2, input)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), new(big.Int).SetBytes(resData.Ret))
+
+	// insufficient balance
+	input, err = nativeABI.Pack("transfer", recipient, "xxb", sdk.NewDec(71).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transitNative(types.NativeTokenContractAddress, 3, input)
+	suite.Require().Error(err)
+
+	// the module accounts are not allowed to receive the tokens
+	feeCollector := ethcmn.BytesToAddress(suite.app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName))
+	input, err = nativeABI.Pack("transfer", feeCollector, "xxb", sdk.NewDec(1).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transitNative(types.NativeTokenContractAddress, 3, input)
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(70), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf("xxb"))
+
+	// the transfer of the forwarder is reverted with the call
+	input, err = nativeABI.Pack("transfer", recipient, "xxb", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transitNative(forwarder, 4, input)
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(50), suite.app.AccountKeeper.GetAccount(suite.ctx, forwarder.Bytes()).GetCoins().AmountOf("xxb"))
+	suite.Require().Equal(sdk.NewDec(30), suite.app.AccountKeeper.GetAccount(suite.ctx, recipient.Bytes()).GetCoins().AmountOf("xxb"))
+}
+
+func (suite *StateDBTestSuite) TestNativeStakingContract() {
+	nativeABI, err := abi.JSON(strings.NewReader(testNativeABI))
+	suite.Require().NoError(err)
+
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+
+	forwarder := ethcmn.HexToAddress("0x1111111111111111111111111111111111111111")
+	reverter := ethcmn.HexToAddress("0x2222222222222222222222222222222222222222")
+	suite.stateDB.SetCode(forwarder, nativeForwarderCode(types.NativeStakingContractAddress, false))
+	suite.stateDB.SetCode(reverter, nativeForwarderCode(types.NativeStakingContractAddress, true))
+	suite.stateDB.SetBalance(forwarder, sdk.NewDec(100).BigInt())
+	suite.stateDB.SetBalance(reverter, sdk.NewDec(100).BigInt())
+	_, err = suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	input, err := nativeABI.Pack("deposit", sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+
+	// deposit of the sender
+	_, err = suite.transitNative(types.NativeStakingContractAddress, 0, input)
+	suite.Require().NoError(err)
+	delegator, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), delegator.Tokens)
+	suite.Require().Equal(sdk.NewDec(4990), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf(sdk.DefaultBondDenom))
+
+	// deposit of the contract
+	_, err = suite.transitNative(forwarder, 1, input)
+	suite.Require().NoError(err)
+	delegator, found = suite.app.StakingKeeper.GetDelegator(suite.ctx, forwarder.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), delegator.Tokens)
+	suite.Require().Equal(sdk.NewDec(90), suite.app.AccountKeeper.GetAccount(suite.ctx, forwarder.Bytes()).GetCoins().AmountOf(sdk.DefaultBondDenom))
+
+	// the deposit is reverted with the call
+	_, err = suite.transitNative(reverter, 2, input)
+	suite.Require().Error(err)
+	_, found = suite.app.StakingKeeper.GetDelegator(suite.ctx, reverter.Bytes())
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewDec(100), suite.app.AccountKeeper.GetAccount(suite.ctx, reverter.Bytes()).GetCoins().AmountOf(sdk.DefaultBondDenom))
+}
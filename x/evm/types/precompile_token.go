@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tokentypes "github.com/okex/exchain/x/token/types"
+)
+
+const nativeTokenABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var nativeToken = MustNativeABI(nativeTokenABI, map[string]uint64{
+	"balanceOf": 2000,
+	"transfer":  20000,
+})
+
+// NativeTokenContract is the native contract of the balances and transfers of the native tokens. The amounts
+// of the tokens are in the precision of the evm, which has 18 decimals. The transfers are executed by the send
+// messages of the token module, so they're restricted as the ones sent by the transactions.
+type NativeTokenContract struct {
+	handler sdk.Handler
+}
+
+var _ NativeContract = NativeTokenContract{}
+
+// NewNativeTokenContract creates the native token contract with the handler of the token module
+func NewNativeTokenContract(handler sdk.Handler) NativeTokenContract {
+	return NativeTokenContract{handler: handler}
+}
+
+func (c NativeTokenContract) RequiredGas(input []byte) uint64 {
+	return nativeToken.RequiredGas(input)
+}
+
+func (c NativeTokenContract) Run(call *NativeCall, input []byte) ([]byte, error) {
+	method, args, err := nativeToken.Unpack(call, input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		account, denom := args[0].(ethcmn.Address), args[1].(string)
+		return method.Outputs.Pack(call.Csdb.GetCoinBalance(account, denom))
+	case "transfer":
+		to, denom, amount := args[0].(ethcmn.Address), args[1].(string), args[2].(*big.Int)
+		coin, err := NativeCoin(denom, amount)
+		if err != nil {
+			return nil, err
+		}
+		msg := tokentypes.NewMsgTokenSend(call.Caller.Bytes(), to.Bytes(), sdk.SysCoins{coin})
+		if _, err := call.Csdb.ExecuteNativeMsg(c.handler, msg, call.Caller, to); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+// NativeCoin returns the coin of the positive amount in the precision of the evm
+func NativeCoin(denom string, amount *big.Int) (sdk.SysCoin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.SysCoin{}, err
+	}
+	if amount.Sign() <= 0 || amount.BitLen() > 255 {
+		return sdk.SysCoin{}, fmt.Errorf("invalid amount %s", amount)
+	}
+	return sdk.NewDecCoinFromDec(denom, sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision)), nil
+}
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 )
 
 // Supported endpoints
@@ -26,8 +31,15 @@ const (
 	QueryContractDeploymentWhitelist = "contract-deployment-whitelist"
 	QueryContractBlockedList         = "contract-blocked-list"
 	QueryContractMethodBlockedList   = "contract-method-blocked-list"
+	QueryCreateAccessList            = "createAccessList"
+	QueryBaseFee                     = "baseFee"
+	QueryTraceTxs                    = "traceTxs"
+	QueryTraceCall                   = "traceCall"
 )
 
+// FlagDebugAPI enables the debug_ prefixed set of web3 APIs, the trace queries are only served if it's set
+const FlagDebugAPI = "debug-api"
+
 // QueryResBalance is response type for balance query
 type QueryResBalance struct {
 	Balance string `json:"balance"`
@@ -105,3 +117,55 @@ type QueryResAccount struct {
 }
 
 type QueryResExportAccount = GenesisAccount
+
+// QueryResAccessList is response type for the access list creation query
+type QueryResAccessList struct {
+	AccessList ethtypes.AccessList `json:"accessList"`
+	GasUsed    uint64              `json:"gasUsed"`
+	Error      string              `json:"error,omitempty"`
+}
+
+// QueryResBaseFee is response type for base fee query, the base fee is nil before the London fork
+type QueryResBaseFee struct {
+	BaseFee *hexutil.Big `json:"baseFee"`
+}
+
+// TraceConfig holds the options of the debug tracers. The struct logger is used if no tracer is specified,
+// otherwise the tracer is either the name of a built-in tracer such as callTracer and prestateTracer or
+// the code of a javascript tracer.
+type TraceConfig struct {
+	*vm.LogConfig
+	Tracer  *string `json:"tracer"`
+	Timeout *string `json:"timeout"`
+}
+
+// TraceTx is an evm transaction of a block to be replayed for tracing
+type TraceTx struct {
+	Tx   hexutil.Bytes `json:"tx"` // ethereum encoded transaction
+	Hash common.Hash   `json:"hash"`
+}
+
+// QueryTraceTxsParams defines the params for the query to trace the evm transactions of a block.
+// The transactions before TraceFrom are executed without tracing to prepare the state of the traced ones.
+type QueryTraceTxsParams struct {
+	Txs         []TraceTx    `json:"txs"`
+	TraceFrom   int          `json:"traceFrom"`
+	BlockHeight int64        `json:"blockHeight"`
+	BlockTime   time.Time    `json:"blockTime"`
+	BlockHash   common.Hash  `json:"blockHash"`
+	TraceConfig *TraceConfig `json:"traceConfig"`
+}
+
+// QueryTraceCallParams defines the params for the query to trace a call on top of a block
+type QueryTraceCallParams struct {
+	Msg         MsgEthermint `json:"msg"`
+	BlockHeight int64        `json:"blockHeight"`
+	BlockTime   time.Time    `json:"blockTime"`
+	TraceConfig *TraceConfig `json:"traceConfig"`
+}
+
+// QueryResTxTrace is response type for the trace of a transaction
+type QueryResTxTrace struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
@@ -252,6 +252,17 @@ func (so *stateObject) SetBalance(amount *big.Int) {
 	so.setBalance(sdk.DefaultBondDenom, amt)
 }
 
+// SetCoinBalance sets the state object's balance of the denom.
+func (so *stateObject) SetCoinBalance(denom string, amount sdk.Dec) {
+	so.stateDB.journal.append(coinBalanceChange{
+		account: &so.address,
+		denom:   denom,
+		prev:    so.account.GetCoins().AmountOf(denom),
+	})
+
+	so.setBalance(denom, amount)
+}
+
 func (so *stateObject) setBalance(denom string, amount sdk.Dec) {
 	so.account.SetBalance(denom, amount)
 }
@@ -471,13 +482,15 @@ func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 	return newStateObj
 }
 
-// empty returns whether the account is considered empty.
+// empty returns whether the account is considered empty. After the native contracts are enabled, the account
+// holding the native tokens is not empty even if it has no balance of the evm denom.
 func (so *stateObject) empty() bool {
 	balace := so.account.Balance(sdk.DefaultBondDenom)
 	return so.account == nil ||
 		(so.account != nil &&
 			so.account.Sequence == 0 &&
 			(balace.BigInt() == nil || balace.IsZero()) &&
+			(so.stateDB.nativeContracts == nil || so.account.GetCoins().IsZero()) &&
 			bytes.Equal(so.account.CodeHash, emptyCodeHash))
 }
 
@@ -26,12 +26,19 @@ type StateTransition struct {
 	Recipient    *common.Address
 	Amount       *big.Int
 	Payload      []byte
+	AccessList   ethtypes.AccessList
+
+	// BaseFee is the base fee of the block after the London fork, nil means zero
+	BaseFee *big.Int
 
 	ChainID  *big.Int
 	Csdb     *CommitStateDB
 	TxHash   *common.Hash
 	Sender   common.Address
 	Simulate bool // i.e CheckTx execution
+
+	// Tracer replaces the default struct logger of the evm if it is set
+	Tracer vm.Tracer
 }
 
 // GasInfo returns the gas limit, gas consumed and gas refunded from the EVM transition
@@ -92,6 +99,10 @@ func (st StateTransition) newEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		GasLimit:    gasLimit,
+		BaseFee:     new(big.Int),
+	}
+	if st.BaseFee != nil {
+		blockCtx.BaseFee.Set(st.BaseFee)
 	}
 
 	txCtx := vm.TxContext{
@@ -105,7 +116,7 @@ func (st StateTransition) newEVM(
 // TransitionDb will transition the state by applying the current transaction and
 // returning the evm execution result.
 // NOTE: State transition checks are run during AnteHandler execution.
-func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs, erc20Contracts interface{}) {
+func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs []*InnerTx, erc20Contracts interface{}) {
 	defer func() {
 		if e := recover(); e != nil {
 			// if the msg recovered can be asserted into type 'ErrContractBlockedVerify', it must be captured by the panics of blocked
@@ -121,7 +132,7 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 
 	contractCreation := st.Recipient == nil
 
-	cost, err := core.IntrinsicGas(st.Payload, []ethtypes.AccessTuple{}, contractCreation, config.IsHomestead(), config.IsIstanbul())
+	cost, err := core.IntrinsicGas(st.Payload, st.AccessList, contractCreation, config.IsHomestead(), config.IsIstanbul())
 	if err != nil {
 		return exeRes, resData, sdkerrors.Wrap(err, "invalid intrinsic gas for transaction"), innerTxs, erc20Contracts
 	}
@@ -141,6 +152,8 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	evmGasMeter := sdk.NewInfiniteGasMeter()
 	ctx = ctx.WithGasMeter(evmGasMeter)
 	csdb := st.Csdb.WithContext(ctx)
+	csdb.nativeContracts = NativeContracts(config, ctx.BlockHeight())
+	defer csdb.clearNativeCalls()
 
 	StartTxLog := func(tag string) {
 		if !ctx.IsCheckTx() {
@@ -163,6 +176,10 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		to = st.Recipient.String()
 	}
 	enableDebug := checkTracesSegment(ctx.BlockHeight(), st.Sender.String(), to)
+	if st.Tracer != nil {
+		tracer = st.Tracer
+		enableDebug = true
+	}
 
 	vmConfig := vm.Config{
 		ExtraEips:  params.ExtraEIPs,
@@ -171,8 +188,25 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		ContractVerifier: NewContractVerifier(params),
 	}
 
+	// the inner txs of the delivered transactions are recorded by the tracer wrapping the one for debugging
+	var recorder *innerTxRecorder
+	if !st.Simulate && IsInnerTxEnabled() {
+		if enableDebug {
+			recorder = newInnerTxRecorder(tracer)
+		} else {
+			recorder = newInnerTxRecorder(nil)
+		}
+		vmConfig.Debug = true
+		vmConfig.Tracer = recorder
+	}
+
 	evm := st.newEVM(ctx, csdb, gasLimit, st.Price, config, vmConfig)
 
+	// the access list only takes effect after EIP-2929 is activated by the berlin fork
+	if rules := evm.ChainConfig().Rules(evm.Context.BlockNumber); rules.IsBerlin {
+		csdb.PrepareAccessList(st.Sender, st.Recipient, ActivePrecompiles(config, rules, ctx.BlockHeight()), st.AccessList)
+	}
+
 	var (
 		ret             []byte
 		leftOverGas     uint64
@@ -186,9 +220,6 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	// Set nonce of sender account before evm state transition for usage in generating Create address
 	csdb.SetNonce(st.Sender, st.AccountNonce)
 
-	//add InnerTx
-	callTx := addDefaultInnerTx(evm, st.Sender.String())
-
 	// create contract or execute call
 	switch contractCreation {
 	case true:
@@ -206,8 +237,6 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		defer StopTxLog(analyzer.EVMCORE)
 		ret, contractAddress, leftOverGas, err = evm.Create(senderRef, st.Payload, gasLimit, st.Amount)
 		recipientLog = fmt.Sprintf("contract address %s", contractAddress.String())
-
-		updateDefaultInnerTxTo(callTx, contractAddress.String())
 	default:
 		if !params.EnableCall {
 			return exeRes, resData, ErrCallDisabled, innerTxs, erc20Contracts
@@ -220,13 +249,13 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		ret, leftOverGas, err = evm.Call(senderRef, *st.Recipient, st.Payload, gasLimit, st.Amount)
 
 		recipientLog = fmt.Sprintf("recipient address %s", st.Recipient.String())
-
-		updateDefaultInnerTxTo(callTx, st.Recipient.String())
 	}
 
 	gasConsumed := gasLimit - leftOverGas
 
-	innerTxs, erc20Contracts = parseInnerTxAndContract(evm, err != nil)
+	if recorder != nil {
+		innerTxs = recorder.InnerTxs()
+	}
 
 	defer func() {
 		// Consume gas from evm execution
@@ -235,7 +264,7 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	}()
 
 	defer func() {
-		if !st.Simulate && enableDebug {
+		if !st.Simulate && enableDebug && st.Tracer == nil {
 			result := &core.ExecutionResult{
 				UsedGas:    gasConsumed,
 				Err:        err,
@@ -1,11 +1,182 @@
 package types
 
-func addDefaultInnerTx(...interface{}) interface{} {
-	return nil
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// call types of the internal transactions
+const (
+	InnerTxCallTypeCall         = "call"
+	InnerTxCallTypeCallCode     = "callcode"
+	InnerTxCallTypeDelegateCall = "delegatecall"
+	InnerTxCallTypeStaticCall   = "staticcall"
+	InnerTxCallTypeCreate       = "create"
+	InnerTxCallTypeCreate2      = "create2"
+	InnerTxCallTypeSelfDestruct = "selfdestruct"
+
+	innerTxInternalFailure = "internal failure"
+)
+
+// InnerTx is a message call, contract creation or self destruct made during the execution of an evm
+// transaction. The transaction itself is recorded as the inner tx of depth 0.
+type InnerTx struct {
+	Depth    int64          `json:"depth"`
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// innerTxRecorder is the evm tracer recording the inner txs. The tracer used for debugging, if any,
+// is wrapped by the recorder and keeps receiving all the tracing events.
+type innerTxRecorder struct {
+	tracer vm.Tracer
+
+	innerTxs []*InnerTx
+	// calls is the stack of the message calls being executed, the call at index i runs at the evm depth i+1
+	calls []*InnerTx
 }
 
-func updateDefaultInnerTxTo(...interface{}) {}
+var _ vm.Tracer = (*innerTxRecorder)(nil)
+
+func newInnerTxRecorder(tracer vm.Tracer) *innerTxRecorder {
+	return &innerTxRecorder{tracer: tracer}
+}
+
+// InnerTxs returns the recorded inner txs in the order of execution
+func (r *innerTxRecorder) InnerTxs() []*InnerTx {
+	return r.innerTxs
+}
+
+func (r *innerTxRecorder) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool,
+	input []byte, gas uint64, value *big.Int) {
+	if r.tracer != nil {
+		r.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+
+	callType := InnerTxCallTypeCall
+	if create {
+		callType = InnerTxCallTypeCreate
+	}
+	r.pushCall(&InnerTx{CallType: callType, From: from, To: to, Value: (*hexutil.Big)(new(big.Int).Set(value))})
+}
+
+func (r *innerTxRecorder) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64,
+	scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if r.tracer != nil {
+		r.tracer.CaptureState(env, pc, op, gas, cost, scope, rData, depth, err)
+	}
+
+	// the calls deeper than the current depth have returned, and the result of the last returned one
+	// is on the top of the stack of its caller
+	for len(r.calls) > depth {
+		call := r.calls[len(r.calls)-1]
+		r.calls = r.calls[:len(r.calls)-1]
+		if len(r.calls) != depth || len(scope.Stack.Data()) == 0 {
+			continue
+		}
+		ret := scope.Stack.Back(0)
+		if ret.IsZero() {
+			if call.Error == "" {
+				call.Error = innerTxInternalFailure
+			}
+		} else if call.CallType == InnerTxCallTypeCreate || call.CallType == InnerTxCallTypeCreate2 {
+			call.To = common.Address(ret.Bytes20())
+		}
+	}
+
+	// the operation fails before it's executed
+	if err != nil {
+		r.setError(depth, err)
+		return
+	}
+
+	stack := scope.Stack
+	from := scope.Contract.Address()
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		callType := InnerTxCallTypeCall
+		if op == vm.CALLCODE {
+			callType = InnerTxCallTypeCallCode
+		}
+		r.pushCall(&InnerTx{
+			Depth:    int64(depth),
+			CallType: callType,
+			From:     from,
+			To:       common.Address(stack.Back(1).Bytes20()),
+			Value:    (*hexutil.Big)(stack.Back(2).ToBig()),
+		})
+	case vm.DELEGATECALL, vm.STATICCALL:
+		callType := InnerTxCallTypeDelegateCall
+		if op == vm.STATICCALL {
+			callType = InnerTxCallTypeStaticCall
+		}
+		r.pushCall(&InnerTx{
+			Depth:    int64(depth),
+			CallType: callType,
+			From:     from,
+			To:       common.Address(stack.Back(1).Bytes20()),
+			Value:    (*hexutil.Big)(new(big.Int)),
+		})
+	case vm.CREATE, vm.CREATE2:
+		callType := InnerTxCallTypeCreate
+		if op == vm.CREATE2 {
+			callType = InnerTxCallTypeCreate2
+		}
+		// the address of the created contract is set after the creation succeeds
+		r.pushCall(&InnerTx{
+			Depth:    int64(depth),
+			CallType: callType,
+			From:     from,
+			Value:    (*hexutil.Big)(stack.Back(0).ToBig()),
+		})
+	case vm.SELFDESTRUCT:
+		r.innerTxs = append(r.innerTxs, &InnerTx{
+			Depth:    int64(depth),
+			CallType: InnerTxCallTypeSelfDestruct,
+			From:     from,
+			To:       common.Address(stack.Back(0).Bytes20()),
+			Value:    (*hexutil.Big)(new(big.Int).Set(env.StateDB.GetBalance(from))),
+		})
+	}
+}
+
+func (r *innerTxRecorder) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64,
+	scope *vm.ScopeContext, depth int, err error) {
+	if r.tracer != nil {
+		r.tracer.CaptureFault(env, pc, op, gas, cost, scope, depth, err)
+	}
+	r.setError(depth, err)
+}
+
+func (r *innerTxRecorder) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {
+	if r.tracer != nil {
+		r.tracer.CaptureEnd(output, gasUsed, t, err)
+	}
+	if err != nil {
+		r.setError(1, err)
+	}
+	r.calls = nil
+}
+
+// pushCall records the message call and puts it on the call stack
+func (r *innerTxRecorder) pushCall(call *InnerTx) {
+	r.innerTxs = append(r.innerTxs, call)
+	r.calls = append(r.calls, call)
+}
 
-func parseInnerTxAndContract(...interface{}) (interface{}, interface{}) {
-	return nil, nil
+// setError sets the error of the message call running at the depth if it hasn't been set
+func (r *innerTxRecorder) setError(depth int, err error) {
+	if depth < 1 || depth > len(r.calls) {
+		return
+	}
+	if call := r.calls[depth-1]; call.Error == "" {
+		call.Error = err.Error()
+	}
 }
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
 )
 
 var (
@@ -518,3 +519,60 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 	suite.Require().Equal(fromBalance, sdk.NewDec(4940).BigInt())
 	suite.Require().Equal(toBalance, sdk.NewDec(50).BigInt())
 }
+
+func (suite *StateDBTestSuite) TestTransitionDbInnerTxs() {
+	viper.Set("home", suite.T().TempDir())
+	viper.Set(types.FlagEnableInnerTx, true)
+	suite.Require().NoError(types.InitInnerTxDB())
+	defer func() {
+		types.CloseInnerTxDB()
+		viper.Set(types.FlagEnableInnerTx, false)
+		suite.Require().NoError(types.InitInnerTxDB())
+	}()
+
+	suite.stateDB.SetNonce(suite.address, 123)
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+
+	callee := ethcmn.HexToAddress("0x1111111111111111111111111111111111111111")
+	beneficiary := ethcmn.HexToAddress("0x2222222222222222222222222222222222222222")
+	// the init code calls the callee with more value than its balance, then calls the callee with 16 wei
+	// and self destructs to the beneficiary
+	initCode := hexutil.MustDecode("0x600060006000600061ffff73" + callee.Hex()[2:] + "5af150" +
+		"6000600060006000601073" + callee.Hex()[2:] + "5af150" +
+		"73" + beneficiary.Hex()[2:] + "ff")
+
+	st := types.StateTransition{
+		AccountNonce: 123,
+		Price:        sdk.NewDec(10).BigInt(),
+		GasLimit:     1000000,
+		Amount:       big.NewInt(100),
+		Payload:      initCode,
+		ChainID:      big.NewInt(1),
+		Csdb:         suite.stateDB,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.address,
+		Simulate:     suite.ctx.IsCheckTx(),
+	}
+	_, _, err, innerTxs, _ := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+
+	contract := ethcrypto.CreateAddress(suite.address, 123)
+	expInnerTxs := []*types.InnerTx{
+		{Depth: 0, CallType: types.InnerTxCallTypeCreate, From: suite.address, To: contract, Value: (*hexutil.Big)(big.NewInt(100))},
+		{Depth: 1, CallType: types.InnerTxCallTypeCall, From: contract, To: callee, Value: (*hexutil.Big)(big.NewInt(0xffff)), Error: "internal failure"},
+		{Depth: 1, CallType: types.InnerTxCallTypeCall, From: contract, To: callee, Value: (*hexutil.Big)(big.NewInt(16))},
+		{Depth: 1, CallType: types.InnerTxCallTypeSelfDestruct, From: contract, To: beneficiary, Value: (*hexutil.Big)(big.NewInt(84))},
+	}
+	suite.Require().Equal(expInnerTxs, innerTxs)
+
+	// no inner tx is recorded by simulation
+	st.Simulate = true
+	st.AccountNonce = 124
+	_, _, err, innerTxs, _ = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Nil(innerTxs)
+}
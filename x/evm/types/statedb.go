@@ -127,6 +127,13 @@ type CommitStateDB struct {
 
 	// Amino codec
 	cdc *codec.Codec
+
+	// pending writes of the messages executed by the native contracts
+	nativeWrites []nativeWrite
+	// keys of the contexts of the calls to the native contracts
+	nativeCallKeys []*byte
+	// native contracts called by the evm of the state transition, nil if they're not enabled at its height
+	nativeContracts map[ethcmn.Address]NativeContract
 }
 
 type StoreProxy interface {
@@ -469,7 +476,7 @@ func (csdb *CommitStateDB) PrepareAccessList(sender ethcmn.Address, dest *ethcmn
 	}
 
 	csdb.AddAddressToAccessList(sender)
-	if csdb != nil {
+	if dest != nil {
 		csdb.AddAddressToAccessList(*dest)
 		// If it's a create-tx, the destination will be added inside evm.create
 	}
@@ -789,6 +796,9 @@ func (csdb *CommitStateDB) Commit(deleteEmptyObjects bool) (ethcmn.Hash, error)
 // removing the csdb destructed objects and clearing the journal as well as the
 // refunds.
 func (csdb *CommitStateDB) Finalise(deleteEmptyObjects bool) error {
+	// the state objects are synced with the writes of the native contracts, so they're written after them
+	csdb.commitNativeWrites()
+
 	for _, dirty := range csdb.journal.dirties {
 		stateEntry, exist := csdb.stateObjects[dirty.address]
 		if !exist {
@@ -1046,6 +1056,7 @@ func (csdb *CommitStateDB) clearJournalAndRefund() {
 	csdb.journal = newJournal()
 	csdb.validRevisions = csdb.validRevisions[:0]
 	csdb.refund = 0
+	csdb.nativeWrites = nil
 }
 
 // Prepare sets the current transaction hash and index and block hash which is
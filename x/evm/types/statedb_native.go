@@ -0,0 +1,125 @@
+package types
+
+import (
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// nativeWrite is the pending writes and events of a message executed by a native contract
+type nativeWrite struct {
+	ctx    sdk.Context
+	write  func()
+	events sdk.Events
+}
+
+// GetCoinBalance returns the balance of the denom of the account, the amount of the coin is in the precision
+// of the evm, which is the same as the balance of the evm denom
+func (csdb *CommitStateDB) GetCoinBalance(addr ethcmn.Address, denom string) *big.Int {
+	if denom == sdk.DefaultBondDenom {
+		return csdb.GetBalance(addr)
+	}
+	so := csdb.getStateObject(addr)
+	if so == nil {
+		return new(big.Int)
+	}
+	return so.account.Balance(denom).BigInt()
+}
+
+// SetCoinBalance sets the balance of the denom of the account
+func (csdb *CommitStateDB) SetCoinBalance(addr ethcmn.Address, denom string, amount *big.Int) {
+	if denom == sdk.DefaultBondDenom {
+		csdb.SetBalance(addr, amount)
+		return
+	}
+	if so := csdb.GetOrNewStateObject(addr); so != nil {
+		so.(*stateObject).SetCoinBalance(denom, sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision))
+	}
+}
+
+// ExecuteNativeMsg executes the message by the handler of its module for a native contract, see ExecuteNative
+// for the accounts.
+func (csdb *CommitStateDB) ExecuteNativeMsg(handler sdk.Handler, msg sdk.Msg, accounts ...ethcmn.Address) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var res *sdk.Result
+	err := csdb.ExecuteNative(func(ctx sdk.Context) (events sdk.Events, err error) {
+		if res, err = handler(ctx, msg); err != nil || res == nil {
+			return nil, err
+		}
+		return res.Events, nil
+	}, accounts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExecuteNative executes the function of the native modules for a native contract. The function is executed
+// on a branch of the store whose writes are journaled, so they are dropped if the state is reverted and written
+// before the state objects when the state is finalised. The accounts of the state objects are written to the
+// branch before the execution, and the state objects are updated with the coins of the accounts changed by the
+// execution, so the accounts must include all the ones whose coins may be changed.
+func (csdb *CommitStateDB) ExecuteNative(fn func(ctx sdk.Context) (sdk.Events, error), accounts ...ethcmn.Address) error {
+	parent := csdb.ctx
+	if n := len(csdb.nativeWrites); n > 0 {
+		parent = csdb.nativeWrites[n-1].ctx
+	}
+	ctx, write := parent.CacheContext()
+	for _, addr := range accounts {
+		if so := csdb.getStateObject(addr); so != nil {
+			csdb.accountKeeper.SetAccount(ctx, so.account)
+		}
+	}
+
+	events, err := fn(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range accounts {
+		acc := csdb.accountKeeper.GetAccount(ctx, addr.Bytes())
+		if acc == nil {
+			continue
+		}
+		coins := acc.GetCoins()
+		so := csdb.GetOrNewStateObject(addr).(*stateObject)
+		for _, coin := range so.account.GetCoins() {
+			if !coins.AmountOf(coin.Denom).Equal(coin.Amount) {
+				so.SetCoinBalance(coin.Denom, coins.AmountOf(coin.Denom))
+			}
+		}
+		for _, coin := range coins {
+			if !so.account.Balance(coin.Denom).Equal(coin.Amount) {
+				so.SetCoinBalance(coin.Denom, coin.Amount)
+			}
+		}
+	}
+
+	csdb.journal.append(nativeWriteChange{})
+	csdb.nativeWrites = append(csdb.nativeWrites, nativeWrite{ctx: ctx, write: write, events: events})
+	return nil
+}
+
+// commitNativeWrites writes the pending writes of the native contracts to the store, and emits their events
+func (csdb *CommitStateDB) commitNativeWrites() {
+	// every branch is based on the previous one, so they're written from the latest one
+	for i := len(csdb.nativeWrites) - 1; i >= 0; i-- {
+		csdb.nativeWrites[i].write()
+	}
+	for _, nw := range csdb.nativeWrites {
+		csdb.ctx.EventManager().EmitEvents(nw.events)
+	}
+	csdb.nativeWrites = nil
+}
+
+// clearNativeCalls removes the contexts of the calls to the native contracts which are not run
+func (csdb *CommitStateDB) clearNativeCalls() {
+	for _, key := range csdb.nativeCallKeys {
+		nativeCalls.Delete(key)
+	}
+	csdb.nativeCallKeys = nil
+}
@@ -10,13 +10,14 @@ import (
 	"github.com/okex/exchain/app/utils"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // TxData implements the Ethereum transaction data structure. It is used
 // solely as intended in Ethereum abiding by the protocol.
 type TxData struct {
 	AccountNonce uint64          `json:"nonce"`
-	Price        *big.Int        `json:"gasPrice"`
+	Price        *big.Int        `json:"gasPrice"` // maxFeePerGas of EIP-1559 dynamic fee transactions
 	GasLimit     uint64          `json:"gas"`
 	Recipient    *ethcmn.Address `json:"to" rlp:"nil"` // nil means contract creation
 	Amount       *big.Int        `json:"value"`
@@ -29,6 +30,15 @@ type TxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// EIP-2718 transaction type, zero for legacy transactions. The typed transaction
+	// fields are not part of the legacy RLP layout.
+	Type       uint8               `json:"type" rlp:"-"`
+	ChainID    *big.Int            `json:"chainId" rlp:"-"`
+	AccessList ethtypes.AccessList `json:"accessList" rlp:"-"`
+
+	// maxPriorityFeePerGas of EIP-1559 dynamic fee transactions, nil for the others
+	GasTipCap *big.Int `json:"maxPriorityFeePerGas" rlp:"-"`
 }
 
 // encodableTxData implements the Ethereum transaction data structure. It is used
@@ -48,6 +58,12 @@ type encodableTxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction fields, omitted for legacy transactions
+	Type       uint8               `json:"type" rlp:"-"`
+	ChainID    string              `json:"chainId" rlp:"-"`
+	AccessList ethtypes.AccessList `json:"accessList" rlp:"-"`
+	GasTipCap  string              `json:"maxPriorityFeePerGas" rlp:"-"`
 }
 
 func (tx *encodableTxData) UnmarshalFromAmino(data []byte) error {
@@ -120,6 +136,75 @@ func (tx *encodableTxData) UnmarshalFromAmino(data []byte) error {
 			}
 			tx.Hash = new(ethcmn.Hash)
 			copy(tx.Hash[:], subData)
+		case 11:
+			txType, n, err := amino.DecodeUvarint(data)
+			if err != nil {
+				return err
+			}
+			if txType > 0xff {
+				return errors.New("tx type overflow")
+			}
+			tx.Type = uint8(txType)
+			dataLen = uint64(n)
+		case 12:
+			tx.ChainID = string(subData)
+		case 13:
+			var tuple ethtypes.AccessTuple
+			if err := unmarshalAccessTupleFromAmino(&tuple, subData); err != nil {
+				return err
+			}
+			tx.AccessList = append(tx.AccessList, tuple)
+		case 14:
+			tx.GasTipCap = string(subData)
+		default:
+			return fmt.Errorf("unexpect feild num %d", pos)
+		}
+	}
+	return nil
+}
+
+func unmarshalAccessTupleFromAmino(tuple *ethtypes.AccessTuple, data []byte) error {
+	var dataLen uint64 = 0
+	var subData []byte
+
+	for {
+		data = data[dataLen:]
+
+		if len(data) == 0 {
+			break
+		}
+
+		pos, pbType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		if err != nil {
+			return err
+		}
+		if pbType != amino.Typ3_ByteLength {
+			return fmt.Errorf("invalid access tuple")
+		}
+		data = data[1:]
+
+		var n int
+		dataLen, n, err = amino.DecodeUvarint(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) < int(dataLen) {
+			return fmt.Errorf("invalid access tuple")
+		}
+		subData = data[:dataLen]
+
+		switch pos {
+		case 1:
+			if dataLen != ethcmn.AddressLength {
+				return errors.New("eth addr len error")
+			}
+			copy(tuple.Address[:], subData)
+		case 2:
+			if dataLen != ethcmn.HashLength {
+				return errors.New("hash len error")
+			}
+			tuple.StorageKeys = append(tuple.StorageKeys, ethcmn.BytesToHash(subData))
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -175,6 +260,20 @@ func (td TxData) MarshalAmino() ([]byte, error) {
 		R:            r,
 		S:            s,
 		Hash:         td.Hash,
+		Type:         td.Type,
+		AccessList:   td.AccessList,
+	}
+
+	// the chain id is only encoded by typed transactions to keep the legacy encoding unchanged
+	if td.ChainID != nil {
+		if e.ChainID, err = utils.MarshalBigInt(td.ChainID); err != nil {
+			return nil, err
+		}
+	}
+	if td.GasTipCap != nil {
+		if e.GasTipCap, err = utils.MarshalBigInt(td.GasTipCap); err != nil {
+			return nil, err
+		}
 	}
 
 	return ModuleCdc.MarshalBinaryBare(e)
@@ -249,7 +348,7 @@ func (td *TxData) UnmarshalAmino(data []byte) error {
 		td.S = s
 	}
 
-	return nil
+	return td.unmarshalTypedFields(e)
 }
 
 func (td *TxData) UnmarshalFromAmino(data []byte) error {
@@ -319,6 +418,29 @@ func (td *TxData) UnmarshalFromAmino(data []byte) error {
 		td.S = s
 	}
 
+	return td.unmarshalTypedFields(e)
+}
+
+// unmarshalTypedFields sets the typed transaction fields from the decoded encodableTxData
+func (td *TxData) unmarshalTypedFields(e encodableTxData) error {
+	td.Type = e.Type
+	td.AccessList = e.AccessList
+	td.ChainID = nil
+	td.GasTipCap = nil
+	if len(e.ChainID) != 0 {
+		chainID, err := utils.UnmarshalBigInt(e.ChainID)
+		if err != nil {
+			return err
+		}
+		td.ChainID = chainID
+	}
+	if len(e.GasTipCap) != 0 {
+		gasTipCap, err := utils.UnmarshalBigInt(e.GasTipCap)
+		if err != nil {
+			return err
+		}
+		td.GasTipCap = gasTipCap
+	}
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func TestMarshalAndUnmarshalData(t *testing.T) {
@@ -51,6 +52,45 @@ func TestMarshalAndUnmarshalData(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestMarshalAndUnmarshalAccessListData(t *testing.T) {
+	addr := GenerateEthAddress()
+	txData := TxData{
+		AccountNonce: 2,
+		Price:        big.NewInt(3),
+		GasLimit:     1,
+		Recipient:    &addr,
+		Amount:       big.NewInt(4),
+		Payload:      []byte("test"),
+		V:            big.NewInt(1),
+		R:            big.NewInt(6),
+		S:            big.NewInt(7),
+		Type:         ethtypes.AccessListTxType,
+		ChainID:      big.NewInt(65),
+		AccessList: ethtypes.AccessList{
+			{Address: addr, StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(1))}},
+			{Address: GenerateEthAddress()},
+		},
+	}
+
+	bz, err := txData.MarshalAmino()
+	require.NoError(t, err)
+
+	var txData2 TxData
+	require.NoError(t, txData2.UnmarshalAmino(bz))
+	require.Equal(t, txData, txData2)
+
+	var txData3 TxData
+	require.NoError(t, txData3.UnmarshalFromAmino(bz))
+	require.Equal(t, txData, txData3)
+
+	// the typed transaction fields are appended to the legacy encoding
+	txData.Type, txData.ChainID, txData.AccessList = 0, nil, nil
+	legacyBz, err := txData.MarshalAmino()
+	require.NoError(t, err)
+	require.True(t, len(legacyBz) < len(bz))
+	require.Equal(t, legacyBz, bz[:len(legacyBz)])
+}
+
 func BenchmarkUnmarshalTxData(b *testing.B) {
 	addr := GenerateEthAddress()
 	hash := ethcmn.BigToHash(big.NewInt(2))
@@ -283,9 +283,10 @@ type EthBlock struct {
 	Uncles           []common.Hash  `json:"uncles"`
 	ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
 	Transactions     interface{}    `json:"transactions"`
+	BaseFee          *hexutil.Big   `json:"baseFeePerGas,omitempty"`
 }
 
-func NewMsgBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash, header abci.Header, gasLimit uint64, gasUsed *big.Int, txs interface{}) *MsgBlock {
+func NewMsgBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash, header abci.Header, gasLimit uint64, gasUsed *big.Int, baseFee *big.Int, txs interface{}) *MsgBlock {
 	b := EthBlock{
 		Number:           hexutil.Uint64(height),
 		Hash:             blockHash,
@@ -307,6 +308,7 @@ func NewMsgBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash
 		Uncles:           []common.Hash{},
 		ReceiptsRoot:     common.Hash{},
 		Transactions:     txs,
+		BaseFee:          (*hexutil.Big)(baseFee),
 	}
 	jsBlock, e := json.Marshal(b)
 	if e != nil {
@@ -216,11 +216,11 @@ func (w *Watcher) SaveState(addr common.Address, key, value []byte) {
 	}
 }
 
-func (w *Watcher) SaveBlock(bloom ethtypes.Bloom) {
+func (w *Watcher) SaveBlock(bloom ethtypes.Bloom, baseFee *big.Int) {
 	if !w.Enabled() {
 		return
 	}
-	wMsg := NewMsgBlock(w.height, bloom, w.blockHash, w.header, uint64(0xffffffff), big.NewInt(int64(w.gasUsed)), w.blockTxs)
+	wMsg := NewMsgBlock(w.height, bloom, w.blockHash, w.header, uint64(0xffffffff), big.NewInt(int64(w.gasUsed)), baseFee, w.blockTxs)
 	if wMsg != nil {
 		w.batch = append(w.batch, wMsg)
 	}
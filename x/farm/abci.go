@@ -14,6 +14,9 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	logger := k.Logger(ctx)
 
+	// settle the boosted locks expiring at current block, whose weights go back to the locked amounts
+	settleExpiredLocks(ctx, k)
+
 	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, MintFarmingAccount)
 	yieldedNativeTokenAmt := moduleAcc.GetCoins().AmountOf(sdk.DefaultBondDenom)
 	logger.Debug(fmt.Sprintf("MintFarmingAccount [%s] balance: %s%s",
@@ -74,6 +77,33 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	}
 }
 
+// settleExpiredLocks withdraws the rewards of the boosted locks expiring at current block by their boosted weights,
+// then resets their weights to the locked amounts
+func settleExpiredLocks(ctx sdk.Context, k keeper.Keeper) {
+	for _, lockInfo := range k.DequeueExpiredLocks(ctx, ctx.BlockHeight()) {
+		pool, found := k.GetFarmPool(ctx, lockInfo.PoolName)
+		if !found {
+			panic("should not happen")
+		}
+
+		updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+		rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens, lockInfo.Owner)
+		if err != nil {
+			panic(err)
+		}
+		weightChange := k.UpdateLockInfo(ctx, lockInfo.Owner, pool.Name, sdk.ZeroDec())
+		updatedPool = updatedPool.AddWeightedValueLocked(weightChange)
+
+		if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
+			panic("should not happen")
+		}
+		updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
+		k.SetFarmPool(ctx, updatedPool)
+
+		k.OnClaim(ctx, lockInfo.Owner, pool.Name, rewards)
+	}
+}
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 
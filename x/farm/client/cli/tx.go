@@ -20,6 +20,8 @@ import (
 	"github.com/okex/exchain/x/farm/types"
 )
 
+const flagLockDuration = "lock-duration"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	farmTxCmd := &cobra.Command{
@@ -141,10 +143,13 @@ func GetCmdLock(cdc *codec.Codec) *cobra.Command {
 		Short: "lock a number of tokens for yield farming",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Lock a number of tokens for yield farming.
+The tokens locked with a lock duration in blocks can't be unlocked until the duration passes,
+and their weight in the pool is boosted by the lock duration.
 
 Example:
 $ %s tx farm lock pool-eth-xxb 5eth --from mykey
-`, version.ClientName),
+$ %s tx farm lock pool-eth-xxb 5eth --lock-duration 864000 --from mykey
+`, version.ClientName, version.ClientName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -158,10 +163,15 @@ $ %s tx farm lock pool-eth-xxb 5eth --from mykey
 			}
 
 			poolName := args[0]
-			msg := types.NewMsgLock(poolName, cliCtx.GetFromAddress(), amount)
+			lockDuration, err := cmd.Flags().GetInt64(flagLockDuration)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLockWithDuration(poolName, cliCtx.GetFromAddress(), amount, lockDuration)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().Int64(flagLockDuration, 0, "the number of blocks the tokens are locked for to boost the weight")
 	return cmd
 }
 
@@ -21,6 +21,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 
 	for _, lockInfo := range data.LockInfos {
 		k.SetLockInfo(ctx, lockInfo)
+		if lockInfo.UnlockHeight > 0 && lockInfo.IsBoosted() {
+			k.SetLockExpiry(ctx, lockInfo.UnlockHeight, lockInfo.Owner, lockInfo.PoolName)
+		}
 	}
 
 	for _, historical := range data.PoolHistoricalRewards {
@@ -31,6 +31,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 			Amount:           sdk.NewDecCoinFromDec(poolMsg.MinLockAmount.Denom, sdk.NewDec(1)),
 			StartBlockHeight: 10,
 			ReferencePeriod:  1,
+			Weight:           sdk.NewDec(1),
 		},
 	}
 	defaultGenesisState.PoolCurrentRewards = []types.PoolCurrentRewardsRecord{
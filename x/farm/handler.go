@@ -102,9 +102,12 @@ func handleMsgProvide(ctx sdk.Context, k keeper.Keeper, msg types.MsgProvide) (*
 	}
 
 	// 3. Terminate pool current period
-	k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+	k.IncrementPoolPeriod(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens)
 
 	// 4. Transfer coin to farm module account
+	if err := k.TokenKeeper().CheckCoinsTransferable(ctx, msg.Address, msg.Amount.ToCoins()); err != nil {
+		return nil, err
+	}
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
 		ctx, msg.Address, YieldFarmingAccount, msg.Amount.ToCoins(),
 	); err != nil {
@@ -139,13 +142,17 @@ func handleMsgClaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaim) (*sdk.
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw rewards
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.TokenKeeper().CheckCoinsTransferable(ctx, msg.Address, rewards); err != nil {
+		return nil, err
+	}
 
 	// 4. Update the lock_info data
-	k.UpdateLockInfo(ctx, msg.Address, pool.Name, sdk.ZeroDec())
+	weightChange := k.UpdateLockInfo(ctx, msg.Address, pool.Name, sdk.ZeroDec())
+	updatedPool = updatedPool.AddWeightedValueLocked(weightChange)
 
 	// 5. Update farm pool
 	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
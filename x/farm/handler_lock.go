@@ -1,6 +1,8 @@
 package farm
 
 import (
+	"strconv"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/farm/keeper"
 	"github.com/okex/exchain/x/farm/types"
@@ -16,7 +18,12 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		return types.ErrInvalidDenom(pool.MinLockAmount.Denom, msg.Amount.Denom).Result()
 	}
 
-	// 1.2. check min lock amount
+	// 1.2 check lock duration
+	if msg.LockDuration > 0 && msg.LockDuration > k.GetParams(ctx).MaxLockDuration {
+		return types.ErrInvalidLockDuration(msg.LockDuration).Result()
+	}
+
+	// 1.3. check min lock amount
 	hasLocked := k.HasLockInfo(ctx, msg.Address, msg.PoolName)
 	if !hasLocked && msg.Amount.Amount.LT(pool.MinLockAmount.Amount) {
 		return types.ErrLockAmountBelowMinimum(pool.MinLockAmount.Amount, msg.Amount.Amount).Result()
@@ -30,10 +37,13 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	if hasLocked {
 		// If it exists, withdraw money
 		var err error
-		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens, msg.Address)
 		if err != nil {
 			return nil, err
 		}
+		if err := k.TokenKeeper().CheckCoinsTransferable(ctx, msg.Address, rewards); err != nil {
+			return nil, err
+		}
 		if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
 			panic("should not happen")
 		}
@@ -41,7 +51,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	} else {
 		// If it doesn't exist, only increase period
-		k.IncrementPoolPeriod(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens)
+		k.IncrementPoolPeriod(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens)
 
 		// Create new lock info
 		lockInfo := types.NewLockInfo(
@@ -52,10 +62,16 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		k.SetAddressInFarmPool(ctx, msg.PoolName, msg.Address)
 	}
 
-	// 4. Update lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount)
+	// 4. Extend the unlock height of the lock info, then update lock info
+	if msg.LockDuration > 0 {
+		extendLockExpiry(ctx, k, msg.Address, msg.PoolName, ctx.BlockHeight()+msg.LockDuration)
+	}
+	weightChange := k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount)
 
 	// 5. Send the locked-tokens from its own account to farm module account
+	if err := k.TokenKeeper().CheckCoinsTransferable(ctx, msg.Address, msg.Amount.ToCoins()); err != nil {
+		return nil, err
+	}
 	if err := k.SupplyKeeper().SendCoinsFromAccountToModule(
 		ctx, msg.Address, ModuleName, msg.Amount.ToCoins(),
 	); err != nil {
@@ -64,6 +80,7 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Add(msg.Amount)
+	updatedPool = updatedPool.AddWeightedValueLocked(weightChange)
 	k.SetFarmPool(ctx, updatedPool)
 
 	// 7. notify backend
@@ -76,10 +93,25 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
 		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
 		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyLockDuration, strconv.FormatInt(msg.LockDuration, 10)),
 	))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// extendLockExpiry moves the unlock height of the lock info forward, the unlock height never moves backward
+func extendLockExpiry(ctx sdk.Context, k keeper.Keeper, addr sdk.AccAddress, poolName string, unlockHeight int64) {
+	lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
+	if !found || unlockHeight <= lockInfo.UnlockHeight {
+		return
+	}
+	if lockInfo.UnlockHeight > ctx.BlockHeight() {
+		k.DeleteLockExpiry(ctx, lockInfo.UnlockHeight, addr, poolName)
+	}
+	lockInfo.UnlockHeight = unlockHeight
+	k.SetLockInfo(ctx, lockInfo)
+	k.SetLockExpiry(ctx, unlockHeight, addr, poolName)
+}
+
 func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sdk.Result, error) {
 	// 1.1 Check if there are enough tokens to unlock
 	lockInfo, found := k.GetLockInfo(ctx, msg.Address, msg.PoolName)
@@ -95,6 +127,10 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 		return types.ErrInsufficientAmount(lockInfo.Amount.String(), msg.Amount.String()).Result()
 	}
 
+	if ctx.BlockHeight() < lockInfo.UnlockHeight {
+		return types.ErrLockNotExpired(lockInfo.UnlockHeight).Result()
+	}
+
 	// 1.2 Get the pool info
 	pool, poolFound := k.GetFarmPool(ctx, msg.PoolName)
 	if !poolFound {
@@ -112,21 +148,25 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Withdraw money
-	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.GetTotalWeightedValueLocked(), yieldedTokens, msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	// 4. Update the lock info
-	k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg())
+	weightChange := k.UpdateLockInfo(ctx, msg.Address, msg.PoolName, msg.Amount.Amount.Neg())
 
 	// 5. Send the locked-tokens from farm module account to its own account
+	if err := k.TokenKeeper().CheckCoinsTransferable(ctx, msg.Address, rewards.Add2(msg.Amount.ToCoins())); err != nil {
+		return nil, err
+	}
 	if err = k.SupplyKeeper().SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Address, msg.Amount.ToCoins()); err != nil {
 		return nil, types.ErrSendCoinsFromModuleToAccountFailed(err.Error())
 	}
 
 	// 6. Update farm pool
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Sub(msg.Amount)
+	updatedPool = updatedPool.AddWeightedValueLocked(weightChange)
 	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
 		panic("should not happen")
 	}
@@ -1316,3 +1316,78 @@ func TestHandlerCheckCombination(t *testing.T) {
 	testCaseCombinationTest(t, tests)
 
 }
+
+func TestHandlerMsgLockWithDuration(t *testing.T) {
+	tCtx := initEnvironment(t)
+	params := tCtx.k.GetParams(tCtx.ctx)
+
+	// create pool and provide
+	createPoolMsg := createPool(t, tCtx)
+	provide(t, tCtx, createPoolMsg)
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 1)
+
+	amount := sdk.NewDecCoinFromDec(createPoolMsg.MinLockAmount.Denom, sdk.NewDec(1))
+	boostedAddr, normalAddr := createPoolMsg.Owner, tCtx.addrList[0]
+
+	// lock duration exceeding the max lock duration
+	lockMsg := types.NewMsgLockWithDuration(createPoolMsg.PoolName, boostedAddr, amount, params.MaxLockDuration+1)
+	_, err := tCtx.handler(tCtx.ctx, lockMsg)
+	require.Equal(t, types.ErrInvalidLockDuration(params.MaxLockDuration+1).Error(), err.Error())
+
+	// boosted lock
+	var lockDuration int64 = 100
+	lockMsg = types.NewMsgLockWithDuration(createPoolMsg.PoolName, boostedAddr, amount, lockDuration)
+	_, err = tCtx.handler(tCtx.ctx, lockMsg)
+	require.Nil(t, err)
+	unlockHeight := tCtx.ctx.BlockHeight() + lockDuration
+	boostedWeight := amount.Amount.Mul(params.LockBoost(lockDuration))
+	lockInfo, found := tCtx.k.GetLockInfo(tCtx.ctx, boostedAddr, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, boostedWeight, lockInfo.Weight)
+	require.Equal(t, unlockHeight, lockInfo.UnlockHeight)
+
+	// normal lock
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgLock(createPoolMsg.PoolName, normalAddr, amount))
+	require.Nil(t, err)
+	pool, found := tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, amount.Amount.MulInt64(2), pool.TotalValueLocked.Amount)
+	require.Equal(t, boostedWeight.Add(amount.Amount), pool.TotalWeightedValueLocked.Amount)
+
+	// the rewards are distributed by the weights
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(tCtx.ctx.BlockHeight() + 5)
+	queryCtx, _ := tCtx.ctx.CacheContext()
+	boostedEarnings, err := tCtx.k.GetEarnings(queryCtx, createPoolMsg.PoolName, boostedAddr)
+	require.Nil(t, err)
+	normalEarnings, err := tCtx.k.GetEarnings(queryCtx, createPoolMsg.PoolName, normalAddr)
+	require.Nil(t, err)
+	require.Equal(t, boostedWeight, boostedEarnings.Weight)
+	require.Equal(t, unlockHeight, boostedEarnings.UnlockHeight)
+	require.True(t, boostedEarnings.AmountYielded.IsAllGT(normalEarnings.AmountYielded))
+
+	// claiming keeps the boosted weight
+	claim(t, tCtx, createPoolMsg)
+	lockInfo, found = tCtx.k.GetLockInfo(tCtx.ctx, boostedAddr, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, boostedWeight, lockInfo.Weight)
+
+	// early unlock is rejected
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgUnlock(createPoolMsg.PoolName, boostedAddr, amount))
+	require.Equal(t, types.ErrLockNotExpired(unlockHeight).Error(), err.Error())
+
+	// the weight goes back to the locked amount at the unlock height
+	tCtx.ctx = tCtx.ctx.WithBlockHeight(unlockHeight)
+	BeginBlocker(tCtx.ctx, abci.RequestBeginBlock{Header: abci.Header{Height: unlockHeight}}, tCtx.k)
+	lockInfo, found = tCtx.k.GetLockInfo(tCtx.ctx, boostedAddr, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, amount.Amount, lockInfo.Weight)
+	pool, found = tCtx.k.GetFarmPool(tCtx.ctx, createPoolMsg.PoolName)
+	require.True(t, found)
+	require.Equal(t, pool.TotalValueLocked.Amount, pool.TotalWeightedValueLocked.Amount)
+	require.Empty(t, tCtx.k.DequeueExpiredLocks(tCtx.ctx, unlockHeight))
+
+	// unlock after the unlock height
+	_, err = tCtx.handler(tCtx.ctx, types.NewMsgUnlock(createPoolMsg.PoolName, boostedAddr, amount))
+	require.Nil(t, err)
+	require.False(t, tCtx.k.HasLockInfo(tCtx.ctx, boostedAddr, createPoolMsg.PoolName))
+}
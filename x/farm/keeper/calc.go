@@ -47,7 +47,7 @@ func (k Keeper) CalculateAmountYieldedBetween(ctx sdk.Context, pool types.FarmPo
 }
 
 func (k Keeper) WithdrawRewards(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
+	ctx sdk.Context, poolName string, totalWeightedValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins, addr sdk.AccAddress,
 ) (sdk.SysCoins, sdk.Error) {
 	// 0. check existence of lock info
 	lockInfo, found := k.GetLockInfo(ctx, addr, poolName)
@@ -56,7 +56,7 @@ func (k Keeper) WithdrawRewards(
 	}
 
 	// 1. end current period and calculate rewards
-	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalValueLocked, yieldedTokens)
+	endingPeriod := k.IncrementPoolPeriod(ctx, poolName, totalWeightedValueLocked, yieldedTokens)
 	rewards := k.calculateRewards(ctx, poolName, addr, endingPeriod, lockInfo)
 
 	// 2. transfer rewards to user account
@@ -73,19 +73,20 @@ func (k Keeper) WithdrawRewards(
 	return rewards, nil
 }
 
-// IncrementPoolPeriod increments pool period, returning the period just ended
+// IncrementPoolPeriod increments pool period, returning the period just ended.
+// The reward ratio is calculated by the total weight of the locks instead of the total locked amount
 func (k Keeper) IncrementPoolPeriod(
-	ctx sdk.Context, poolName string, totalValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins,
+	ctx sdk.Context, poolName string, totalWeightedValueLocked sdk.SysCoin, yieldedTokens sdk.SysCoins,
 ) uint64 {
 	// 1. fetch current period rewards
 	rewards := k.GetPoolCurrentRewards(ctx, poolName)
 	// 2. calculate current reward ratio
 	rewards.Rewards = rewards.Rewards.Add2(yieldedTokens)
 	var currentRatio sdk.SysCoins
-	if totalValueLocked.IsZero() {
+	if totalWeightedValueLocked.IsZero() {
 		currentRatio = sdk.SysCoins{}
 	} else {
-		currentRatio = rewards.Rewards.QuoDecTruncate(totalValueLocked.Amount)
+		currentRatio = rewards.Rewards.QuoDecTruncate(totalWeightedValueLocked.Amount)
 	}
 
 	// 3.1 get the previous pool historical rewards
@@ -138,32 +139,34 @@ func (k Keeper) calculateRewards(
 
 	startingPeriod := lockInfo.ReferencePeriod
 	// calculate rewards for final period
-	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.Amount)
+	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.GetWeight())
 }
 
 // calculateLockRewardsBetween calculate the rewards accrued by a pool between two periods
 func (k Keeper) calculateLockRewardsBetween(ctx sdk.Context, poolName string, startingPeriod, endingPeriod uint64,
-	amount sdk.SysCoin) (rewards sdk.SysCoins) {
+	weight sdk.Dec) (rewards sdk.SysCoins) {
 
 	// sanity check
 	if startingPeriod > endingPeriod {
 		panic("startingPeriod cannot be greater than endingPeriod")
 	}
 
-	if amount.Amount.LT(sdk.ZeroDec()) {
-		panic("amount should not be negative")
+	if weight.LT(sdk.ZeroDec()) {
+		panic("weight should not be negative")
 	}
 
-	// return amount * (ending - starting)
+	// return weight * (ending - starting)
 	starting := k.GetPoolHistoricalRewards(ctx, poolName, startingPeriod)
 	ending := k.GetPoolHistoricalRewards(ctx, poolName, endingPeriod)
 	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
-	rewards = difference.MulDecTruncate(amount.Amount)
+	rewards = difference.MulDecTruncate(weight)
 	return
 }
 
-// UpdateLockInfo updates lock info for the modified lock info
-func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec) {
+// UpdateLockInfo updates lock info for the modified lock info, returning the change of its weight
+func (k Keeper) UpdateLockInfo(
+	ctx sdk.Context, addr sdk.AccAddress, poolName string, changedAmount sdk.Dec,
+) (weightChange sdk.Dec) {
 	// period has already been incremented - we want to store the period ended by this lock action
 	previousPeriod := k.GetPoolCurrentRewards(ctx, poolName).Period - 1
 
@@ -174,16 +177,37 @@ func (k Keeper) UpdateLockInfo(ctx sdk.Context, addr sdk.AccAddress, poolName st
 	}
 	lockInfo.StartBlockHeight = ctx.BlockHeight()
 	lockInfo.ReferencePeriod = previousPeriod
+	previousWeight := lockInfo.GetWeight()
 	lockInfo.Amount.Amount = lockInfo.Amount.Amount.Add(changedAmount)
 	if lockInfo.Amount.IsZero() {
 		k.DeleteLockInfo(ctx, lockInfo.Owner, lockInfo.PoolName)
 		k.DeleteAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
-	} else {
-		// increment reference count for the period we're going to track
-		k.incrementReferenceCount(ctx, poolName, previousPeriod)
+		if lockInfo.UnlockHeight > ctx.BlockHeight() {
+			k.DeleteLockExpiry(ctx, lockInfo.UnlockHeight, lockInfo.Owner, lockInfo.PoolName)
+		}
+		return previousWeight.Neg()
+	}
+
+	lockInfo.Weight = k.calculateLockWeight(ctx, lockInfo, changedAmount)
+	// increment reference count for the period we're going to track
+	k.incrementReferenceCount(ctx, poolName, previousPeriod)
 
-		// set the updated lock info
-		k.SetLockInfo(ctx, lockInfo)
-		k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+	// set the updated lock info
+	k.SetLockInfo(ctx, lockInfo)
+	k.SetAddressInFarmPool(ctx, lockInfo.PoolName, lockInfo.Owner)
+	return lockInfo.Weight.Sub(previousWeight)
+}
+
+// calculateLockWeight calculates the weight of the lock info boosted by its remaining lock duration
+func (k Keeper) calculateLockWeight(ctx sdk.Context, lockInfo types.LockInfo, changedAmount sdk.Dec) sdk.Dec {
+	remainingDuration := lockInfo.UnlockHeight - ctx.BlockHeight()
+	if remainingDuration <= 0 {
+		// the lock has expired, its weight goes back to the locked amount
+		return lockInfo.Amount.Amount
+	}
+	if changedAmount.IsZero() {
+		// claiming rewards keeps the boost of the lock
+		return lockInfo.GetWeight()
 	}
+	return lockInfo.Amount.Amount.Mul(k.GetParams(ctx).LockBoost(remainingDuration))
 }
@@ -277,7 +277,7 @@ func TestCalculateLockRewardsBetween(t *testing.T) {
 		keeper.SetPoolHistoricalRewards(ctx, poolName, test.endPeriod, endHis)
 
 		wrappedTestFunc := func() sdk.SysCoins {
-			return keeper.calculateLockRewardsBetween(ctx, poolName, test.startPeriod, test.endPeriod, test.amount)
+			return keeper.calculateLockRewardsBetween(ctx, poolName, test.startPeriod, test.endPeriod, test.amount.Amount)
 		}
 		test.expectedFunc(test, wrappedTestFunc)
 	}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/farm/types"
+)
+
+// SetLockExpiry inserts a boosted lock into the lock expiry queue at its unlock height
+func (k Keeper) SetLockExpiry(ctx sdk.Context, unlockHeight int64, addr sdk.AccAddress, poolName string) {
+	ctx.KVStore(k.storeKey).Set(types.GetLockExpiryQueueKey(unlockHeight, addr, poolName), []byte(""))
+}
+
+// DeleteLockExpiry removes a boosted lock from the lock expiry queue
+func (k Keeper) DeleteLockExpiry(ctx sdk.Context, unlockHeight int64, addr sdk.AccAddress, poolName string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetLockExpiryQueueKey(unlockHeight, addr, poolName))
+}
+
+// DequeueExpiredLocks removes all the boosted locks expiring at the block height from the lock expiry queue,
+// returning their lock infos
+func (k Keeper) DequeueExpiredLocks(ctx sdk.Context, height int64) (lockInfos []types.LockInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLockExpiryQueueHeightPrefix(height))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		addr, poolName := types.SplitLockExpiryQueueKey(key)
+		if lockInfo, found := k.GetLockInfo(ctx, addr, poolName); found {
+			lockInfos = append(lockInfos, lockInfo)
+		}
+	}
+	return
+}
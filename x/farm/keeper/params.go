@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/farm/types"
 )
 
@@ -12,6 +13,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams returns the total set of farm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	common.GetParamSetWithDefaults(ctx, k.paramSubspace, &params, types.OptionalParamKeys...)
 	return
 }
@@ -170,6 +170,7 @@ func GetKeeper(t *testing.T) (sdk.Context, MockFarmKeeper) {
 		types.YieldFarmingAccount: nil,
 		types.MintFarmingAccount:  nil,
 		swap.ModuleName:           {supply.Burner, supply.Minter},
+		swap.ProtocolFeeAccount:   nil,
 		govtypes.ModuleName:       nil,
 	}
 	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
@@ -10,6 +10,8 @@ type Earnings struct {
 	TargetBlockHeight int64        `json:"target_block_height"`
 	AmountLocked      sdk.SysCoin  `json:"amount_locked"`
 	AmountYielded     sdk.SysCoins `json:"amount_yielded"`
+	Weight            sdk.Dec      `json:"weight"`
+	UnlockHeight      int64        `json:"unlock_height"`
 }
 
 // NewEarnings creates a new instance of Earnings
@@ -18,6 +20,7 @@ func NewEarnings(targetBlockHeight int64, amountLocked sdk.SysCoin, amountYielde
 		TargetBlockHeight: targetBlockHeight,
 		AmountLocked:      amountLocked,
 		AmountYielded:     amountYielded,
+		Weight:            amountLocked.Amount,
 	}
 }
 
@@ -26,7 +29,9 @@ func (e Earnings) String() string {
 	return fmt.Sprintf(`Earnings:
   Target Block Height: 		%d,
   Amount Locked:			%s,
-  Amount Yielded:			%s`,
-		e.TargetBlockHeight, e.AmountLocked, e.AmountYielded,
+  Amount Yielded:			%s,
+  Weight:					%s,
+  Unlock Height:			%d`,
+		e.TargetBlockHeight, e.AmountLocked, e.AmountYielded, e.Weight, e.UnlockHeight,
 	)
 }
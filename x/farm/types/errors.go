@@ -35,6 +35,8 @@ const (
 	CodeLockAmountBelowMinimum             uint32 = 66019
 	CodeSendCoinsFromModuleToAccountFailed uint32 = 66020
 	CodeSwapTokenPairNotExist              uint32 = 66021
+	CodeInvalidLockDuration                uint32 = 66022
+	CodeLockNotExpired                     uint32 = 66023
 )
 
 // ErrInvalidInput returns an error when an input parameter is invalid
@@ -148,4 +150,14 @@ func ErrSendCoinsFromModuleToAccountFailed(content string) sdk.EnvelopedErr {
 // ErrSwapTokenPairNotExist returns an error when a swap token pair not exists
 func ErrSwapTokenPairNotExist(tokenName string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeSwapTokenPairNotExist, fmt.Sprintf("failed. swap token pair %s does not exist", tokenName))}
-}
\ No newline at end of file
+}
+
+// ErrInvalidLockDuration returns an error when the lock duration is negative or exceeds the max lock duration
+func ErrInvalidLockDuration(lockDuration int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidLockDuration, fmt.Sprintf("failed. invalid lock duration %d", lockDuration))}
+}
+
+// ErrLockNotExpired returns an error when the tokens are unlocked before the unlock height
+func ErrLockNotExpired(unlockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockNotExpired, fmt.Sprintf("failed. the locked tokens can't be unlocked until height %d", unlockHeight))}
+}
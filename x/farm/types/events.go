@@ -18,6 +18,7 @@ const (
 	AttributeKeyDeposit             = "deposit"
 	AttributeKeyWithdraw            = "withdraw"
 	AttributeKeyClaimed             = "claimed"
+	AttributeKeyLockDuration        = "lock_duration"
 
 	AttributeValueCategory = ModuleName
 )
@@ -17,6 +17,8 @@ type FarmPool struct {
 	TotalValueLocked        sdk.SysCoin       `json:"total_value_locked"`
 	YieldedTokenInfos       YieldedTokenInfos `json:"yielded_token_infos"`
 	TotalAccumulatedRewards sdk.SysCoins      `json:"total_accumulated_rewards"`
+	// sum of LockInfo.Weight, appended as the last field to decode the pools stored before
+	TotalWeightedValueLocked sdk.SysCoin `json:"total_weighted_value_locked"`
 }
 
 // NewFarmPool creates a new instance of FarmPool
@@ -25,16 +27,33 @@ func NewFarmPool(
 	yieldedTokenInfos YieldedTokenInfos, accumulatedRewards sdk.SysCoins,
 ) FarmPool {
 	return FarmPool{
-		Owner:                   owner,
-		Name:                    name,
-		MinLockAmount:           minLockAmount,
-		DepositAmount:           depositAmount,
-		TotalValueLocked:        totalValueLocked,
-		YieldedTokenInfos:       yieldedTokenInfos,
-		TotalAccumulatedRewards: accumulatedRewards,
+		Owner:                    owner,
+		Name:                     name,
+		MinLockAmount:            minLockAmount,
+		DepositAmount:            depositAmount,
+		TotalValueLocked:         totalValueLocked,
+		TotalWeightedValueLocked: totalValueLocked,
+		YieldedTokenInfos:        yieldedTokenInfos,
+		TotalAccumulatedRewards:  accumulatedRewards,
 	}
 }
 
+// GetTotalWeightedValueLocked returns the sum of the weights of the locked tokens, which is the total value locked
+// for the pool without boosted locks
+func (fp FarmPool) GetTotalWeightedValueLocked() sdk.SysCoin {
+	if fp.TotalWeightedValueLocked.Denom == "" {
+		return fp.TotalValueLocked
+	}
+	return fp.TotalWeightedValueLocked
+}
+
+// AddWeightedValueLocked returns the pool with the weight change of its locks
+func (fp FarmPool) AddWeightedValueLocked(weightChange sdk.Dec) FarmPool {
+	total := fp.GetTotalWeightedValueLocked()
+	fp.TotalWeightedValueLocked = sdk.NewDecCoinFromDec(total.Denom, total.Amount.Add(weightChange))
+	return fp
+}
+
 func (fp FarmPool) Finished() bool {
 	for _, yieldedTokenInfo := range fp.YieldedTokenInfos {
 		if yieldedTokenInfo.RemainingAmount.IsPositive() {
@@ -52,9 +71,11 @@ func (fp FarmPool) String() string {
   Min Lock Amount:      			    %s
   Deposit Amount:                   %s
   Total Value Locked:               %s
+  Total Weighted Value Locked:      %s
   Yielded Token Infos:			    %s
   Total Accumulated Rewards:        %s`,
-		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked, fp.YieldedTokenInfos, fp.TotalAccumulatedRewards)
+		fp.Name, fp.Owner, fp.MinLockAmount.String(), fp.DepositAmount, fp.TotalValueLocked,
+		fp.GetTotalWeightedValueLocked(), fp.YieldedTokenInfos, fp.TotalAccumulatedRewards)
 }
 
 // FarmPools is a collection of FarmPool
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/okex/exchain/x/common"
@@ -72,3 +73,19 @@ func TestFarmPools(t *testing.T) {
 		require.Equal(t, test.isFinished, pool.Finished())
 	}
 }
+
+func TestFarmPoolDecodeStoredBeforeWeight(t *testing.T) {
+	// the pool encoded before the total weighted value locked is introduced
+	bz, err := hex.DecodeString("b3010a01011204706f6f6c1a1a0a03787862121331303030303030303030303030303030303030221b0a036f6b74121431303030303030303030303030303030303030302a1c0a03787862121531303030303030303030303030303030303030303032350a1c0a037777621215313030303030303030303030303030303030303030100a1a13313030303030303030303030303030303030303a1a0a03777762121335303030303030303030303030303030303030")
+	require.Nil(t, err)
+
+	var pool FarmPool
+	require.Nil(t, ModuleCdc.UnmarshalBinaryLengthPrefixed(bz, &pool))
+	require.Equal(t, "pool", pool.Name)
+	require.Equal(t, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), pool.TotalValueLocked)
+	require.Equal(t, YieldedTokenInfos{NewYieldedTokenInfo(sdk.NewDecCoinFromDec("wwb", sdk.NewDec(100)), 10,
+		sdk.NewDec(1))}, pool.YieldedTokenInfos)
+	require.Equal(t, sdk.SysCoins{sdk.NewDecCoinFromDec("wwb", sdk.NewDec(5))}, pool.TotalAccumulatedRewards)
+	// the weighted value locked falls back to the value locked
+	require.Equal(t, pool.TotalValueLocked, pool.GetTotalWeightedValueLocked())
+}
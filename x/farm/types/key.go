@@ -39,6 +39,7 @@ var (
 	PoolsYieldNativeTokenPrefix = []byte{0x04}
 	PoolHistoricalRewardsPrefix = []byte{0x05}
 	PoolCurrentRewardsPrefix    = []byte{0x06}
+	LockExpiryQueuePrefix       = []byte{0x07}
 )
 
 const (
@@ -87,3 +88,19 @@ func GetPoolHistoricalRewardsPrefix(poolName string) []byte {
 func GetPoolCurrentRewardsKey(poolName string) []byte {
 	return append(PoolCurrentRewardsPrefix, []byte(poolName)...)
 }
+
+// GetLockExpiryQueueHeightPrefix gets the prefix key of the boosted locks expiring at the block height
+func GetLockExpiryQueueHeightPrefix(height int64) []byte {
+	return append(LockExpiryQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetLockExpiryQueueKey gets the key of a boosted lock in the lock expiry queue
+func GetLockExpiryQueueKey(height int64, addr sdk.AccAddress, poolName string) []byte {
+	return append(GetLockExpiryQueueHeightPrefix(height), append(addr.Bytes(), []byte(poolName)...)...)
+}
+
+// SplitLockExpiryQueueKey splits the address and the pool name out from a lock expiry queue key
+func SplitLockExpiryQueueKey(key []byte) (addr sdk.AccAddress, poolName string) {
+	addrIndex := len(LockExpiryQueuePrefix) + PeriodByteArrayLength
+	return key[addrIndex : addrIndex+sdk.AddrLen], string(key[addrIndex+sdk.AddrLen:])
+}
@@ -12,6 +12,10 @@ type LockInfo struct {
 	Amount           sdk.SysCoin    `json:"amount"`
 	StartBlockHeight int64          `json:"start_block_height"`
 	ReferencePeriod  uint64         `json:"reference_period"`
+	// Weight is the amount boosted by the lock duration, which the rewards are distributed by
+	Weight sdk.Dec `json:"weight"`
+	// UnlockHeight is the block height before which the locked tokens can't be unlocked
+	UnlockHeight int64 `json:"unlock_height"`
 }
 
 // NewLockInfo creates a new instance of LockInfo
@@ -22,9 +26,24 @@ func NewLockInfo(owner sdk.AccAddress, poolName string, amount sdk.SysCoin, star
 		Amount:           amount,
 		StartBlockHeight: startBlockHeight,
 		ReferencePeriod:  referencePeriod,
+		Weight:           amount.Amount,
 	}
 }
 
+// GetWeight returns the weight of the locked tokens, which is the locked amount for the lock info stored before
+// the weight is introduced
+func (li LockInfo) GetWeight() sdk.Dec {
+	if li.Weight.IsNil() || li.Weight.IsZero() {
+		return li.Amount.Amount
+	}
+	return li.Weight
+}
+
+// IsBoosted returns whether the weight of the lock info is boosted by the lock duration
+func (li LockInfo) IsBoosted() bool {
+	return li.GetWeight().GT(li.Amount.Amount)
+}
+
 // String returns a human readable string representation of LockInfo
 func (li LockInfo) String() string {
 	return fmt.Sprintf(`Lock Info:
@@ -32,6 +51,8 @@ func (li LockInfo) String() string {
   Pool Name:					%s
   Locked Amount:      			%s
   Start Block Height:           %d
-  Reference Period:             %d`,
-		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod)
+  Reference Period:             %d
+  Weight:                       %s
+  Unlock Height:                %d`,
+		li.Owner, li.PoolName, li.Amount, li.StartBlockHeight, li.ReferencePeriod, li.GetWeight(), li.UnlockHeight)
 }
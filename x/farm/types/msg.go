@@ -171,13 +171,20 @@ type MsgLock struct {
 	PoolName string         `json:"pool_name" yaml:"pool_name"`
 	Address  sdk.AccAddress `json:"address" yaml:"address"`
 	Amount   sdk.SysCoin    `json:"amount" yaml:"amount"`
+	// LockDuration is the number of blocks the tokens are locked for to boost the weight of the lock
+	LockDuration int64 `json:"lock_duration,omitempty" yaml:"lock_duration,omitempty"`
 }
 
 func NewMsgLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin) MsgLock {
+	return NewMsgLockWithDuration(poolName, address, amount, 0)
+}
+
+func NewMsgLockWithDuration(poolName string, address sdk.AccAddress, amount sdk.SysCoin, lockDuration int64) MsgLock {
 	return MsgLock{
-		PoolName: poolName,
-		Address:  address,
-		Amount:   amount,
+		PoolName:     poolName,
+		Address:      address,
+		Amount:       amount,
+		LockDuration: lockDuration,
 	}
 }
 
@@ -201,6 +208,9 @@ func (m MsgLock) ValidateBasic() sdk.Error {
 	if m.Amount.Amount.LTE(sdk.ZeroDec()) || !m.Amount.IsValid() {
 		return ErrInvalidInputAmount(m.Amount.Amount.String())
 	}
+	if m.LockDuration < 0 {
+		return ErrInvalidLockDuration(m.LockDuration)
+	}
 	return nil
 }
 
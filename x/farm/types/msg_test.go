@@ -162,39 +162,58 @@ func TestMsgProvide(t *testing.T) {
 
 func TestMsgLock(t *testing.T) {
 	tests := []struct {
-		poolName string
-		addr     sdk.AccAddress
-		amount   sdk.SysCoin
-		errCode  uint32
+		poolName     string
+		addr         sdk.AccAddress
+		amount       sdk.SysCoin
+		lockDuration int64
+		errCode      uint32
 	}{
 		{
 			"pool",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
+			sdk.CodeOK,
+		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			100,
 			sdk.CodeOK,
 		},
 		{
 			"pool",
 			nil,
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
 			CodeInvalidAddress,
 		},
 		{
 			"",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			0,
 			CodeInvalidInput,
 		},
 		{
 			"pool",
 			sdk.AccAddress{0x1},
 			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(0)),
+			0,
 			CodeInvalidInput,
 		},
+		{
+			"pool",
+			sdk.AccAddress{0x1},
+			sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			-1,
+			CodeInvalidLockDuration,
+		},
 	}
 
 	for _, test := range tests {
-		msg := NewMsgLock(test.poolName, test.addr, test.amount)
+		msg := NewMsgLockWithDuration(test.poolName, test.addr, test.amount, test.lockDuration)
 		require.Equal(t, lockMsgType, msg.Type())
 		require.Equal(t, ModuleName, msg.Route())
 		require.Equal(t, []sdk.AccAddress{test.addr}, msg.GetSigners())
@@ -207,6 +226,17 @@ func TestMsgLock(t *testing.T) {
 	}
 }
 
+func TestMsgLockSignBytesWithoutDuration(t *testing.T) {
+	// a lock without duration is signed the same as before the lock duration is introduced
+	msg := NewMsgLock("pool", sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)))
+	expected := `{"type":"okexchain/farm/MsgLock","value":{"address":"` + sdk.AccAddress{0x1}.String() +
+		`","amount":{"amount":"100.000000000000000000","denom":"xxb"},"pool_name":"pool"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	msg = NewMsgLockWithDuration("pool", sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)), 100)
+	require.Contains(t, string(msg.GetSignBytes()), `"lock_duration":"100"`)
+}
+
 func TestMsgUnlock(t *testing.T) {
 	tests := []struct {
 		poolName string
@@ -14,6 +14,9 @@ const (
 	defaultQuoteSymbol       = "usdk"
 	defaultCreatePoolFee     = "0"
 	defaultCreatePoolDeposit = "10"
+	// about one year with the block time of 3 seconds
+	defaultMaxLockDuration = 10512000
+	defaultMaxLockBoost    = "2.5"
 )
 
 // Parameter store keys
@@ -22,6 +25,11 @@ var (
 	KeyCreatePoolFee     = []byte("CreatePoolFee")
 	KeyCreatePoolDeposit = []byte("CreatePoolDeposit")
 	keyYieldNativeToken  = []byte("YieldNativeToken")
+	KeyMaxLockDuration   = []byte("MaxLockDuration")
+	KeyMaxLockBoost      = []byte("MaxLockBoost")
+
+	// OptionalParamKeys are the keys of the params added after the genesis, which are the defaults until set
+	OptionalParamKeys = [][]byte{KeyMaxLockDuration, KeyMaxLockBoost}
 )
 
 // ParamKeyTable for farm module
@@ -36,6 +44,10 @@ type Params struct {
 	CreatePoolDeposit sdk.SysCoin `json:"create_pool_deposit"`
 	// proposal params
 	YieldNativeToken bool `json:"yield_native_token"`
+	// the max number of blocks the tokens can be locked for
+	MaxLockDuration int64 `json:"max_lock_duration"`
+	// the boost of the weight of the tokens locked for the max lock duration
+	MaxLockBoost sdk.Dec `json:"max_lock_boost"`
 }
 
 // String implements the stringer interface for Params
@@ -44,8 +56,22 @@ func (p Params) String() string {
   Quote Symbol:								%s
   Create Pool Fee:							%s
   Create Pool Deposit:						%s
-  Yield Native Token Enabled:               %v`,
-		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken)
+  Yield Native Token Enabled:               %v
+  Max Lock Duration:						%d
+  Max Lock Boost:							%s`,
+		p.QuoteSymbol, p.CreatePoolFee, p.CreatePoolDeposit, p.YieldNativeToken, p.MaxLockDuration, p.MaxLockBoost)
+}
+
+// LockBoost returns the boost of the weight of the tokens locked for the duration, which grows linearly from 1 to
+// the max lock boost with the duration
+func (p Params) LockBoost(lockDuration int64) sdk.Dec {
+	if lockDuration <= 0 || p.MaxLockDuration <= 0 {
+		return sdk.OneDec()
+	}
+	if lockDuration > p.MaxLockDuration {
+		lockDuration = p.MaxLockDuration
+	}
+	return sdk.OneDec().Add(p.MaxLockBoost.Sub(sdk.OneDec()).MulInt64(lockDuration).QuoInt64(p.MaxLockDuration))
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -55,7 +81,20 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCreatePoolFee, Value: &p.CreatePoolFee, ValidatorFn: common.ValidateSysCoin("create pool fee")},
 		{Key: KeyCreatePoolDeposit, Value: &p.CreatePoolDeposit, ValidatorFn: common.ValidateSysCoin("create pool deposit")},
 		{Key: keyYieldNativeToken, Value: &p.YieldNativeToken, ValidatorFn: common.ValidateBool("yield native token")},
+		{Key: KeyMaxLockDuration, Value: &p.MaxLockDuration, ValidatorFn: common.ValidateInt64Positive("max lock duration")},
+		{Key: KeyMaxLockBoost, Value: &p.MaxLockBoost, ValidatorFn: validateMaxLockBoost},
+	}
+}
+
+func validateMaxLockBoost(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("max lock boost must be not less than 1: %s", v)
 	}
+	return nil
 }
 
 // DefaultParams defines the parameters for this module
@@ -65,5 +104,7 @@ func DefaultParams() Params {
 		CreatePoolFee:     sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolFee)),
 		CreatePoolDeposit: sdk.NewDecCoinFromDec(common.NativeToken, sdk.MustNewDecFromStr(defaultCreatePoolDeposit)),
 		YieldNativeToken:  false,
+		MaxLockDuration:   defaultMaxLockDuration,
+		MaxLockBoost:      sdk.MustNewDecFromStr(defaultMaxLockBoost),
 	}
 }
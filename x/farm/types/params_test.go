@@ -12,7 +12,9 @@ const (
   Quote Symbol:								usdk
   Create Pool Fee:							0.000000000000000000` + sdk.DefaultBondDenom + `
   Create Pool Deposit:						10.000000000000000000` + sdk.DefaultBondDenom + `
-  Yield Native Token Enabled:               false`
+  Yield Native Token Enabled:               false
+  Max Lock Duration:						10512000
+  Max Lock Boost:							2.500000000000000000`
 )
 
 func TestParams(t *testing.T) {
@@ -22,3 +24,15 @@ func TestParams(t *testing.T) {
 	require.Equal(t, defaultState.Params, defaultParams)
 	require.Equal(t, strExpected, defaultParams.String())
 }
+
+func TestParams_LockBoost(t *testing.T) {
+	params := DefaultParams()
+	params.MaxLockDuration = 100
+	params.MaxLockBoost = sdk.NewDecWithPrec(25, 1)
+
+	require.Equal(t, sdk.OneDec(), params.LockBoost(0))
+	require.Equal(t, sdk.OneDec(), params.LockBoost(-1))
+	require.Equal(t, sdk.NewDecWithPrec(175, 2), params.LockBoost(50))
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), params.LockBoost(100))
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), params.LockBoost(200))
+}
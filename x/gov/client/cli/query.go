@@ -552,7 +552,7 @@ $ %s query gov tally 1
 				return err
 			}
 
-			var tally types.TallyResult
+			var tally types.QueryTallyResult
 			cdc.MustUnmarshalJSON(res, &tally)
 			return cliCtx.PrintOutput(tally)
 		},
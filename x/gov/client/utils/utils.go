@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/okex/exchain/x/gov/types"
+import (
+	"strings"
+
+	"github.com/okex/exchain/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,17 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options, e.g. "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	optionStrs := strings.Split(options, ",")
+	for i, optionStr := range optionStrs {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		fields[0] = NormalizeVoteOption(fields[0])
+		optionStrs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(optionStrs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
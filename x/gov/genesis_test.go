@@ -19,7 +19,7 @@ func TestInitGenesisState(t *testing.T) {
 		{ProposalID: 1, Depositor: keeper.Addrs[0], Amount: initialDeposit},
 	}
 	votes := types.Votes{
-		{ProposalID: 2, Voter: keeper.Addrs[1], Option: types.OptionYes},
+		types.NewVote(2, keeper.Addrs[1], types.OptionYes),
 	}
 	proposals := types.Proposals{
 		types.Proposal{
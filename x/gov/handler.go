@@ -25,6 +25,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -103,12 +106,21 @@ func handleMsgDeposit(ctx sdk.Context, keeper keeper.Keeper, msg MsgDeposit) (*s
 }
 
 func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result, error) {
-	proposal, ok := k.GetProposal(ctx, msg.ProposalID)
+	return handleVote(ctx, k, msg.ProposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option))
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, k keeper.Keeper, msg MsgVoteWeighted) (*sdk.Result, error) {
+	return handleVote(ctx, k, msg.ProposalID, msg.Voter, msg.Options)
+}
+
+func handleVote(ctx sdk.Context, k keeper.Keeper, proposalID uint64, voter sdk.AccAddress,
+	options types.WeightedVoteOptions) (*sdk.Result, error) {
+	proposal, ok := k.GetProposal(ctx, proposalID)
 	if !ok {
-		return sdk.EnvelopedErr{types.ErrUnknownProposal(msg.ProposalID)}.Result()
+		return sdk.EnvelopedErr{types.ErrUnknownProposal(proposalID)}.Result()
 	}
 
-	err, _ := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err, _ := k.AddWeightedVote(ctx, proposalID, voter, options)
 	if err != nil {
 		return sdk.EnvelopedErr{err}.Result()
 	}
@@ -138,7 +150,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
 		),
 	)
@@ -144,16 +144,16 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, types.ErrUnknownProposal(proposalID)
 	}
 
-	var tallyResult types.TallyResult
+	var tallyResult types.QueryTallyResult
 
 	switch proposal.Status {
 	case types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult(keeper.totalPower(ctx))
+		tallyResult = types.NewQueryTallyResult(types.EmptyTallyResult(keeper.totalPower(ctx)), nil, nil)
 	case types.StatusPassed, types.StatusRejected, types.StatusFailed:
-		tallyResult = proposal.FinalTallyResult
+		tallyResult = types.NewQueryTallyResult(proposal.FinalTallyResult, nil, nil)
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = Tally(ctx, keeper, proposal, true)
+		tallyResult = queryTallyResult(ctx, keeper, proposal)
 	}
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, tallyResult)
@@ -163,7 +163,7 @@ func getQueriedVotes(
 
 func getQueriedTally(
 	t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, proposalID uint64,
-) types.TallyResult {
+) types.QueryTallyResult {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryTally}, "/"),
 		Data: cdc.MustMarshalJSON(types.NewQueryProposalParams(proposalID)),
@@ -173,7 +173,7 @@ func getQueriedTally(
 	require.Nil(t, err)
 	require.NotNil(t, bz)
 
-	var tally types.TallyResult
+	var tally types.QueryTallyResult
 	err2 := cdc.UnmarshalJSON(bz, &tally)
 	require.Nil(t, err2)
 	return tally
@@ -312,7 +312,7 @@ func TestQueries(t *testing.T) {
 	proposal2.FinalTallyResult = tallyResults
 	keeper.SetProposal(ctx, proposal2)
 	tally := getQueriedTally(t, ctx, cdc, querier, proposalID2)
-	require.Equal(t, tallyResults, tally)
+	require.Equal(t, types.NewQueryTallyResult(tallyResults, nil, nil), tally)
 
 	bz, err := querier(ctx, []string{""}, abci.RequestQuery{})
 	require.NotNil(t, err)
@@ -363,14 +363,18 @@ func TestQueryTally(t *testing.T) {
 	require.NotNil(t, err)
 	require.Nil(t, bz)
 
+	// the votes are broken down by the voters in voting period
 	expectedTally := newTallyResult(t, "1", "1", "0.0", "0.0", "0.0", "2")
-	require.Equal(t, expectedTally, getQueriedTally(t, ctx, cdc, querier, proposal.ProposalID))
+	validatorVotes := []types.VotePower{types.NewVotePower(Addrs[0], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1))}
+	require.Equal(t, types.NewQueryTallyResult(expectedTally, validatorVotes, nil),
+		getQueriedTally(t, ctx, cdc, querier, proposal.ProposalID))
 
-	// proposal passed
+	// proposal passed, only the totals are kept in the final tally result
 	proposal.Status = types.StatusPassed
 	proposal.FinalTallyResult = expectedTally
 	keeper.SetProposal(ctx, proposal)
-	require.Equal(t, expectedTally, getQueriedTally(t, ctx, cdc, querier, proposal.ProposalID))
+	require.Equal(t, types.NewQueryTallyResult(expectedTally, nil, nil),
+		getQueriedTally(t, ctx, cdc, querier, proposal.ProposalID))
 }
 
 func TestQueryParams(t *testing.T) {
@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                types.VoteOption     // Vote of the validator
+	Address             sdk.ValAddress            // address of the validator operator
+	BondedTokens        sdk.Int                   // Power of a Validator
+	DelegatorShares     sdk.Dec                   // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec                   // Delegator deductions from validator's delegators voting independently
+	Vote                types.WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote types.VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote types.WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -27,81 +27,140 @@ func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegator
 	}
 }
 
+// delegatorGovInfo used for tallying the votes of delegators
+type delegatorGovInfo struct {
+	Address            sdk.AccAddress            // address of the delegator
+	ValidatorAddresses []sdk.ValAddress          // validators that the shares are added to, which are the proxy's if bound
+	Shares             sdk.Dec                   // shares added to each of the validators on behalf of the delegator
+	Vote               types.WeightedVoteOptions // vote of the delegator
+}
+
 func tallyDelegatorVotes(
 	ctx sdk.Context, keeper Keeper, currValidators map[string]validatorGovInfo, proposalID uint64,
 	voteP *types.Vote, voterPower, totalVotedPower *sdk.Dec, results map[types.VoteOption]sdk.Dec,
-) {
+) (validatorVoters []sdk.ValAddress, delegatorVotes []types.VotePower) {
 	// iterate over all the votes
 	votesIterator := keeper.GetVotes(ctx, proposalID)
 	if voteP != nil {
 		votesIterator = append(votesIterator, *voteP)
 	}
+
+	var delegators []delegatorGovInfo
+	// shares of the bound delegators voting independently, which are deducted from the votes of their proxies
+	proxyDeductions := make(map[string]sdk.Dec)
 	for i := 0; i < len(votesIterator); i++ {
 		vote := votesIterator[i]
 
 		// if validator, just record it in the map
-		// if delegator tally voting power
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
-		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			delegation := keeper.sk.Delegator(ctx, vote.Voter)
-			if delegation == nil {
+		// if delegator, tally voting power after all the deductions of proxies are known
+		valAddr := sdk.ValAddress(vote.Voter)
+		if val, ok := currValidators[valAddr.String()]; ok {
+			if len(val.Vote) == 0 {
+				validatorVoters = append(validatorVoters, valAddr)
+			}
+			val.Vote = vote.GetOptions()
+			currValidators[valAddr.String()] = val
+			continue
+		}
+
+		delegator := keeper.sk.Delegator(ctx, vote.Voter)
+		if delegator == nil {
+			continue
+		}
+		info := delegatorGovInfo{
+			Address:            vote.Voter,
+			ValidatorAddresses: delegator.GetShareAddedValidatorAddresses(),
+			Shares:             delegator.GetLastAddedShares(),
+			Vote:               vote.GetOptions(),
+		}
+		// the tokens of a bound delegator are counted in the shares of its proxy, so take the part of them
+		if proxyAddr := delegator.GetProxyAddress(); proxyAddr != nil {
+			proxy := keeper.sk.Delegator(ctx, proxyAddr)
+			if proxy == nil || !proxy.GetTotalTokens().IsPositive() {
 				continue
 			}
-			for _, val := range delegation.GetShareAddedValidatorAddresses() {
-				valAddrStr := val.String()
-				if valInfo, ok := currValidators[valAddrStr]; ok {
-					valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(delegation.GetLastAddedShares())
-					currValidators[valAddrStr] = valInfo
-
-					votedPower := delegation.GetLastAddedShares()
-					// calculate vote power of delegator for voterPowerRate
-					if voteP != nil && vote.Voter.Equals(voteP.Voter) {
-						voterPower.Add(votedPower)
-					}
-					results[vote.Option] = results[vote.Option].Add(votedPower)
-					*totalVotedPower = totalVotedPower.Add(votedPower)
-				}
+			info.ValidatorAddresses = proxy.GetShareAddedValidatorAddresses()
+			info.Shares = proxy.GetLastAddedShares().MulTruncate(delegator.GetTokens()).QuoTruncate(proxy.GetTotalTokens())
+			deduction, ok := proxyDeductions[proxyAddr.String()]
+			if !ok {
+				deduction = sdk.ZeroDec()
 			}
+			proxyDeductions[proxyAddr.String()] = deduction.Add(info.Shares)
 		}
+		delegators = append(delegators, info)
 	}
+
+	// deduct the shares of delegators from any delegated-to validators
+	for _, del := range delegators {
+		shares := del.Shares
+		if deduction, ok := proxyDeductions[del.Address.String()]; ok {
+			shares = shares.Sub(deduction)
+		}
+
+		votedPower := sdk.ZeroDec()
+		for _, valAddr := range del.ValidatorAddresses {
+			valAddrStr := valAddr.String()
+			if valInfo, ok := currValidators[valAddrStr]; ok {
+				valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(shares)
+				currValidators[valAddrStr] = valInfo
+				votedPower = votedPower.Add(shares)
+			}
+		}
+
+		// calculate vote power of delegator for voterPowerRate
+		if voteP != nil && del.Address.Equals(voteP.Voter) {
+			*voterPower = voterPower.Add(votedPower)
+		}
+		addWeightedVotePower(results, del.Vote, votedPower)
+		*totalVotedPower = totalVotedPower.Add(votedPower)
+		delegatorVotes = append(delegatorVotes, types.NewVotePower(del.Address, del.Vote, votedPower))
+	}
+
+	return validatorVoters, delegatorVotes
 }
 
 func tallyValidatorVotes(
-	currValidators map[string]validatorGovInfo, voteP *types.Vote, voterPower,
+	currValidators map[string]validatorGovInfo, validatorVoters []sdk.ValAddress, voteP *types.Vote, voterPower,
 	totalPower, totalVotedPower *sdk.Dec, results map[types.VoteOption]sdk.Dec,
-) {
-	// iterate over the validators again to tally their voting power
-	for key, val := range currValidators {
-		// calculate all vote power of current validators including delegated for voterPowerRate
+) (validatorVotes []types.VotePower) {
+	// calculate all vote power of current validators including delegated for voterPowerRate
+	for _, val := range currValidators {
 		*totalPower = totalPower.Add(val.DelegatorShares)
-		if val.Vote == types.OptionEmpty {
-			continue
-		}
+	}
 
+	// iterate over the validators voted in order to tally their voting power
+	for _, valAddr := range validatorVoters {
+		val := currValidators[valAddr.String()]
 		valValidVotedPower := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		if voteP != nil && sdk.ValAddress(voteP.Voter).String() == key {
+		if voteP != nil && sdk.ValAddress(voteP.Voter).Equals(valAddr) {
 			// calculate vote power of validator after deduction for voterPowerRate
 			*voterPower = voterPower.Add(valValidVotedPower)
 		}
-		results[val.Vote] = results[val.Vote].Add(valValidVotedPower)
+		addWeightedVotePower(results, val.Vote, valValidVotedPower)
 		*totalVotedPower = totalVotedPower.Add(valValidVotedPower)
+		validatorVotes = append(validatorVotes, types.NewVotePower(sdk.AccAddress(valAddr), val.Vote, valValidVotedPower))
+	}
+
+	return validatorVotes
+}
+
+// addWeightedVotePower splits the voting power of a voter into the results by the weights of the options
+func addWeightedVotePower(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions, power sdk.Dec) {
+	for _, option := range options {
+		results[option.Option] = results[option.Option].Add(power.Mul(option.Weight))
 	}
 }
 
 func preTally(
 	ctx sdk.Context, keeper Keeper, proposal types.Proposal, voteP *types.Vote,
-) (results map[types.VoteOption]sdk.Dec, totalVotedPower sdk.Dec, voterPowerRate sdk.Dec) {
-	results = make(map[types.VoteOption]sdk.Dec)
+) (tallyResults types.TallyResult, validatorVotes, delegatorVotes []types.VotePower, voterPowerRate sdk.Dec) {
+	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotedPower = sdk.ZeroDec()
+	totalVotedPower := sdk.ZeroDec()
 	totalPower := sdk.ZeroDec()
 	voterPower := sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
@@ -113,23 +172,26 @@ func preTally(
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
 	})
 
-	tallyDelegatorVotes(ctx, keeper, currValidators, proposal.ProposalID,
+	validatorVoters, delegatorVotes := tallyDelegatorVotes(ctx, keeper, currValidators, proposal.ProposalID,
 		voteP, &voterPower, &totalVotedPower, results)
 
-	tallyValidatorVotes(currValidators, voteP, &voterPower, &totalPower, &totalVotedPower, results)
+	validatorVotes = tallyValidatorVotes(currValidators, validatorVoters, voteP, &voterPower, &totalPower,
+		&totalVotedPower, results)
 	if totalPower.GT(sdk.ZeroDec()) {
 		voterPowerRate = voterPower.Quo(totalPower)
 	} else {
 		voterPowerRate = sdk.ZeroDec()
 	}
 
-	return results, totalVotedPower, voterPowerRate
+	tallyResults = types.NewTallyResultFromMap(results)
+	tallyResults.TotalVotedPower = totalVotedPower
+	return tallyResults, validatorVotes, delegatorVotes, voterPowerRate
 }
 
 // tally and return status before voting period end time
@@ -201,10 +263,8 @@ func tallyStatusExpireVotePeriod(
 // Tally counts the votes for proposal
 func Tally(ctx sdk.Context, keeper Keeper, proposal types.Proposal, isExpireVoteEndTime bool,
 ) (types.ProposalStatus, bool, types.TallyResult) {
-	results, totalVotedPower, _ := preTally(ctx, keeper, proposal, nil)
-	tallyResults := types.NewTallyResultFromMap(results)
+	tallyResults, _, _, _ := preTally(ctx, keeper, proposal, nil)
 	tallyResults.TotalPower = keeper.totalPower(ctx)
-	tallyResults.TotalVotedPower = totalVotedPower
 
 	if isExpireVoteEndTime {
 		status, distribute := tallyStatusExpireVotePeriod(ctx, keeper, tallyResults)
@@ -213,3 +273,10 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal types.Proposal, isExpireVote
 	status, distribute := tallyStatusInVotePeriod(ctx, keeper, tallyResults)
 	return status, distribute, tallyResults
 }
+
+// queryTallyResult counts the votes for the proposal in voting period with the breakdown of the votes by the voters
+func queryTallyResult(ctx sdk.Context, keeper Keeper, proposal types.Proposal) types.QueryTallyResult {
+	tallyResults, validatorVotes, delegatorVotes, _ := preTally(ctx, keeper, proposal, nil)
+	tallyResults.TotalPower = keeper.totalPower(ctx)
+	return types.NewQueryTallyResult(tallyResults, validatorVotes, delegatorVotes)
+}
@@ -36,6 +36,14 @@ func newTallyResult(t *testing.T, totalVoted, yes, abstain, no, veto, totalVotin
 	}
 }
 
+// requireTallyVotes checks the breakdown of the queried tally result regardless of the order of the voters
+func requireTallyVotes(t *testing.T, expected types.TallyResult, validatorVotes, delegatorVotes []types.VotePower,
+	actual types.QueryTallyResult) {
+	require.Equal(t, expected, actual.GetTallyResult())
+	require.ElementsMatch(t, validatorVotes, actual.ValidatorVotes)
+	require.ElementsMatch(t, delegatorVotes, actual.DelegatorVotes)
+}
+
 func TestTallyNoBondedTokens(t *testing.T) {
 	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
 
@@ -265,10 +273,16 @@ func TestTallyDelegatorInherit(t *testing.T) {
 	//  2 vals -> OptionNo
 	//  1 val -> OptionYes
 	expectedTallyResult := newTallyResult(t, "11003", "11001", "0.0", "2", "0.0", "11003")
+	validatorVotes := []types.VotePower{
+		types.NewVotePower(Addrs[0], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[1], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[2], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(11001)),
+	}
 	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
 	require.False(t, dist)
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
+	requireTallyVotes(t, expectedTallyResult, validatorVotes, nil, queryTallyResult(ctx, keeper, proposal))
 }
 
 func TestTallyDelegatorOverride(t *testing.T) {
@@ -308,8 +322,128 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Nil(t, err)
 
 	expectedTallyResult := newTallyResult(t, "4", "3", "0.0", "1", "0.0", "4")
+	validatorVotes := []types.VotePower{
+		types.NewVotePower(Addrs[0], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[1], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[2], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+	}
+	delegatorVotes := []types.VotePower{types.NewVotePower(Addrs[3], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1))}
+	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusPassed, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+	requireTallyVotes(t, expectedTallyResult, validatorVotes, delegatorVotes, queryTallyResult(ctx, keeper, proposal))
+}
+
+func TestTallyProxyDelegatorOverride(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	// the proxy adds the shares of 6000 tokens to the 3rd validator, 5000 of which are delegated by 2 delegators
+	proxyAddr := Addrs[3]
+	for i, amount := range []int64{1000, 3000, 2000} {
+		_, err := stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3+i], sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(amount))))
+		require.Nil(t, err)
+	}
+	_, err := stakingHandler(ctx, staking.NewMsgRegProxy(proxyAddr, true))
+	require.Nil(t, err)
+	_, err = stakingHandler(ctx, staking.NewMsgAddShares(proxyAddr, []sdk.ValAddress{valAddrs[2]}))
+	require.Nil(t, err)
+	for _, addr := range Addrs[4:6] {
+		_, err = stakingHandler(ctx, staking.NewMsgBindProxy(addr, proxyAddr))
+		require.Nil(t, err)
+	}
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionNo)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionNo)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[2], types.OptionYes)
+	require.Nil(t, err)
+	// the bound delegator overrides the vote of its proxy, while the other one inherits it
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[4], types.OptionNo)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, proxyAddr, types.OptionAbstain)
+	require.Nil(t, err)
+
+	expectedTallyResult := newTallyResult(t, "6003", "1", "3000", "3002", "0.0", "6003")
+	validatorVotes := []types.VotePower{
+		types.NewVotePower(Addrs[0], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[1], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[2], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+	}
+	delegatorVotes := []types.VotePower{
+		types.NewVotePower(Addrs[4], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(3000)),
+		types.NewVotePower(proxyAddr, types.NewNonSplitVoteOption(types.OptionAbstain), sdk.NewDec(3000)),
+	}
+	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusRejected, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+	requireTallyVotes(t, expectedTallyResult, validatorVotes, delegatorVotes, queryTallyResult(ctx, keeper, proposal))
+}
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	_, err := stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3], sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(10000))))
+	require.Nil(t, err)
+	_, err = stakingHandler(ctx, staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{valAddrs[2]}))
+	require.Nil(t, err)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[2], types.OptionNo)
+	require.Nil(t, err)
+	// the delegator splits its voting power into weighted options
+	options, err := types.WeightedVoteOptionsFromString("Yes=0.6,No=0.3,NoWithVeto=0.1")
+	require.Nil(t, err)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[3], options)
+	require.Nil(t, err)
+
+	expectedTallyResult := newTallyResult(t, "10003", "6002", "0.0", "3001", "1000", "10003")
+	validatorVotes := []types.VotePower{
+		types.NewVotePower(Addrs[0], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[1], types.NewNonSplitVoteOption(types.OptionYes), sdk.NewDec(1)),
+		types.NewVotePower(Addrs[2], types.NewNonSplitVoteOption(types.OptionNo), sdk.NewDec(1)),
+	}
+	delegatorVotes := []types.VotePower{types.NewVotePower(Addrs[3], options, sdk.NewDec(10000))}
 	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
 	require.False(t, dist)
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
+	requireTallyVotes(t, expectedTallyResult, validatorVotes, delegatorVotes, queryTallyResult(ctx, keeper, proposal))
 }
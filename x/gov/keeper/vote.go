@@ -10,22 +10,30 @@ import (
 func (keeper Keeper) AddVote(
 	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption,
 ) (sdk.Error, string) {
-	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	return keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option))
+}
+
+// AddWeightedVote adds a vote with the voting power split into weighted options on a specific proposal
+func (keeper Keeper) AddWeightedVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) (sdk.Error, string) {
+	return keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options))
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote) (sdk.Error, string) {
+	proposal, ok := keeper.GetProposal(ctx, vote.ProposalID)
 	if !ok {
-		return types.ErrUnknownProposal(proposalID), ""
+		return types.ErrUnknownProposal(vote.ProposalID), ""
 	}
 	if proposal.Status != types.StatusVotingPeriod {
 		return types.ErrInvalidateProposalStatus(), ""
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(option), ""
+	if err := types.ValidateWeightedVoteOptions(vote.Options); err != nil {
+		return err, ""
 	}
 
 	voteFeeStr := ""
-	vote := types.Vote{
-		ProposalID: proposalID, Voter: voterAddr, Option: option,
-	}
 	if keeper.ProposalHandlerRouter().HasRoute(proposal.ProposalRoute()) {
 		var err sdk.Error
 		voteFeeStr, err = keeper.ProposalHandlerRouter().GetRoute(proposal.ProposalRoute()).VoteHandler(ctx, proposal, vote)
@@ -34,13 +42,13 @@ func (keeper Keeper) AddVote(
 		}
 	}
 
-	keeper.SetVote(ctx, proposalID, vote)
+	keeper.SetVote(ctx, vote.ProposalID, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, vote.Options.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalID)),
 		),
 	)
 
@@ -93,8 +101,15 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return vote, false
 	}
 
+	return keeper.mustUnmarshalVote(bz), true
+}
+
+// mustUnmarshalVote decodes a vote from store, and the single option of the votes stored before the weighted votes
+// is decoded as the option with the full weight
+func (keeper Keeper) mustUnmarshalVote(bz []byte) (vote types.Vote) {
 	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
-	return vote, true
+	vote.Options = vote.GetOptions()
+	return vote
 }
 
 func (keeper Keeper) setVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, vote types.Vote) {
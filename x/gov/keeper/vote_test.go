@@ -48,7 +48,7 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", votefee)
 	vote, ok := keeper.GetVote(ctx, proposalID, Addrs[0])
-	expectedVote := types.Vote{ProposalID: proposalID, Voter: Addrs[0], Option: types.OptionYes}
+	expectedVote := types.NewVote(proposalID, Addrs[0], types.OptionYes)
 	require.True(t, ok)
 	require.Equal(t, expectedVote, vote)
 
@@ -56,7 +56,7 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", votefee)
 	vote, ok = keeper.GetVote(ctx, proposalID, Addrs[0])
-	expectedVote = types.Vote{ProposalID: proposalID, Voter: Addrs[0], Option: types.OptionNo}
+	expectedVote = types.NewVote(proposalID, Addrs[0], types.OptionNo)
 	require.True(t, ok)
 	require.Equal(t, expectedVote, vote)
 }
@@ -92,6 +92,43 @@ func TestKeeper_GetVote(t *testing.T) {
 	require.True(t, vote.Equals(expectedVote))
 }
 
+func TestKeeper_AddWeightedVote(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// the weights must sum to one without duplicate options
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.NotNil(t, err)
+	options = append(options, types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(1, 1)))
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.NotNil(t, err)
+
+	options[2].Option = types.OptionAbstain
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.Nil(t, err)
+	vote, found := keeper.GetVote(ctx, proposalID, Addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, vote.Equals(types.NewWeightedVote(proposalID, Addrs[0], options)))
+
+	// the single-option vote stored before the weighted votes is decoded with the full weight
+	keeper.SetVote(ctx, proposalID, types.Vote{ProposalID: proposalID, Voter: Addrs[1], Option: types.OptionNo})
+	vote, found = keeper.GetVote(ctx, proposalID, Addrs[1])
+	require.True(t, found)
+	require.Equal(t, types.NewVote(proposalID, Addrs[1], types.OptionNo), vote)
+	require.Equal(t, 2, len(keeper.GetVotes(ctx, proposalID)))
+}
+
 func TestKeeper_GetVotes(t *testing.T) {
 	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
 
@@ -113,16 +150,8 @@ func TestKeeper_GetVotes(t *testing.T) {
 	require.Equal(t, "", voteFee)
 
 	expectedVotes := types.Votes{
-		{
-			ProposalID: proposalID,
-			Voter:      Addrs[1],
-			Option:     types.OptionYes,
-		},
-		{
-			ProposalID: proposalID,
-			Voter:      Addrs[2],
-			Option:     types.OptionNo,
-		},
+		types.NewVote(proposalID, Addrs[1], types.OptionYes),
+		types.NewVote(proposalID, Addrs[2], types.OptionNo),
 	}
 	votes := keeper.GetVotes(ctx, proposalID)
 	require.Equal(t, expectedVotes, votes)
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
@@ -81,3 +84,62 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// Result of query 'custom/gov/tally', which breaks the tally result down by the voters while the proposal is in voting
+// period. The breakdown isn't kept in the final tally result of the proposal
+type QueryTallyResult struct {
+	TotalPower      sdk.Dec `json:"total_power"`
+	TotalVotedPower sdk.Dec `json:"total_voted_power"`
+	Yes             sdk.Dec `json:"yes"`
+	Abstain         sdk.Dec `json:"abstain"`
+	No              sdk.Dec `json:"no"`
+	NoWithVeto      sdk.Dec `json:"no_with_veto"`
+	// voting power of the validators who has voted, excluding the shares of their delegators voting independently
+	ValidatorVotes []VotePower `json:"validator_votes,omitempty"`
+	// voting power of the delegators who has voted, deducted from the validators they added shares to
+	DelegatorVotes []VotePower `json:"delegator_votes,omitempty"`
+}
+
+// creates a new instance of QueryTallyResult
+func NewQueryTallyResult(tr TallyResult, validatorVotes, delegatorVotes []VotePower) QueryTallyResult {
+	return QueryTallyResult{
+		TotalPower:      tr.TotalPower,
+		TotalVotedPower: tr.TotalVotedPower,
+		Yes:             tr.Yes,
+		Abstain:         tr.Abstain,
+		No:              tr.No,
+		NoWithVeto:      tr.NoWithVeto,
+		ValidatorVotes:  validatorVotes,
+		DelegatorVotes:  delegatorVotes,
+	}
+}
+
+// GetTallyResult returns the tally result without the breakdown
+func (qtr QueryTallyResult) GetTallyResult() TallyResult {
+	return TallyResult{
+		TotalPower:      qtr.TotalPower,
+		TotalVotedPower: qtr.TotalVotedPower,
+		Yes:             qtr.Yes,
+		Abstain:         qtr.Abstain,
+		No:              qtr.No,
+		NoWithVeto:      qtr.NoWithVeto,
+	}
+}
+
+func (qtr QueryTallyResult) String() string {
+	var b strings.Builder
+	b.WriteString(qtr.GetTallyResult().String())
+	if len(qtr.ValidatorVotes) != 0 {
+		b.WriteString("\n  Validator Votes:")
+		for _, vp := range qtr.ValidatorVotes {
+			b.WriteString(fmt.Sprintf("\n    %s: %s %s", vp.Voter, vp.Options, vp.Power))
+		}
+	}
+	if len(qtr.DelegatorVotes) != 0 {
+		b.WriteString("\n  Delegator Votes:")
+		for _, vp := range qtr.DelegatorVotes {
+			b.WriteString(fmt.Sprintf("\n    %s: %s %s", vp.Voter, vp.Options, vp.Power))
+		}
+	}
+	return b.String()
+}
@@ -3,24 +3,44 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter, empty if the vote is split
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options chosen by the voter
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, NewNonSplitVoteOption(option)}
+}
+
+// NewWeightedVote creates a new Vote instance with the voting power split into weighted options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalID: proposalID, Voter: voter, Options: options}
+	if len(options) == 1 {
+		vote.Option = options[0].Option
+	}
+	return vote
+}
+
+// GetOptions returns the weighted options of the vote. The votes stored before the weighted votes have the single
+// option only, which is decoded as the option with the full weight
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +52,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +61,7 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.GetOptions().Equals(comp.GetOptions())
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +69,123 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption is an option chosen by the voter with the weight of the voting power given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is the options of a vote with the weights summing to one
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the options of a vote giving all the voting power to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface. The non-split option is represented by the option only
+func (options WeightedVoteOptions) String() string {
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		return options[0].Option.String()
+	}
+
+	strs := make([]string, len(options))
+	for i, option := range options {
+		strs[i] = option.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Equals returns whether two weighted options are equal.
+func (options WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(options) != len(comp) {
+		return false
+	}
+	for i := range options {
+		if options[i].Option != comp[i].Option || !options[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateWeightedVoteOptions returns an error if any option is invalid or duplicate, or the weights don't sum to one
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) sdk.Error {
+	if len(options) == 0 {
+		return ErrInvalidWeightedVote("no vote option")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) {
+			return ErrInvalidVote(option.Option)
+		}
+		if usedOptions[option.Option] {
+			return ErrInvalidWeightedVote(fmt.Sprintf("duplicate vote option %s", option.Option))
+		}
+		usedOptions[option.Option] = true
+
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return ErrInvalidWeightedVote(fmt.Sprintf("weight of vote option %s must be in (0, 1]", option.Option))
+		}
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return ErrInvalidWeightedVote(fmt.Sprintf("total weight %s of vote options must be 1", totalWeight))
+	}
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns the weighted options from a string as "Yes=0.6,No=0.3,Abstain=0.1". A single
+// option without the weight is given the full weight
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight := sdk.OneDec()
+		switch len(fields) {
+		case 1:
+		case 2:
+			if weight, err = sdk.NewDecFromStr(fields[1]); err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid weight of vote option: %s", fields[1], err)
+			}
+		default:
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", optionStr)
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// VotePower is the voting power of a voter counted in the tally
+type VotePower struct {
+	Voter   sdk.AccAddress      `json:"voter" yaml:"voter"`
+	Options WeightedVoteOptions `json:"options" yaml:"options"`
+	Power   sdk.Dec             `json:"power" yaml:"power"`
+}
+
+// NewVotePower creates a new VotePower instance
+func NewVotePower(voter sdk.AccAddress, options WeightedVoteOptions, power sdk.Dec) VotePower {
+	return VotePower{voter, options, power}
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
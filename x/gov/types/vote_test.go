@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerror "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedVoteOptions(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.3, Abstain=0.1")
+	require.Nil(t, err)
+	require.Nil(t, ValidateWeightedVoteOptions(options))
+	require.Equal(t, "Yes=0.600000000000000000,No=0.300000000000000000,Abstain=0.100000000000000000", options.String())
+
+	// a single option without the weight is given the full weight
+	options, err = WeightedVoteOptionsFromString("NoWithVeto")
+	require.Nil(t, err)
+	require.True(t, options.Equals(NewNonSplitVoteOption(OptionNoWithVeto)))
+	require.Equal(t, "NoWithVeto", options.String())
+
+	_, err = WeightedVoteOptionsFromString("Yes=0.5,Maybe=0.5")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=half")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=0.5=0.5")
+	require.NotNil(t, err)
+
+	tests := []struct {
+		options      string
+		expectedCode uint32
+	}{
+		{"Yes=0.5,No=0.5", 0},
+		{"Yes=0.5,No=0.4", CodeInvalidWeightedVote},
+		{"Yes=0.5,No=0.6", CodeInvalidWeightedVote},
+		{"Yes=0.5,Yes=0.5", CodeInvalidWeightedVote},
+		{"Yes=1.5,No=-0.5", CodeInvalidWeightedVote},
+		{"Yes=1,No=0", CodeInvalidWeightedVote},
+	}
+	for _, test := range tests {
+		options, err := WeightedVoteOptionsFromString(test.options)
+		require.Nil(t, err)
+		err = ValidateWeightedVoteOptions(options)
+		if test.expectedCode == 0 {
+			require.Nil(t, err, test.options)
+		} else {
+			require.Equal(t, test.expectedCode, err.(*sdkerror.Error).ABCICode(), test.options)
+		}
+	}
+	require.NotNil(t, ValidateWeightedVoteOptions(nil))
+	require.Equal(t, CodeInvalidVote,
+		ValidateWeightedVoteOptions(NewNonSplitVoteOption(OptionEmpty)).(*sdkerror.Error).ABCICode())
+}
+
+func TestMsgVoteWeighted(t *testing.T) {
+	voter := sdk.AccAddress([]byte("voter_______________"))
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}
+	msg := NewMsgVoteWeighted(voter, 1, options)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, TypeMsgVoteWeighted, msg.Type())
+	require.Equal(t, []sdk.AccAddress{voter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NotNil(t, NewMsgVoteWeighted(nil, 1, options).ValidateBasic())
+	require.NotNil(t, NewMsgVoteWeighted(voter, 1, options[:1]).ValidateBasic())
+
+	// the weighted vote with a single option is the same as the single-option vote
+	vote := NewWeightedVote(1, voter, NewNonSplitVoteOption(OptionNo))
+	require.Equal(t, NewVote(1, voter, OptionNo), vote)
+	require.Equal(t, OptionEmpty, NewWeightedVote(1, voter, options).Option)
+}
@@ -19,27 +19,32 @@ const (
 // nolint
 // types aliases
 type (
-	Keeper           = keeper.Keeper
-	Order            = types.Order
-	DepthBook        = types.DepthBook
-	MatchResult      = types.MatchResult
-	Deal             = types.Deal
-	Params           = types.Params
-	MsgNewOrder      = types.MsgNewOrder
-	MsgCancelOrder   = types.MsgCancelOrder
-	MsgNewOrders     = types.MsgNewOrders
-	MsgCancelOrders  = types.MsgCancelOrders
-	BlockMatchResult = types.BlockMatchResult
+	Keeper                = keeper.Keeper
+	Order                 = types.Order
+	TriggerOrder          = types.TriggerOrder
+	DepthBook             = types.DepthBook
+	MatchResult           = types.MatchResult
+	Deal                  = types.Deal
+	Params                = types.Params
+	MsgNewOrder           = types.MsgNewOrder
+	MsgCancelOrder        = types.MsgCancelOrder
+	MsgNewOrders          = types.MsgNewOrders
+	MsgCancelOrders       = types.MsgCancelOrders
+	MsgNewTriggerOrder    = types.MsgNewTriggerOrder
+	MsgCancelTriggerOrder = types.MsgCancelTriggerOrder
+	BlockMatchResult      = types.BlockMatchResult
 )
 
 // nolint
 // functions aliases
 var (
-	RegisterCodec     = types.RegisterCodec
-	DefaultParams     = types.DefaultParams
-	NewMsgNewOrder    = types.NewMsgNewOrder
-	NewMsgCancelOrder = types.NewMsgCancelOrder
-	NewKeeper         = keeper.NewKeeper
-	NewQuerier        = keeper.NewQuerier
-	FormatOrderIDsKey = types.FormatOrderIDsKey
+	RegisterCodec            = types.RegisterCodec
+	DefaultParams            = types.DefaultParams
+	NewMsgNewOrder           = types.NewMsgNewOrder
+	NewMsgCancelOrder        = types.NewMsgCancelOrder
+	NewMsgNewTriggerOrder    = types.NewMsgNewTriggerOrder
+	NewMsgCancelTriggerOrder = types.NewMsgCancelTriggerOrder
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	FormatOrderIDsKey        = types.FormatOrderIDsKey
 )
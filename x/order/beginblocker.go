@@ -10,10 +10,11 @@ import (
 )
 
 // BeginBlocker runs the logic of BeginBlocker with version 0.
-// BeginBlocker resets keeper cache.
+// BeginBlocker resets keeper cache, and activates the trigger orders reached by the last prices.
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	seq := perf.GetPerf().OnBeginBlockEnter(ctx, types.ModuleName)
 	defer perf.GetPerf().OnBeginBlockExit(ctx, types.ModuleName, seq)
 
 	keeper.ResetCache(ctx)
+	activateTriggerOrders(ctx, keeper)
 }
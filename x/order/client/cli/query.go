@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDepthBook(queryRoute, cdc),
 		GetCmdQueryStore(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryTriggerOrder(queryRoute, cdc),
+		GetCmdQueryTriggerOrders(queryRoute, cdc),
 	)...)
 
 	queryCmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
@@ -55,6 +57,64 @@ func GetCmdQueryOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryTriggerOrder queries trigger order info by triggerOrderID
+func GetCmdQueryTriggerOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trigger-detail [trigger-order-id]",
+		Short: "Query a trigger order which is not triggered yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			triggerOrderID := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTriggerOrder, triggerOrderID),
+				nil)
+			if err != nil {
+				fmt.Printf("trigger order does not exist - %s \n", triggerOrderID)
+				return nil
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryTriggerOrders queries the trigger book of a product
+func GetCmdQueryTriggerOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-orders [product]",
+		Short: "Query the trigger orders of a trading pair",
+		Long: strings.TrimSpace(`Query the trigger orders of a trading pair which are not triggered yet:
+
+$ exchaincli query order trigger-orders mytoken_okt --address okexchain1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			product := args[0]
+			params := keeper.NewQueryTriggerOrdersParams(product, viper.GetString("address"))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTriggerOrders),
+				bz)
+			if err != nil {
+				fmt.Printf("get trigger orders of %s failed: %v\n", product, err.Error())
+				return nil
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String("address", "", "only query the trigger orders of the address")
+	return cmd
+}
+
 // GetCmdDepthBook queries order book about a product
 func GetCmdDepthBook(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -10,8 +10,11 @@ import (
 
 // GenesisState - all order state that must be provided at genesis
 type GenesisState struct {
-	Params     types.Params   `json:"params"`
-	OpenOrders []*types.Order `json:"open_orders"`
+	Params        types.Params          `json:"params"`
+	OpenOrders    []*types.Order        `json:"open_orders"`
+	TriggerOrders []*types.TriggerOrder `json:"trigger_orders"`
+	// sequence of the last trigger order id, which keeps the ids of new trigger orders unique
+	TriggerOrderSeq int64 `json:"trigger_order_seq"`
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
@@ -54,6 +57,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 	if len(data.OpenOrders) > 0 {
 		keeper.Cache2Disk(ctx)
 	}
+
+	for _, triggerOrder := range data.TriggerOrders {
+		if triggerOrder == nil {
+			panic("the nil pointer is not expected")
+		}
+		keeper.SetTriggerOrder(ctx, triggerOrder)
+	}
+	keeper.SetTriggerOrderSeq(ctx, data.TriggerOrderSeq)
 }
 
 // ExportGenesis writes the current store values
@@ -110,7 +121,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data GenesisState) {
 	}
 
 	return GenesisState{
-		Params:     *params,
-		OpenOrders: openOrders,
+		Params:          *params,
+		OpenOrders:      openOrders,
+		TriggerOrders:   keeper.GetAllTriggerOrders(ctx),
+		TriggerOrderSeq: keeper.GetTriggerOrderSeq(ctx),
 	}
 }
@@ -148,3 +148,35 @@ func TestExportGenesis(t *testing.T) {
 	// 0x20
 	require.Equal(t, int64(2), newOrderKeeper.GetStoreOrderNum(newCtx))
 }
+
+func TestExportGenesisTriggerOrders(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	orderKeeper := testInput.OrderKeeper
+
+	newTriggerOrder := func() *types.TriggerOrder {
+		return types.NewTriggerOrder("", testInput.TestAddrs[0], types.TestTokenPair, types.SellOrder,
+			types.TriggerTypeStopLoss, sdk.MustNewDecFromStr("9.0"), sdk.ZeroDec(), sdk.OneDec(), types.OrderTypeMarket, 0)
+	}
+	order1 := newTriggerOrder()
+	orderKeeper.PlaceTriggerOrder(ctx, order1)
+	order2 := newTriggerOrder()
+	orderKeeper.PlaceTriggerOrder(ctx, order2)
+	orderKeeper.RemoveTriggerOrder(ctx, order2)
+
+	exportGenesis := ExportGenesis(ctx, orderKeeper)
+	require.Equal(t, []*types.TriggerOrder{order1}, exportGenesis.TriggerOrders)
+	require.Equal(t, int64(2), exportGenesis.TriggerOrderSeq)
+
+	newTestInput := keeper.CreateTestInput(t)
+	newCtx := newTestInput.Ctx.WithBlockHeight(10)
+	newOrderKeeper := newTestInput.OrderKeeper
+	InitGenesis(newCtx, newOrderKeeper, exportGenesis)
+	require.Equal(t, order1, newOrderKeeper.GetTriggerOrder(newCtx, order1.TriggerOrderID))
+
+	// the ids of new trigger orders don't collide with the exported ones
+	order3 := newTriggerOrder()
+	newOrderKeeper.PlaceTriggerOrder(newCtx, order3)
+	require.NotEqual(t, order1.TriggerOrderID, order3.TriggerOrderID)
+	require.NotEqual(t, order2.TriggerOrderID, order3.TriggerOrderID)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/common/perf"
 	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/match/continuousauction"
 	"github.com/okex/exchain/x/order/types"
 	"github.com/okex/exchain/libs/tendermint/crypto/tmhash"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
@@ -23,6 +24,10 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	case types.MsgCancelOrders:
 		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgNewTriggerOrder:
+		gas = params.NewOrderMsgGasUnit
+	case types.MsgCancelTriggerOrder:
+		gas = params.CancelOrderMsgGasUnit
 	default:
 		gas = math.MaxUint64
 	}
@@ -65,6 +70,16 @@ func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgCancelOrders(ctx, keeper, msg, logger)
 			}
+		case types.MsgNewTriggerOrder:
+			name = "handleMsgNewTriggerOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgNewTriggerOrder(ctx, keeper, msg, logger)
+			}
+		case types.MsgCancelTriggerOrder:
+			name = "handleMsgCancelTriggerOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCancelTriggerOrder(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Invalid msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -108,6 +123,26 @@ func checkOrderNewMsg(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgNewOrd
 	if msg.Quantity.LT(tokenPair.MinQuantity) {
 		return types.ErrMsgQuantityLessThan(tokenPair.MinQuantity.String())
 	}
+
+	// post only orders are only allowed to add liquidity to the depth book
+	if msg.OrderType == types.OrderTypePostOnly &&
+		keeper.GetDepthBookCopy(msg.Product).AvailableQuantity(msg.Side, msg.Price).IsPositive() {
+		return types.ErrPostOnlyOrderWouldMatch(msg.Price)
+	}
+	return nil
+}
+
+// priceMarketOrder sets the price of a market order to the worst price it needs to be filled
+// by the opposite side of the depth book
+func priceMarketOrder(keeper keeper.Keeper, msg *types.MsgNewOrder) error {
+	if msg.OrderType != types.OrderTypeMarket {
+		return nil
+	}
+	price, ok := keeper.GetDepthBookCopy(msg.Product).WorstPriceToFill(msg.Side, msg.Quantity)
+	if !ok {
+		return types.ErrNoLiquidityForMarketOrder(msg.Product)
+	}
+	msg.Price = price
 	return nil
 }
 
@@ -115,7 +150,7 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 	feeParams := k.GetParams(ctx)
 	feePerBlockAmount := feeParams.FeePerBlock.Amount.Mul(sdk.MustNewDecFromStr(ratio))
 	feePerBlock := sdk.NewDecCoinFromDec(feeParams.FeePerBlock.Denom, feePerBlockAmount)
-	return types.NewOrder(
+	order := types.NewOrder(
 		fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
 		msg.Sender,
 		msg.Product,
@@ -126,6 +161,8 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 		feeParams.OrderExpireBlocks,
 		feePerBlock,
 	)
+	order.OrderType = msg.OrderType
+	return order
 }
 
 func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
@@ -134,20 +171,27 @@ func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
 	cacheItem := ctx.MultiStore().CacheMultiStore()
 	ctxItem := ctx.WithMultiStore(cacheItem)
 	msg := MsgNewOrder{
-		Sender:   sender,
-		Product:  item.Product,
-		Side:     item.Side,
-		Price:    item.Price,
-		Quantity: item.Quantity,
+		Sender:    sender,
+		Product:   item.Product,
+		Side:      item.Side,
+		Price:     item.Price,
+		Quantity:  item.Quantity,
+		OrderType: item.OrderType,
 	}
-	order := getOrderFromMsg(ctxItem, k, msg, ratio)
 	err := checkOrderNewMsg(ctxItem, k, msg)
+	if err == nil {
+		err = priceMarketOrder(k, &msg)
+	}
+	order := getOrderFromMsg(ctxItem, k, msg, ratio)
 
 	if err == nil {
 		if k.IsProductLocked(ctx, msg.Product) {
 			err = types.ErrIsProductLocked(order.Product)
 		} else {
 			err = k.PlaceOrder(ctxItem, order)
+			if err == nil && k.IsContinuousProduct(ctxItem, order.Product) {
+				continuousauction.MatchNewOrder(ctxItem, k, order, logger)
+			}
 		}
 	}
 
@@ -217,16 +261,20 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 
 	for _, item := range msg.OrderItems {
 		msg := MsgNewOrder{
-			Sender:   msg.Sender,
-			Product:  item.Product,
-			Side:     item.Side,
-			Price:    item.Price,
-			Quantity: item.Quantity,
+			Sender:    msg.Sender,
+			Product:   item.Product,
+			Side:      item.Side,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+			OrderType: item.OrderType,
 		}
 		err := checkOrderNewMsg(ctx, k, msg)
 		if err != nil {
 			return nil, err
 		}
+		if err = priceMarketOrder(k, &msg); err != nil {
+			return nil, err
+		}
 		if k.IsProductLocked(ctx, msg.Product) {
 			return types.ErrIsProductLocked(msg.Product).Result()
 		}
@@ -17,6 +17,9 @@ type TokenKeeper interface {
 	UnlockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error
 	BalanceAccount(ctx sdk.Context, addr sdk.AccAddress, outputCoins sdk.SysCoins, inputCoins sdk.SysCoins) error
 	SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.SysCoins) error
+	// Token controls
+	GetTokenInfo(ctx sdk.Context, symbol string) token.Token
+	CheckCoinsTransferable(ctx sdk.Context, from sdk.AccAddress, coins sdk.SysCoins) error
 	// Fee detail
 	AddFeeDetail(ctx sdk.Context, from string, fee sdk.SysCoins, feeType string, receiver string)
 	GetAllLockedCoins(ctx sdk.Context) (locks []token.AccCoins)
@@ -49,6 +49,19 @@ func GetZeroFee() sdk.SysCoins {
 // GetDealFee is used to calculate the handling fee when matching an order
 func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper GetFeeKeeper,
 	feeParams *types.Params) sdk.SysCoins {
+	dealFee := GetDealFeeByRate(order, fillAmt, ctx, keeper, feeParams.TradeFeeRate)
+
+	minFeeDec := sdk.MustNewDecFromStr(minFee)
+	if dealFee[0].Amount.GT(minFeeDec) {
+		return dealFee
+	}
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(dealFee[0].Denom, minFeeDec)}
+}
+
+// GetDealFeeByRate is used to calculate the handling fee or the rebate with the fee rate when matching an order.
+// The fee is valued in the token received by the order, and the absolute value of a negative fee rate is used
+func GetDealFeeByRate(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper GetFeeKeeper,
+	feeRate sdk.Dec) sdk.SysCoins {
 	symbols := strings.Split(order.Product, "_")
 	symbol := symbols[0]
 	quantity := fillAmt
@@ -57,10 +70,31 @@ func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper Get
 		quantity = fillAmt.Mul(keeper.GetLastPrice(ctx, order.Product))
 	}
 
-	minFeeDec := sdk.MustNewDecFromStr(minFee)
-	feeAmt := quantity.Mul(feeParams.TradeFeeRate)
-	if feeAmt.GT(minFeeDec) {
-		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, feeAmt)}
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, quantity.Mul(feeRate.Abs()))}
+}
+
+// GetDealFeeRate gets the fee rate charged on the maker or taker side of the deals of the product.
+// The fee rates set on the token pair override the ones set on its dex operator, and the trade fee rate is
+// charged on both sides if neither of them is set, in which case false is returned
+func (k Keeper) GetDealFeeRate(ctx sdk.Context, product string, isMaker bool,
+	feeParams *types.Params) (feeRate sdk.Dec, scheduled bool) {
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
+	if tokenPair == nil {
+		return feeParams.TradeFeeRate, false
+	}
+
+	feeRates := tokenPair.FeeRates
+	if feeRates == nil {
+		if operator, exists := k.GetDexKeeper().GetOperator(ctx, tokenPair.Owner); exists {
+			feeRates = operator.FeeRates
+		}
+	}
+	if feeRates == nil {
+		return feeParams.TradeFeeRate, false
+	}
+
+	if isMaker {
+		return feeRates.MakerFeeRate, true
 	}
-	return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, minFeeDec)}
+	return feeRates.TakerFeeRate, true
 }
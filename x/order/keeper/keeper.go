@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"log"
+	"strings"
 	"sync"
 
 	"github.com/willf/bitset"
@@ -351,6 +352,28 @@ func (k Keeper) GetLastClosedOrderIDs(ctx sdk.Context) []string {
 	return orderIDs
 }
 
+// GetImmediateOrderIDs gets the ids of market, IOC and FOK orders which will be expired after matching
+func (k Keeper) GetImmediateOrderIDs(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.ImmediateOrderIDsKey)
+	orderIDs := []string{}
+	if bz == nil {
+		return orderIDs
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &orderIDs)
+	return orderIDs
+}
+
+// SetImmediateOrderIDs sets the ids of market, IOC and FOK orders which will be expired after matching
+func (k Keeper) SetImmediateOrderIDs(ctx sdk.Context, orderIDs []string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if len(orderIDs) == 0 {
+		store.Delete(types.ImmediateOrderIDsKey)
+		return
+	}
+	store.Set(types.ImmediateOrderIDsKey, k.cdc.MustMarshalBinaryBare(orderIDs))
+}
+
 // nolint
 func (k Keeper) GetBlockMatchResult() *types.BlockMatchResult {
 	return k.cache.getBlockMatchResult()
@@ -363,6 +386,37 @@ func (k Keeper) SetBlockMatchResult(result *types.BlockMatchResult) {
 	}
 }
 
+// AddContinuousMatchResult records the deals matched by the continuous auction engine in this block,
+// the export to the backend is gated by SetBlockMatchResult
+func (k Keeper) AddContinuousMatchResult(product string, result types.MatchResult) {
+	k.cache.addContinuousMatchResult(product, result)
+}
+
+// GetContinuousMatchResults gets the match results of continuous auction products in this block
+func (k Keeper) GetContinuousMatchResults() map[string]types.MatchResult {
+	return k.cache.getContinuousMatchResults()
+}
+
+// IsProductControllable returns true if the base or quote token of the product is controllable by its owner
+func (k Keeper) IsProductControllable(ctx sdk.Context, product string) bool {
+	for _, symbol := range strings.Split(product, "_") {
+		if k.tokenKeeper.GetTokenInfo(ctx, symbol).Controllable {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckOrderTransferable checks whether the coins locked by the order can be transferred out of the sender
+func (k Keeper) CheckOrderTransferable(ctx sdk.Context, order *types.Order) error {
+	return k.tokenKeeper.CheckCoinsTransferable(ctx, order.Sender, order.NeedUnlockCoins())
+}
+
+// IsContinuousProduct returns true if the product is matched by the continuous auction engine
+func (k Keeper) IsContinuousProduct(ctx sdk.Context, product string) bool {
+	return k.GetParams(ctx).IsContinuousProduct(product)
+}
+
 // LockCoins locks coins from the specified address,
 func (k Keeper) LockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error {
 	if coins.IsZero() {
@@ -436,6 +490,24 @@ func (k Keeper) SendFeesToProductOwner(ctx sdk.Context, coins sdk.SysCoins, from
 	return to.String(), nil
 }
 
+// PayRebateFromProductOwner pays the rebate to the maker from the fee receiver of the product
+func (k Keeper) PayRebateFromProductOwner(ctx sdk.Context, coins sdk.SysCoins, to sdk.AccAddress,
+	product string) (payer string, err error) {
+	if coins.IsZero() {
+		return "", nil
+	}
+	from, err := k.GetProductFeeReceiver(ctx, product)
+	if err != nil {
+		return "", err
+	}
+	if err := k.tokenKeeper.SendCoinsFromAccountToAccount(ctx, from, to, coins); err != nil {
+		log.Printf("Pay rebate(%s) from address(%s) failed\n", coins.String(), from.String())
+		return "", types.ErrSendCoinsFailed(coins.String(), to.String())
+	}
+	k.tokenKeeper.AddFeeDetail(ctx, from.String(), coins, types.FeeTypeOrderDealRebate, to.String())
+	return from.String(), nil
+}
+
 // AddCollectedFees adds fee to the feePool
 func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.SysCoins, from sdk.AccAddress,
 	feeType string, hasFeeDetail bool) error {
@@ -451,8 +523,8 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.SysCoins, from sdk.A
 
 // GetParams gets inflation params from the global param store
 func (k Keeper) GetParams(ctx sdk.Context) *types.Params {
-	var param types.Params
-	k.paramSpace.GetParamSet(ctx, &param)
+	param := types.DefaultParams()
+	common.GetParamSetWithDefaults(ctx, k.paramSpace, &param, types.OptionalParamKeys...)
 	return &param
 }
 
@@ -11,6 +11,8 @@ type Cache struct {
 	updatedOrderIDs  []string
 	blockMatchResult *types.BlockMatchResult
 	handlerTxMsgResult []bitset.BitSet
+	// deals matched by the continuous auction engine as orders arrive
+	continuousMatchResults map[string]types.MatchResult
 
 	// for statistic
 	cancelNum      int64 // canceled orders num in this block
@@ -22,8 +24,9 @@ type Cache struct {
 // nolint
 func NewCache() *Cache {
 	return &Cache{
-		updatedOrderIDs:  []string{},
-		blockMatchResult: nil,
+		updatedOrderIDs:        []string{},
+		blockMatchResult:       nil,
+		continuousMatchResults: make(map[string]types.MatchResult),
 	}
 }
 
@@ -32,6 +35,7 @@ func (c *Cache) reset() {
 	c.updatedOrderIDs = []string{}
 	c.blockMatchResult = &types.BlockMatchResult{}
 	c.handlerTxMsgResult = []bitset.BitSet{}
+	c.continuousMatchResults = make(map[string]types.MatchResult)
 
 	c.cancelNum = 0
 	c.expireNum = 0
@@ -47,6 +51,20 @@ func (c *Cache) setBlockMatchResult(result *types.BlockMatchResult) {
 	c.blockMatchResult = result
 }
 
+// addContinuousMatchResult accumulates the match result of a product in this block,
+// the price of the accumulated result is the latest deal price
+func (c *Cache) addContinuousMatchResult(product string, result types.MatchResult) {
+	if prev, ok := c.continuousMatchResults[product]; ok {
+		result.Quantity = prev.Quantity.Add(result.Quantity)
+		result.Deals = append(prev.Deals, result.Deals...)
+	}
+	c.continuousMatchResults[product] = result
+}
+
+func (c *Cache) getContinuousMatchResults() map[string]types.MatchResult {
+	return c.continuousMatchResults
+}
+
 func (c *Cache) addTxHandlerMsgResult(resultSet bitset.BitSet) {
 	c.handlerTxMsgResult = append(c.handlerTxMsgResult, resultSet)
 }
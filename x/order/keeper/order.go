@@ -48,6 +48,9 @@ func (k Keeper) PlaceOrder(ctx sdk.Context, order *types.Order) error {
 
 	k.SetBlockOrderNum(ctx, blockHeight, orderNum+1)
 	k.SetOrder(ctx, order.OrderID, order)
+	if order.IsImmediate() {
+		k.SetImmediateOrderIDs(ctx, append(k.GetImmediateOrderIDs(ctx), order.OrderID))
+	}
 
 	// update depth book and orderIDsMap in cache
 	k.InsertOrderIntoDepthBook(order)
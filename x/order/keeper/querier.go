@@ -29,6 +29,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 		case types.QueryDepthBookV2:
 			return queryDepthBookV2(ctx, path[1:], req, keeper)
+		case types.QueryTriggerOrder:
+			return queryTriggerOrder(ctx, path[1:], req, keeper)
+		case types.QueryTriggerOrders:
+			return queryTriggerOrders(ctx, req, keeper)
 		default:
 			return nil, types.ErrUnknownOrderQueryType()
 		}
@@ -46,6 +50,51 @@ func queryOrder(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func queryTriggerOrder(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte,
+	err sdk.Error) {
+	order := keeper.GetTriggerOrder(ctx, path[0])
+	if order == nil {
+		return nil, types.ErrTriggerOrderNotExist(path[0])
+	}
+	bz := keeper.cdc.MustMarshalJSON(order)
+	return bz, nil
+}
+
+// QueryTriggerOrdersParams as input parameters when querying the trigger book
+type QueryTriggerOrdersParams struct {
+	Product string
+	Address string
+}
+
+// NewQueryTriggerOrdersParams creates a new instance of QueryTriggerOrdersParams
+func NewQueryTriggerOrdersParams(product, address string) QueryTriggerOrdersParams {
+	return QueryTriggerOrdersParams{
+		Product: product,
+		Address: address,
+	}
+}
+
+func queryTriggerOrders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTriggerOrdersParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed("incorrectly formatted request Data")
+	}
+	if params.Product == "" {
+		return nil, types.ErrProductIsEmpty()
+	}
+
+	orders := []*types.TriggerOrder{}
+	for _, order := range keeper.GetProductTriggerOrders(ctx, params.Product) {
+		if params.Address == "" || order.Sender.String() == params.Address {
+			orders = append(orders, order)
+		}
+	}
+	bz := keeper.cdc.MustMarshalJSON(orders)
+	return bz, nil
+}
+
 // QueryDepthBookParams as input parameters when querying the depthBook
 type QueryDepthBookParams struct {
 	Product string
@@ -231,6 +231,9 @@ func TestQueryParameters(t *testing.T) {
 		TradeFeeRate:          sdk.MustNewDecFromStr("0.001"),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+
+		TriggerOrderFee:            sdk.NewDecCoinFromDec(types.DefaultFeeDenomPerBlock, sdk.NewDec(1)),
+		MaxTriggerOrdersPerAccount: 10,
 	}
 	keeper.SetParams(ctx, params)
 	path := []string{types.QueryParameters}
@@ -252,3 +255,54 @@ func TestQueryInvalidPath(t *testing.T) {
 	_, err := querier(ctx, path, abci.RequestQuery{})
 	require.NotNil(t, err)
 }
+
+func TestQueryTriggerOrders(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	querier := NewQuerier(keeper)
+
+	product := types.TestTokenPair
+	newTriggerOrder := func(sender sdk.AccAddress) *types.TriggerOrder {
+		return types.NewTriggerOrder("", sender, product, types.SellOrder, types.TriggerTypeStopLoss,
+			sdk.MustNewDecFromStr("9.0"), sdk.ZeroDec(), sdk.OneDec(), types.OrderTypeMarket, 0)
+	}
+	order1 := newTriggerOrder(testInput.TestAddrs[0])
+	keeper.PlaceTriggerOrder(ctx, order1)
+	order2 := newTriggerOrder(testInput.TestAddrs[1])
+	keeper.PlaceTriggerOrder(ctx, order2)
+	require.NotEqual(t, order1.TriggerOrderID, order2.TriggerOrderID)
+
+	// query by trigger order id
+	path := []string{types.QueryTriggerOrder, order1.TriggerOrderID}
+	bz, err := querier(ctx, path, abci.RequestQuery{})
+	require.Nil(t, err)
+	var order types.TriggerOrder
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &order))
+	require.EqualValues(t, *order1, order)
+
+	// query the trigger book of the product
+	params := NewQueryTriggerOrdersParams(product, "")
+	path = []string{types.QueryTriggerOrders}
+	bz, err = querier(ctx, path, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+	var orders []types.TriggerOrder
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &orders))
+	require.EqualValues(t, 2, len(orders))
+
+	// query the trigger book of the product by address
+	params = NewQueryTriggerOrdersParams(product, testInput.TestAddrs[1].String())
+	bz, err = querier(ctx, path, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &orders))
+	require.EqualValues(t, 1, len(orders))
+	require.EqualValues(t, order2.TriggerOrderID, orders[0].TriggerOrderID)
+
+	// removed trigger orders can't be queried
+	keeper.RemoveTriggerOrder(ctx, order1)
+	path = []string{types.QueryTriggerOrder, order1.TriggerOrderID}
+	_, err = querier(ctx, path, abci.RequestQuery{})
+	require.NotNil(t, err)
+	require.EqualValues(t, 1, len(keeper.GetProductTriggerOrders(ctx, product)))
+	require.EqualValues(t, 1, len(keeper.GetAllTriggerOrders(ctx)))
+}
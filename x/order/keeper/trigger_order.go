@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/order/types"
+)
+
+// PlaceTriggerOrder assigns an id to the trigger order and puts it on the trigger book of its product
+func (k Keeper) PlaceTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	seq := k.GetTriggerOrderSeq(ctx) + 1
+	k.SetTriggerOrderSeq(ctx, seq)
+	order.TriggerOrderID = types.FormatTriggerOrderID(ctx.BlockHeight(), seq)
+	k.SetTriggerOrder(ctx, order)
+}
+
+// SetTriggerOrder sets the trigger order and puts it on the trigger book of its product
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if !store.Has(types.GetTriggerOrderKey(order.TriggerOrderID)) {
+		k.setTriggerOrderNum(ctx, order.Sender, k.GetTriggerOrderNum(ctx, order.Sender)+1)
+	}
+	store.Set(types.GetTriggerOrderKey(order.TriggerOrderID), k.cdc.MustMarshalBinaryBare(order))
+	store.Set(types.GetTriggerBookKey(order.Product, order.IsRising(), order.TriggerPrice, order.TriggerOrderID),
+		[]byte(order.TriggerOrderID))
+	store.Set(types.GetTriggerProductKey(order.Product), []byte{})
+}
+
+// GetTriggerOrder gets the trigger order from KVStore, returns nil if it is triggered or cancelled
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, triggerOrderID string) *types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetTriggerOrderKey(triggerOrderID))
+	if bz == nil {
+		return nil
+	}
+	order := &types.TriggerOrder{}
+	k.cdc.MustUnmarshalBinaryBare(bz, order)
+	return order
+}
+
+// RemoveTriggerOrder removes the trigger order from KVStore and the trigger book of its product
+func (k Keeper) RemoveTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if store.Has(types.GetTriggerOrderKey(order.TriggerOrderID)) {
+		k.setTriggerOrderNum(ctx, order.Sender, k.GetTriggerOrderNum(ctx, order.Sender)-1)
+	}
+	store.Delete(types.GetTriggerOrderKey(order.TriggerOrderID))
+	store.Delete(types.GetTriggerBookKey(order.Product, order.IsRising(), order.TriggerPrice, order.TriggerOrderID))
+
+	// the product is removed from the products with trigger orders if its trigger book is empty
+	iter := sdk.KVStorePrefixIterator(store, types.GetTriggerBookProductKey(order.Product))
+	defer iter.Close()
+	if !iter.Valid() {
+		store.Delete(types.GetTriggerProductKey(order.Product))
+	}
+}
+
+// GetTriggerOrderNum gets the number of the trigger orders of the account on the trigger books
+func (k Keeper) GetTriggerOrderNum(ctx sdk.Context, addr sdk.AccAddress) int64 {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetTriggerOrderNumKey(addr))
+	if bz == nil {
+		return 0
+	}
+	return common.BytesToInt64(bz)
+}
+
+func (k Keeper) setTriggerOrderNum(ctx sdk.Context, addr sdk.AccAddress, num int64) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if num <= 0 {
+		store.Delete(types.GetTriggerOrderNumKey(addr))
+		return
+	}
+	store.Set(types.GetTriggerOrderNumKey(addr), common.Int64ToBytes(num))
+}
+
+// GetProductTriggerOrders gets the trigger orders on the trigger book of the product
+func (k Keeper) GetProductTriggerOrders(ctx sdk.Context, product string) []*types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTriggerBookProductKey(product))
+	defer iter.Close()
+
+	var orders []*types.TriggerOrder
+	for ; iter.Valid(); iter.Next() {
+		if order := k.GetTriggerOrder(ctx, string(iter.Value())); order != nil {
+			orders = append(orders, order)
+		}
+	}
+	return orders
+}
+
+// GetTriggeredOrders gets the trigger orders of the product whose trigger prices are reached by the last price,
+// by iterating the trigger book from the trigger price closest to the last price
+func (k Keeper) GetTriggeredOrders(ctx sdk.Context, product string, lastPrice sdk.Dec) []*types.TriggerOrder {
+	if !lastPrice.IsPositive() {
+		return nil
+	}
+	store := ctx.KVStore(k.orderStoreKey)
+
+	var orders []*types.TriggerOrder
+	collect := func(iter sdk.Iterator) {
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			if order := k.GetTriggerOrder(ctx, string(iter.Value())); order != nil && order.IsTriggered(lastPrice) {
+				orders = append(orders, order)
+			}
+		}
+	}
+
+	// orders waiting for a rising price are triggered if the trigger prices are not greater than the last price
+	risingKey := types.GetTriggerBookSideKey(product, true)
+	collect(store.Iterator(risingKey, sdk.PrefixEndBytes(types.GetTriggerBookPriceKey(product, true, lastPrice))))
+	// orders waiting for a falling price are triggered if the trigger prices are not less than the last price
+	fallingKey := types.GetTriggerBookSideKey(product, false)
+	collect(store.ReverseIterator(types.GetTriggerBookPriceKey(product, false, lastPrice), sdk.PrefixEndBytes(fallingKey)))
+	return orders
+}
+
+// GetTriggerOrderProducts gets the products which have trigger orders
+func (k Keeper) GetTriggerOrderProducts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TriggerProductKey)
+	defer iter.Close()
+
+	var products []string
+	for ; iter.Valid(); iter.Next() {
+		products = append(products, string(iter.Key()[len(types.TriggerProductKey):]))
+	}
+	return products
+}
+
+// GetAllTriggerOrders gets the trigger orders of all products
+func (k Keeper) GetAllTriggerOrders(ctx sdk.Context) []*types.TriggerOrder {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TriggerOrderKey)
+	defer iter.Close()
+
+	var orders []*types.TriggerOrder
+	for ; iter.Valid(); iter.Next() {
+		order := &types.TriggerOrder{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetTriggerOrderSeq gets the sequence of the last trigger order id
+func (k Keeper) GetTriggerOrderSeq(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.TriggerOrderSeqKey)
+	if bz == nil {
+		return 0
+	}
+	return common.BytesToInt64(bz)
+}
+
+// SetTriggerOrderSeq sets the sequence of the last trigger order id
+func (k Keeper) SetTriggerOrderSeq(ctx sdk.Context, seq int64) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.TriggerOrderSeqKey, common.Int64ToBytes(seq))
+}
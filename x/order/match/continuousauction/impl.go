@@ -4,13 +4,32 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 
 	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
 )
 
-// nolint
+// CaEngine is the continuous auction match engine.
+// Orders of continuous auction products are matched in the handler as they arrive, see MatchNewOrder,
+// the engine only collects the deals of this block into the block match result at EndBlock.
 type CaEngine struct {
 }
 
 // nolint
 func (e *CaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
-	// TODO
+	results := keeper.GetContinuousMatchResults()
+	if len(results) == 0 {
+		return
+	}
+
+	blockMatchResult := keeper.GetBlockMatchResult()
+	if blockMatchResult == nil || len(blockMatchResult.ResultMap) == 0 {
+		blockMatchResult = &types.BlockMatchResult{
+			BlockHeight: ctx.BlockHeight(),
+			ResultMap:   make(map[string]types.MatchResult, len(results)),
+			TimeStamp:   ctx.BlockHeader().Time.Unix(),
+		}
+	}
+	for product, result := range results {
+		blockMatchResult.ResultMap[product] = result
+	}
+	keeper.SetBlockMatchResult(blockMatchResult)
 }
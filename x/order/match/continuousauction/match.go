@@ -0,0 +1,160 @@
+package continuousauction
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/match/periodicauction"
+	"github.com/okex/exchain/x/order/types"
+)
+
+// MatchNewOrder matches a new order of a continuous auction product with price-time priority.
+// The new order takes the resting orders on the opposite side of the depth book, from the best price
+// and the earliest order at the same price, and every deal is executed at the price of the resting order.
+// The unfilled quantity of the new order keeps resting on the depth book, except for market, IOC and
+// FOK orders which are expired, and a FOK order is expired without any deal if it can't be fully filled.
+func MatchNewOrder(ctx sdk.Context, k keeper.Keeper, order *types.Order, logger log.Logger) []types.Deal {
+	feeParams := k.GetParams(ctx)
+	// the resting orders which can't be settled are cancelled before taking them
+	periodicauction.CancelUntransferableOrders(ctx, k, order.Product)
+	book := k.GetDepthBookCopy(order.Product)
+
+	// take the new order out of the depth book while it is taking liquidity
+	book.RemoveOrder(order)
+
+	if order.OrderType == types.OrderTypeFOK && book.AvailableQuantity(order.Side, order.Price).LT(order.RemainQuantity) {
+		k.ExpireOrder(ctx, order, logger)
+		return nil
+	}
+
+	var deals []types.Deal
+	executed := sdk.ZeroDec()
+	lastPrice := sdk.ZeroDec()
+
+	// buy orders take sell orders from low price to high price,
+	// sell orders take buy orders from high price to low price
+	makerSide := types.SellOrder
+	index := len(book.Items) - 1
+	if order.Side == types.SellOrder {
+		makerSide = types.BuyOrder
+		index = 0
+	}
+	for order.RemainQuantity.IsPositive() && index >= 0 && index < len(book.Items) {
+		price := book.Items[index].Price
+		if !isCrossed(order, price) {
+			break
+		}
+
+		var levelDeals []types.Deal
+		filled := sdk.ZeroDec()
+		if makerQuantity(book.Items[index], makerSide).IsPositive() {
+			// deal fees of sell orders are valued with the last price
+			k.SetLastPrice(ctx, order.Product, price)
+			levelDeals, filled = fillPriceLevel(ctx, k, order, price, makerSide, feeParams)
+			deals = append(deals, levelDeals...)
+			executed = executed.Add(filled)
+			lastPrice = price
+			book.Sub(index, filled, makerSide)
+		}
+
+		removed := book.RemoveIfEmpty(index)
+		if order.Side == types.BuyOrder {
+			index--
+		} else if !removed {
+			index++
+		}
+	}
+
+	if order.RemainQuantity.IsPositive() {
+		book.InsertOrder(order)
+	} else {
+		removeOrderID(k, order)
+	}
+	k.SetDepthBook(order.Product, book)
+
+	if executed.IsPositive() {
+		k.AddContinuousMatchResult(order.Product, types.MatchResult{
+			BlockHeight: ctx.BlockHeight(),
+			Price:       lastPrice,
+			Quantity:    executed,
+			Deals:       deals,
+		})
+	}
+
+	if order.IsImmediate() && order.Status == types.OrderStatusOpen {
+		k.ExpireOrder(ctx, order, logger)
+	}
+	return deals
+}
+
+// isCrossed returns true if the order can be matched with resting orders at price
+func isCrossed(order *types.Order, price sdk.Dec) bool {
+	if order.Side == types.BuyOrder {
+		return order.Price.GTE(price)
+	}
+	return order.Price.LTE(price)
+}
+
+func makerQuantity(item types.DepthBookItem, makerSide string) sdk.Dec {
+	if makerSide == types.BuyOrder {
+		return item.BuyQuantity
+	}
+	return item.SellQuantity
+}
+
+// fillPriceLevel fills the resting orders at price one by one in time priority until the new order is
+// filled, returns the deals of both sides and the quantity executed at this price level
+func fillPriceLevel(ctx sdk.Context, k keeper.Keeper, order *types.Order, price sdk.Dec, makerSide string,
+	feeParams *types.Params) ([]types.Deal, sdk.Dec) {
+
+	var deals []types.Deal
+	filled := sdk.ZeroDec()
+	key := types.FormatOrderIDsKey(order.Product, price, makerSide)
+	orderIDs := k.GetProductPriceOrderIDs(key)
+
+	index := 0
+	for index < len(orderIDs) && order.RemainQuantity.IsPositive() {
+		maker := k.GetOrder(ctx, orderIDs[index])
+		if maker == nil {
+			ctx.Logger().Error(fmt.Sprintf("[Order] Not exist orderID: %s", orderIDs[index]))
+			index++
+			continue
+		}
+
+		fillQuantity := sdk.MinDec(maker.RemainQuantity, order.RemainQuantity)
+		if deal := periodicauction.FillOrderAs(maker, ctx, k, price, fillQuantity, feeParams, true); deal != nil {
+			deals = append(deals, *deal)
+		}
+		if deal := periodicauction.FillOrderAs(order, ctx, k, price, fillQuantity, feeParams, false); deal != nil {
+			deals = append(deals, *deal)
+		}
+		filled = filled.Add(fillQuantity)
+
+		if maker.Status == types.OrderStatusFilled {
+			index++
+		}
+	}
+
+	// Note: orderIDs is shared with the disk cache, copy the unfilled ones before updating
+	unfilledOrderIDs := make([]string, 0, len(orderIDs)-index)
+	unfilledOrderIDs = append(unfilledOrderIDs, orderIDs[index:]...)
+	k.SetOrderIDs(key, unfilledOrderIDs)
+
+	return deals, filled
+}
+
+// removeOrderID removes a fully filled new order from the orderIDs of its price
+func removeOrderID(k keeper.Keeper, order *types.Order) {
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	orderIDs := k.GetProductPriceOrderIDs(key)
+	remainOrderIDs := make([]string, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if orderID != order.OrderID {
+			remainOrderIDs = append(remainOrderIDs, orderID)
+		}
+	}
+	k.SetOrderIDs(key, remainOrderIDs)
+}
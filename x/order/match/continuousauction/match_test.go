@@ -0,0 +1,137 @@
+package continuousauction
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/dex"
+	orderkeeper "github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
+)
+
+func placeAndMatch(t *testing.T, ctx sdk.Context, keeper orderkeeper.Keeper, order *types.Order) []types.Deal {
+	err := keeper.PlaceOrder(ctx, order)
+	require.NoError(t, err)
+	return MatchNewOrder(ctx, keeper, order, ctx.Logger())
+}
+
+func TestMatchNewOrder(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	keeper.ResetCache(ctx)
+
+	// resting sell orders
+	makers := []*types.Order{
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "2.0"),
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.2", "1.0"),
+	}
+	for _, maker := range makers {
+		maker.Sender = testInput.TestAddrs[1]
+		deals := placeAndMatch(t, ctx, keeper, maker)
+		require.Empty(t, deals)
+	}
+
+	// buy order crossing the price level 10.0 only
+	taker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.1", "2.0")
+	taker.Sender = testInput.TestAddrs[0]
+	deals := placeAndMatch(t, ctx, keeper, taker)
+
+	// earlier order at the same price is filled first, at the price of resting orders
+	require.EqualValues(t, 4, len(deals))
+	require.EqualValues(t, makers[0].OrderID, deals[0].OrderID)
+	require.EqualValues(t, taker.OrderID, deals[1].OrderID)
+	require.EqualValues(t, makers[1].OrderID, deals[2].OrderID)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.0"), deals[3].Price)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker.OrderID).Status)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, makers[0].OrderID).Status)
+	order1 := keeper.GetOrder(ctx, makers[1].OrderID)
+	require.EqualValues(t, types.OrderStatusOpen, order1.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), order1.RemainQuantity)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.0"), keeper.GetLastPrice(ctx, types.TestTokenPair))
+
+	// the filled buy order does not rest on the depth book
+	book := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.EqualValues(t, 2, len(book.Items))
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.2"), book.Items[0].Price)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.0"), book.Items[1].Price)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), book.Items[1].SellQuantity)
+	require.EqualValues(t, 0, len(keeper.GetProductPriceOrderIDs(
+		types.FormatOrderIDsKey(types.TestTokenPair, taker.Price, taker.Side))))
+
+	// unfilled quantity keeps resting on the depth book
+	taker = types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.1", "2.0")
+	taker.Sender = testInput.TestAddrs[0]
+	deals = placeAndMatch(t, ctx, keeper, taker)
+	require.EqualValues(t, 2, len(deals))
+	order := keeper.GetOrder(ctx, taker.OrderID)
+	require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), order.RemainQuantity)
+	book = keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.EqualValues(t, 2, len(book.Items))
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.1"), book.Items[1].Price)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), book.Items[1].BuyQuantity)
+
+	// sell order takes the best bid
+	taker = types.MockOrder("", types.TestTokenPair, types.SellOrder, "9.0", "0.5")
+	taker.Sender = testInput.TestAddrs[1]
+	deals = placeAndMatch(t, ctx, keeper, taker)
+	require.EqualValues(t, 2, len(deals))
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.1"), deals[0].Price)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, taker.OrderID).Status)
+
+	// match results of the block are recorded regardless of the backend
+	require.EqualValues(t, 8, len(keeper.GetContinuousMatchResults()[types.TestTokenPair].Deals))
+
+	// match results of the block are collected by the engine
+	engine := &CaEngine{}
+	engine.Run(ctx, keeper)
+	result := keeper.GetBlockMatchResult().ResultMap[types.TestTokenPair]
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.1"), result.Price)
+	require.EqualValues(t, sdk.MustNewDecFromStr("3.5"), result.Quantity)
+	require.EqualValues(t, 8, len(result.Deals))
+}
+
+func TestMatchNewImmediateOrder(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	keeper.ResetCache(ctx)
+
+	maker := types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0")
+	maker.Sender = testInput.TestAddrs[1]
+	placeAndMatch(t, ctx, keeper, maker)
+
+	// FOK order which can't be fully filled is expired without deals
+	taker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0")
+	taker.Sender = testInput.TestAddrs[0]
+	taker.OrderType = types.OrderTypeFOK
+	deals := placeAndMatch(t, ctx, keeper, taker)
+	require.Empty(t, deals)
+	require.EqualValues(t, types.OrderStatusExpired, keeper.GetOrder(ctx, taker.OrderID).Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), keeper.GetOrder(ctx, maker.OrderID).RemainQuantity)
+	book := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.EqualValues(t, 1, len(book.Items))
+	require.True(t, book.Items[0].BuyQuantity.IsZero())
+
+	// IOC order is partially filled and the rest is expired
+	taker = types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0")
+	taker.Sender = testInput.TestAddrs[0]
+	taker.OrderType = types.OrderTypeIOC
+	deals = placeAndMatch(t, ctx, keeper, taker)
+	require.EqualValues(t, 2, len(deals))
+	order := keeper.GetOrder(ctx, taker.OrderID)
+	require.EqualValues(t, types.OrderStatusPartialFilledExpired, order.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), order.RemainQuantity)
+	require.EqualValues(t, types.OrderStatusFilled, keeper.GetOrder(ctx, maker.OrderID).Status)
+	require.EqualValues(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+}
@@ -10,26 +10,24 @@ import (
 	"github.com/okex/exchain/x/order/match/periodicauction"
 )
 
-// nolint
-const DefaultAuctionType = "periodicauction"
-
 // nolint
 var (
-	once        sync.Once
-	engine      Engine
-	auctionType = DefaultAuctionType
+	once    sync.Once
+	engines []Engine
 )
 
-// GetEngine : periodic auction only today
-func GetEngine() Engine {
+// GetEngines returns the match engines run in EndBlocker, in order:
+//  1. periodic auction: cleans up expired and delisted orders of all products, and matches
+//     the products which are not switched to the continuous auction by governance
+//  2. continuous auction: collects the deals matched as orders arrived within the block
+func GetEngines() []Engine {
 	once.Do(func() {
-		if auctionType == DefaultAuctionType {
-			engine = &periodicauction.PaEngine{}
-		} else {
-			engine = &continuousauction.CaEngine{}
+		engines = []Engine{
+			&periodicauction.PaEngine{},
+			&continuousauction.CaEngine{},
 		}
 	})
-	return engine
+	return engines
 }
 
 // nolint
@@ -156,14 +156,14 @@ func fillOrderByKey(ctx sdk.Context, keeper orderkeeper.Keeper, key string,
 		}
 		if filledAmount.Add(order.RemainQuantity).LTE(needFillAmount) {
 			filledAmount = filledAmount.Add(order.RemainQuantity)
-			if deal := fillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, order.RemainQuantity, feeParams); deal != nil {
 				deals = append(deals, *deal)
 			}
 
 			filledDealsCnt++
 			index++
 		} else {
-			if deal := fillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams); deal != nil {
+			if deal := FillOrder(order, ctx, keeper, fillPrice, needFillAmount.Sub(filledAmount), feeParams); deal != nil {
 				deals = append(deals, *deal)
 			}
 			filledAmount = needFillAmount
@@ -200,7 +200,7 @@ func balanceAccount(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keep
 }
 
 func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, fillQuantity sdk.Dec,
-	feeParams *types.Params) (dealFee sdk.SysCoins, feeReceiver string) {
+	feeParams *types.Params, isMaker bool) (dealFee sdk.SysCoins, feeReceiver string) {
 	// charge fee
 	fee := orderkeeper.GetZeroFee()
 	if order.Status == types.OrderStatusFilled {
@@ -217,18 +217,55 @@ func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, f
 			ctx.Logger().Error(fmt.Sprintf("Send fee failed:%s\n", err.Error()))
 		}
 	}
-	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeParams)
-	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, types.FeeTypeOrderDeal, order.Product)
+	feeRate, scheduled := keeper.GetDealFeeRate(ctx, order.Product, isMaker, feeParams)
+	if !scheduled {
+		dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeParams)
+		feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, types.FeeTypeOrderDeal, order.Product)
+		if err == nil {
+			order.RecordOrderDealFee(fee)
+		}
+		return dealFee, feeReceiver
+	}
+
+	if feeRate.IsNegative() {
+		// the maker gets a rebate from the fee receiver of the product
+		rebate := orderkeeper.GetDealFeeByRate(order, fillQuantity, ctx, keeper, feeRate)
+		if _, err := keeper.PayRebateFromProductOwner(ctx, rebate, order.Sender, order.Product); err == nil {
+			order.RecordOrderDealRebate(rebate)
+		}
+		return orderkeeper.GetZeroFee(), ""
+	}
+
+	feeType := types.FeeTypeOrderDealTaker
+	if isMaker {
+		feeType = types.FeeTypeOrderDealMaker
+	}
+	dealFee = orderkeeper.GetDealFeeByRate(order, fillQuantity, ctx, keeper, feeRate)
+	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, feeType, order.Product)
 	if err == nil {
-		order.RecordOrderDealFee(fee)
+		order.RecordOrderDealFeeAs(dealFee, isMaker)
 	}
-	return
+	return dealFee, feeReceiver
+}
+
+// IsMakerOrder returns true if the order was placed before current block, which rests on the depth book
+// before the periodic auction of current block and is regarded as a maker
+func IsMakerOrder(ctx sdk.Context, order *types.Order) bool {
+	return types.GetBlockHeightFromOrderID(order.OrderID) < ctx.BlockHeight()
 }
 
-// Fill an order. Update order, charge fee and transfer tokens. Return a deal.
+// FillOrder fills an order. Update order, charge fee and transfer tokens. Return a deal.
 // If an order is fully filled but still lock some coins, unlock it.
-func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
+// The order placed before current block is charged as a maker, otherwise as a taker.
+func FillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params) *types.Deal {
+	return FillOrderAs(order, ctx, keeper, fillPrice, fillQuantity, feeParams, IsMakerOrder(ctx, order))
+}
+
+// FillOrderAs fills an order as a maker or a taker, the deal fee is charged with the maker or taker fee rate.
+// It is shared with the continuous auction engine.
+func FillOrderAs(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
+	fillPrice, fillQuantity sdk.Dec, feeParams *types.Params, isMaker bool) *types.Deal {
 
 	// update order
 	order.Fill(fillPrice, fillQuantity)
@@ -241,7 +278,8 @@ func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 		order.Unlock()
 	}
 
-	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams)
+	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams, isMaker)
 	keeper.UpdateOrder(order, ctx) // update order info on filled
-	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(), FeeReceiver: feeReceiver}
+	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Price: fillPrice, Quantity: fillQuantity,
+		Fee: dealFee.String(), FeeReceiver: feeReceiver}
 }
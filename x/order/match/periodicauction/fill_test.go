@@ -639,7 +639,7 @@ func TestFillOrder(t *testing.T) {
 	feeParams := types.DefaultTestParams()
 
 	for _, order := range orders {
-		retDeals := fillOrder(order, ctx, keeper, fillPrice, fillQuantity, &feeParams)
+		retDeals := FillOrder(order, ctx, keeper, fillPrice, fillQuantity, &feeParams)
 		require.NotEmpty(t, retDeals)
 	}
 }
@@ -706,8 +706,61 @@ func TestChargeFee(t *testing.T) {
 	feeParams := types.DefaultTestParams()
 
 	for _, order := range orders {
-		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, &feeParams)
+		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, &feeParams, false)
 		require.NotEmpty(t, retFee)
 		require.NotEmpty(t, feeReceiver)
+		// the legacy fee is recorded without a fee schedule
+		require.NotEmpty(t, order.GetExtraInfoWithKey(types.OrderExtraInfoKeyDealFee))
+		require.Empty(t, order.GetExtraInfoWithKey(types.OrderExtraInfoKeyTakerFee))
 	}
 }
+
+func TestChargeScheduledFee(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	feeRates := dex.NewDealFeeRates(sdk.MustNewDecFromStr("-0.0005"), sdk.MustNewDecFromStr("0.002"))
+	testInput.DexKeeper.SetOperator(ctx, dex.DEXOperator{
+		Address:            tokenPair.Owner,
+		HandlingFeeAddress: testInput.TestAddrs[0],
+		FeeRates:           &feeRates,
+	})
+	keeper.ResetCache(ctx)
+	feeParams := types.DefaultTestParams()
+
+	// the fee rates of the operator are applied
+	makerRate, scheduled := keeper.GetDealFeeRate(ctx, types.TestTokenPair, true, &feeParams)
+	require.True(t, scheduled)
+	require.EqualValues(t, feeRates.MakerFeeRate, makerRate)
+	takerRate, scheduled := keeper.GetDealFeeRate(ctx, types.TestTokenPair, false, &feeParams)
+	require.True(t, scheduled)
+	require.EqualValues(t, feeRates.TakerFeeRate, takerRate)
+
+	taker := mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	taker.Sender = testInput.TestAddrs[1]
+	takerFee, feeReceiver := chargeFee(taker, ctx, keeper, sdk.OneDec(), &feeParams, false)
+	require.EqualValues(t, testInput.TestAddrs[0].String(), feeReceiver)
+	require.EqualValues(t, "0.002000000000000000"+common.TestToken, takerFee.String())
+	require.EqualValues(t, takerFee.String(), taker.GetExtraInfoWithKey(types.OrderExtraInfoKeyTakerFee))
+
+	// the maker gets a rebate from the handling fee address of the operator
+	receiverBalance := keeper.GetCoins(ctx, testInput.TestAddrs[0])
+	maker := mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0")
+	maker.Sender = testInput.TestAddrs[1]
+	makerFee, _ := chargeFee(maker, ctx, keeper, sdk.OneDec(), &feeParams, true)
+	require.True(t, makerFee.IsZero())
+	rebate := sdk.SysCoins{sdk.NewDecCoinFromDec(common.TestToken, sdk.MustNewDecFromStr("0.0005"))}
+	require.EqualValues(t, rebate.String(), maker.GetExtraInfoWithKey(types.OrderExtraInfoKeyDealRebate))
+	require.EqualValues(t, receiverBalance.Sub(rebate), keeper.GetCoins(ctx, testInput.TestAddrs[0]))
+
+	// the fee rates of the token pair override the ones of the operator
+	pairFeeRates := dex.NewDealFeeRates(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001"))
+	tokenPair.FeeRates = &pairFeeRates
+	testInput.DexKeeper.UpdateTokenPair(ctx, tokenPair.Name(), tokenPair)
+	takerRate, scheduled = keeper.GetDealFeeRate(ctx, types.TestTokenPair, false, &feeParams)
+	require.True(t, scheduled)
+	require.EqualValues(t, pairFeeRates.TakerFeeRate, takerRate)
+}
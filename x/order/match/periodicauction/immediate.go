@@ -0,0 +1,126 @@
+package periodicauction
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
+)
+
+// expireUnfillableFOKOrders expires the FOK orders placed in this block which can't be fully filled
+// by the match result of their products. Expiring an order changes the match result, so it repeats
+// until all the remaining FOK orders can be fully filled.
+func expireUnfillableFOKOrders(ctx sdk.Context, k keeper.Keeper, products []string) {
+	fokOrders := getBlockFOKOrders(ctx, k)
+	if len(fokOrders) == 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "order")
+	params := k.GetParams(ctx)
+	for _, product := range products {
+		orders := fokOrders[product]
+		if len(orders) == 0 || params.IsContinuousProduct(product) {
+			continue
+		}
+		tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
+		if tokenPair == nil {
+			continue
+		}
+
+		for expired := true; expired && len(orders) > 0; {
+			expired = false
+			book := k.GetDepthBookCopy(product)
+			bestPrice, maxExecution := periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
+				k.GetLastPrice(ctx, product))
+
+			remainOrders := make([]*types.Order, 0, len(orders))
+			for _, order := range orders {
+				if isFullyFilled(ctx, k, book, order, bestPrice, maxExecution) {
+					remainOrders = append(remainOrders, order)
+					continue
+				}
+				k.ExpireOrder(ctx, order, logger)
+				expired = true
+			}
+			orders = remainOrders
+		}
+	}
+}
+
+// getBlockFOKOrders returns the open FOK orders placed in this block, grouped by product
+func getBlockFOKOrders(ctx sdk.Context, k keeper.Keeper) map[string][]*types.Order {
+	fokOrders := make(map[string][]*types.Order)
+	for _, orderID := range k.GetImmediateOrderIDs(ctx) {
+		if types.GetBlockHeightFromOrderID(orderID) != ctx.BlockHeight() {
+			continue
+		}
+		order := k.GetOrder(ctx, orderID)
+		if order == nil || order.OrderType != types.OrderTypeFOK || order.Status != types.OrderStatusOpen {
+			continue
+		}
+		fokOrders[order.Product] = append(fokOrders[order.Product], order)
+	}
+	return fokOrders
+}
+
+// isFullyFilled returns true if the order can be fully filled at bestPrice with maxExecution.
+// Orders on the same side are filled from the best price, and in time priority at the same price,
+// so the order is fully filled only if the orders before it and itself don't exceed maxExecution.
+func isFullyFilled(ctx sdk.Context, k keeper.Keeper, book *types.DepthBook, order *types.Order,
+	bestPrice, maxExecution sdk.Dec) bool {
+	if !maxExecution.IsPositive() {
+		return false
+	}
+	if (order.Side == types.BuyOrder && order.Price.LT(bestPrice)) ||
+		(order.Side == types.SellOrder && order.Price.GT(bestPrice)) {
+		return false
+	}
+
+	// quantity of the orders at better prices
+	filled := sdk.ZeroDec()
+	for _, item := range book.Items {
+		if order.Side == types.BuyOrder && item.Price.GT(order.Price) {
+			filled = filled.Add(item.BuyQuantity)
+		} else if order.Side == types.SellOrder && item.Price.LT(order.Price) {
+			filled = filled.Add(item.SellQuantity)
+		}
+	}
+
+	// quantity of the earlier orders at the same price
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	for _, orderID := range k.GetProductPriceOrderIDs(key) {
+		if orderID == order.OrderID {
+			break
+		}
+		if earlier := k.GetOrder(ctx, orderID); earlier != nil {
+			filled = filled.Add(earlier.RemainQuantity)
+		}
+	}
+
+	return filled.Add(order.RemainQuantity).LTE(maxExecution)
+}
+
+// expireImmediateOrders expires the unfilled quantity of market, IOC and FOK orders after matching.
+// Orders of locked products are kept until their products are unlocked.
+func expireImmediateOrders(ctx sdk.Context, k keeper.Keeper) {
+	orderIDs := k.GetImmediateOrderIDs(ctx)
+	if len(orderIDs) == 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "order")
+	var remainOrderIDs []string
+	for _, orderID := range orderIDs {
+		order := k.GetOrder(ctx, orderID)
+		if order == nil || order.Status != types.OrderStatusOpen {
+			continue
+		}
+		if k.IsProductLocked(ctx, order.Product) {
+			remainOrderIDs = append(remainOrderIDs, orderID)
+			continue
+		}
+		k.ExpireOrder(ctx, order, logger)
+	}
+	k.SetImmediateOrderIDs(ctx, remainOrderIDs)
+}
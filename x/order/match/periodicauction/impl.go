@@ -15,4 +15,5 @@ func (e *PaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
 	cleanupExpiredOrders(ctx, keeper)
 	cleanupOrdersWhoseTokenPairHaveBeenDelisted(ctx, keeper)
 	matchOrders(ctx, keeper)
+	expireImmediateOrders(ctx, keeper)
 }
@@ -46,3 +46,44 @@ func TestPaEngine_Run(t *testing.T) {
 	require.EqualValues(t, types.OrderStatusOpen, order2.Status)
 	require.EqualValues(t, sdk.MustNewDecFromStr("2"), order2.RemainQuantity)
 }
+
+func TestPaEngine_RunImmediateOrders(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	// mock orders
+	orders := []*types.Order{
+		types.MockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+		types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0"),
+		types.MockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "2.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[1]
+	orders[1].Sender = testInput.TestAddrs[0]
+	orders[1].OrderType = types.OrderTypeFOK
+	orders[2].Sender = testInput.TestAddrs[0]
+	orders[2].OrderType = types.OrderTypeIOC
+	for i := 0; i < 3; i++ {
+		err := keeper.PlaceOrder(ctx, orders[i])
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 2, len(keeper.GetImmediateOrderIDs(ctx)))
+
+	engine := &PaEngine{}
+	engine.Run(ctx, keeper)
+
+	// the FOK order can't be fully filled and is expired before matching,
+	// the IOC order is partially filled and the rest is expired after matching
+	order0 := keeper.GetOrder(ctx, orders[0].OrderID)
+	order1 := keeper.GetOrder(ctx, orders[1].OrderID)
+	order2 := keeper.GetOrder(ctx, orders[2].OrderID)
+	require.EqualValues(t, types.OrderStatusFilled, order0.Status)
+	require.EqualValues(t, types.OrderStatusExpired, order1.Status)
+	require.EqualValues(t, types.OrderStatusPartialFilledExpired, order2.Status)
+	require.EqualValues(t, sdk.MustNewDecFromStr("1"), order2.RemainQuantity)
+	require.EqualValues(t, 0, len(keeper.GetImmediateOrderIDs(ctx)))
+	require.EqualValues(t, 0, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+}
@@ -242,6 +242,34 @@ func cleanupOrdersByProduct(ctx sdk.Context, keeper keeper.Keeper, product strin
 	}
 }
 
+// CancelUntransferableOrders cancels the open orders of the product whose locked coins can't be transferred out of
+// their senders any more, since the token is paused or the sender is frozen, so that they are never filled. Only the
+// products of controllable tokens are scanned
+func CancelUntransferableOrders(ctx sdk.Context, keeper keeper.Keeper, product string) {
+	// the orders of a locked product are being filled across blocks
+	if keeper.IsProductLocked(ctx, product) || !keeper.IsProductControllable(ctx, product) {
+		return
+	}
+
+	logger := ctx.Logger()
+	depthBook := keeper.GetDepthBookCopy(product)
+	for _, item := range depthBook.Items {
+		// Note: the orderIDs are shared with the disk cache and updated by the cancellation, copy them first
+		var orderIDList []string
+		for _, side := range []string{types.BuyOrder, types.SellOrder} {
+			key := types.FormatOrderIDsKey(product, item.Price, side)
+			orderIDList = append(orderIDList, keeper.GetProductPriceOrderIDs(key)...)
+		}
+		for _, orderID := range orderIDList {
+			order := keeper.GetOrder(ctx, orderID)
+			if order == nil || keeper.CheckOrderTransferable(ctx, order) == nil {
+				continue
+			}
+			keeper.CancelOrder(ctx, order, logger)
+		}
+	}
+}
+
 func cleanOrdersByOrderIDList(ctx sdk.Context, keeper keeper.Keeper, orderIDList []string) {
 	logger := ctx.Logger()
 	for _, orderID := range orderIDList {
@@ -274,6 +302,12 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	products := keeper.GetDiskCache().GetNewDepthbookKeys()
 	products = keeper.FilterDelistedProducts(ctx, products)
 	keeper.GetDexKeeper().SortProducts(ctx, products) // sort products
+	for _, product := range products {
+		CancelUntransferableOrders(ctx, keeper, product)
+	}
+
+	// step0.1: expire the FOK orders which can't be fully filled
+	expireUnfillableFOKOrders(ctx, keeper, products)
 
 	// step1: calc best price and max execution for every active product, save latest price
 	//updatedProductsBaseprice := make(map[string]types.MatchResult)
@@ -302,8 +336,13 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 
 func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []string) map[string]types.MatchResult {
 	resultMap := make(map[string]types.MatchResult)
+	params := k.GetParams(ctx)
 
 	for _, product := range products {
+		// products switched to the continuous auction are matched as their orders arrive
+		if params.IsContinuousProduct(product) {
+			continue
+		}
 		tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
 		if tokenPair == nil {
 			continue
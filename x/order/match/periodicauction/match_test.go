@@ -5,8 +5,10 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/dex"
 	orderkeeper "github.com/okex/exchain/x/order/keeper"
+	token "github.com/okex/exchain/x/token/types"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -874,3 +876,34 @@ func TestCleanupOrdersWhoseTokenPairHaveBeenDelisted(t *testing.T) {
 	require.EqualValues(t, 0, len(depthBook.Items))
 
 }
+
+func TestCancelUntransferableOrders(t *testing.T) {
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.1", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.2", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[0]
+	orders[1].Sender = testInput.TestAddrs[1]
+	for i := 0; i < 2; i++ {
+		err := keeper.PlaceOrder(ctx, orders[i])
+		require.EqualValues(t, nil, err)
+	}
+
+	// the orders of the tokens not controllable are kept
+	testInput.TokenKeeper.SetAddressFrozen(ctx, common.TestToken, testInput.TestAddrs[1], true)
+	CancelUntransferableOrders(ctx, keeper, types.TestTokenPair)
+	require.EqualValues(t, 2, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+
+	// the sell order of the frozen sender is cancelled
+	testInput.TokenKeeper.NewToken(ctx, token.Token{Symbol: common.TestToken, OriginalSymbol: common.TestToken,
+		Controllable: true})
+	CancelUntransferableOrders(ctx, keeper, types.TestTokenPair)
+	depthBook := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.EqualValues(t, 1, len(depthBook.Items))
+	require.EqualValues(t, orders[0].Price, depthBook.Items[0].Price)
+	require.EqualValues(t, types.OrderStatusCancelled, keeper.GetOrder(ctx, orders[1].OrderID).Status)
+}
@@ -0,0 +1,165 @@
+package order
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/crypto/tmhash"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/match/continuousauction"
+	"github.com/okex/exchain/x/order/types"
+)
+
+// EventTypeTriggerOrder is the event type of the trigger orders which are triggered or cancelled
+const EventTypeTriggerOrder = "trigger_order"
+
+// handleMsgNewTriggerOrder puts a trigger order on the trigger book of its product, no coins are locked
+// until it is triggered. The trigger order fee is charged on placing, and the trigger orders of an account
+// are limited, since the trigger books are checked in every block
+func handleMsgNewTriggerOrder(ctx sdk.Context, k Keeper, msg types.MsgNewTriggerOrder,
+	logger log.Logger) (*sdk.Result, error) {
+	orderMsg := MsgNewOrder{
+		Sender:    msg.Sender,
+		Product:   msg.Product,
+		Side:      msg.Side,
+		Price:     msg.Price,
+		Quantity:  msg.Quantity,
+		OrderType: msg.OrderType,
+	}
+	if err := checkOrderNewMsg(ctx, k, orderMsg); err != nil {
+		return nil, err
+	}
+	priceDigit := k.GetDexKeeper().GetTokenPair(ctx, msg.Product).MaxPriceDigit
+	if !msg.TriggerPrice.RoundDecimal(priceDigit).Equal(msg.TriggerPrice) {
+		return types.ErrPriceOverAccuracy(msg.TriggerPrice, priceDigit).Result()
+	}
+
+	triggerOrder := types.NewTriggerOrder(fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())), msg.Sender,
+		msg.Product, msg.Side, msg.TriggerType, msg.TriggerPrice, msg.Price, msg.Quantity, msg.OrderType,
+		ctx.BlockHeader().Time.Unix())
+	lastPrice := k.GetLastPrice(ctx, msg.Product)
+	if triggerOrder.IsTriggered(lastPrice) {
+		return types.ErrTriggerPriceAlreadyReached(msg.TriggerPrice, lastPrice).Result()
+	}
+
+	params := k.GetParams(ctx)
+	if k.GetTriggerOrderNum(ctx, msg.Sender) >= params.MaxTriggerOrdersPerAccount {
+		return types.ErrTooManyTriggerOrders(params.MaxTriggerOrdersPerAccount).Result()
+	}
+	err := k.AddCollectedFees(ctx, sdk.SysCoins{params.TriggerOrderFee}, msg.Sender,
+		types.FeeTypeTriggerOrderNew, false)
+	if err != nil {
+		return types.ErrInsufficientTriggerOrderFee(params.TriggerOrderFee, err).Result()
+	}
+	k.PlaceTriggerOrder(ctx, triggerOrder)
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, trigger order<%s> is placed",
+		ctx.BlockHeight(), "handleMsgNewTriggerOrder", triggerOrder.TriggerOrderID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute("trigger_order_id", triggerOrder.TriggerOrderID),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCancelTriggerOrder removes a trigger order from the trigger book of its product
+func handleMsgCancelTriggerOrder(ctx sdk.Context, k Keeper, msg types.MsgCancelTriggerOrder,
+	logger log.Logger) (*sdk.Result, error) {
+	triggerOrder := k.GetTriggerOrder(ctx, msg.TriggerOrderID)
+	if triggerOrder == nil {
+		return types.ErrTriggerOrderNotExist(msg.TriggerOrderID).Result()
+	}
+	if !triggerOrder.Sender.Equals(msg.Sender) {
+		return types.ErrNotOrderOwner(msg.TriggerOrderID).Result()
+	}
+	k.RemoveTriggerOrder(ctx, triggerOrder)
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, trigger order<%s> is cancelled",
+		ctx.BlockHeight(), "handleMsgCancelTriggerOrder", triggerOrder.TriggerOrderID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+	))
+	emitTriggerOrderEvent(ctx, triggerOrder, types.TriggerOrderStatusCancelled, "")
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// activateTriggerOrders places the trigger orders whose trigger prices are reached by the last price
+// of their products as new orders. Coins of the new orders are locked as regular orders, and the
+// trigger orders fail if the coins are insufficient.
+func activateTriggerOrders(ctx sdk.Context, k keeper.Keeper) {
+	products := k.GetTriggerOrderProducts(ctx)
+	if len(products) == 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "order")
+	for _, product := range products {
+		var triggerOrders []*types.TriggerOrder
+		if k.GetDexKeeper().GetTokenPair(ctx, product) == nil {
+			// trigger orders of the delisted products fail directly
+			triggerOrders = k.GetProductTriggerOrders(ctx, product)
+		} else if k.IsProductLocked(ctx, product) {
+			// new orders are not allowed on locked products, try again after unlocked
+			continue
+		} else {
+			triggerOrders = k.GetTriggeredOrders(ctx, product, k.GetLastPrice(ctx, product))
+		}
+
+		for _, triggerOrder := range triggerOrders {
+			k.RemoveTriggerOrder(ctx, triggerOrder)
+
+			order, err := placeTriggeredOrder(ctx, k, triggerOrder, logger)
+			if err != nil {
+				logger.Info(fmt.Sprintf("trigger order(%s) failed: %v", triggerOrder.TriggerOrderID, err))
+				emitTriggerOrderEvent(ctx, triggerOrder, types.TriggerOrderStatusFailed, "")
+				continue
+			}
+			emitTriggerOrderEvent(ctx, triggerOrder, types.TriggerOrderStatusTriggered, order.OrderID)
+		}
+	}
+}
+
+// placeTriggeredOrder places the triggered order as a new order
+func placeTriggeredOrder(ctx sdk.Context, k keeper.Keeper, triggerOrder *types.TriggerOrder,
+	logger log.Logger) (*types.Order, error) {
+	cacheItem := ctx.MultiStore().CacheMultiStore()
+	ctxItem := ctx.WithMultiStore(cacheItem)
+
+	msg := MsgNewOrder{
+		Sender:    triggerOrder.Sender,
+		Product:   triggerOrder.Product,
+		Side:      triggerOrder.Side,
+		Price:     triggerOrder.Price,
+		Quantity:  triggerOrder.Quantity,
+		OrderType: triggerOrder.OrderType,
+	}
+	if err := checkOrderNewMsg(ctxItem, k, msg); err != nil {
+		return nil, err
+	}
+	if err := priceMarketOrder(k, &msg); err != nil {
+		return nil, err
+	}
+
+	order := getOrderFromMsg(ctxItem, k, msg, "1")
+	order.TxHash = triggerOrder.TxHash
+	if err := k.PlaceOrder(ctxItem, order); err != nil {
+		return nil, err
+	}
+	if k.IsContinuousProduct(ctxItem, order.Product) {
+		continuousauction.MatchNewOrder(ctxItem, k, order, logger)
+	}
+	cacheItem.Write()
+	return order, nil
+}
+
+func emitTriggerOrderEvent(ctx sdk.Context, triggerOrder *types.TriggerOrder, status, orderID string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeTriggerOrder,
+		sdk.NewAttribute("trigger_order_id", triggerOrder.TriggerOrderID),
+		sdk.NewAttribute("status", status),
+		sdk.NewAttribute("order_id", orderID),
+	))
+}
@@ -0,0 +1,196 @@
+package order
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/dex"
+	"github.com/okex/exchain/x/order/keeper"
+	"github.com/okex/exchain/x/order/types"
+)
+
+func TestTriggerOrder(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	k := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	logger := ctx.Logger()
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	k.ResetCache(ctx)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	// resting buy order to be taken by the triggered market order
+	maker := types.MockOrder("", types.TestTokenPair, types.BuyOrder, "9.0", "2.0")
+	maker.Sender = testInput.TestAddrs[1]
+	require.NoError(t, k.PlaceOrder(ctx, maker))
+
+	// trigger price already reached
+	msg := types.NewMsgNewTriggerOrder(testInput.TestAddrs[0], types.TestTokenPair, types.SellOrder,
+		types.TriggerTypeStopLoss, "10.5", "0", "1.0", types.OrderTypeMarket)
+	_, err = handleMsgNewTriggerOrder(ctx, k, msg, logger)
+	require.NotNil(t, err)
+
+	msg = types.NewMsgNewTriggerOrder(testInput.TestAddrs[0], types.TestTokenPair, types.SellOrder,
+		types.TriggerTypeStopLoss, "9.5", "0", "1.0", types.OrderTypeMarket)
+	_, err = handleMsgNewTriggerOrder(ctx, k, msg, logger)
+	require.Nil(t, err)
+	triggerOrders := k.GetProductTriggerOrders(ctx, types.TestTokenPair)
+	require.EqualValues(t, 1, len(triggerOrders))
+	triggerOrder := triggerOrders[0]
+
+	// not triggered, and no coins are locked
+	activateTriggerOrders(ctx, k)
+	require.NotNil(t, k.GetTriggerOrder(ctx, triggerOrder.TriggerOrderID))
+	require.True(t, testInput.TokenKeeper.GetLockedCoins(ctx, testInput.TestAddrs[0]).IsZero())
+
+	// triggered by the falling price, and placed as a market order
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.4"))
+	activateTriggerOrders(ctx, k)
+	require.Nil(t, k.GetTriggerOrder(ctx, triggerOrder.TriggerOrderID))
+	order := k.GetOrder(ctx, types.FormatOrderID(ctx.BlockHeight(), 2))
+	require.NotNil(t, order)
+	require.EqualValues(t, triggerOrder.TxHash, order.TxHash)
+	require.EqualValues(t, types.SellOrder, order.Side)
+	require.EqualValues(t, types.OrderTypeMarket, order.OrderType)
+	require.EqualValues(t, sdk.MustNewDecFromStr("9.0"), order.Price)
+	require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	require.False(t, testInput.TokenKeeper.GetLockedCoins(ctx, testInput.TestAddrs[0]).IsZero())
+
+	// triggered orders fail with insufficient coins
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+	msg = types.NewMsgNewTriggerOrder(testInput.TestAddrs[0], types.TestTokenPair, types.SellOrder,
+		types.TriggerTypeStopLoss, "9.5", "9.1", "10000.0", "")
+	_, err = handleMsgNewTriggerOrder(ctx, k, msg, logger)
+	require.Nil(t, err)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.4"))
+	activateTriggerOrders(ctx, k)
+	require.EqualValues(t, 0, len(k.GetProductTriggerOrders(ctx, types.TestTokenPair)))
+	require.Nil(t, k.GetOrder(ctx, types.FormatOrderID(ctx.BlockHeight(), 3)))
+}
+
+func TestCancelTriggerOrder(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	k := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	logger := ctx.Logger()
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	msg := types.NewMsgNewTriggerOrder(testInput.TestAddrs[0], types.TestTokenPair, types.BuyOrder,
+		types.TriggerTypeTakeProfit, "9.5", "9.5", "1.0", "")
+	_, err = handleMsgNewTriggerOrder(ctx, k, msg, logger)
+	require.Nil(t, err)
+	triggerOrderID := k.GetProductTriggerOrders(ctx, types.TestTokenPair)[0].TriggerOrderID
+
+	// not the owner
+	_, err = handleMsgCancelTriggerOrder(ctx, k, types.NewMsgCancelTriggerOrder(testInput.TestAddrs[1],
+		triggerOrderID), logger)
+	require.NotNil(t, err)
+
+	_, err = handleMsgCancelTriggerOrder(ctx, k, types.NewMsgCancelTriggerOrder(testInput.TestAddrs[0],
+		triggerOrderID), logger)
+	require.Nil(t, err)
+	require.Nil(t, k.GetTriggerOrder(ctx, triggerOrderID))
+
+	// already cancelled
+	_, err = handleMsgCancelTriggerOrder(ctx, k, types.NewMsgCancelTriggerOrder(testInput.TestAddrs[0],
+		triggerOrderID), logger)
+	require.NotNil(t, err)
+}
+
+func TestTriggerOrderFeeAndLimit(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	k := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	logger := ctx.Logger()
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	params := types.DefaultTestParams()
+	params.MaxTriggerOrdersPerAccount = 2
+	k.SetParams(ctx, &params)
+
+	sender := testInput.TestAddrs[0]
+	newTriggerOrder := func() error {
+		msg := types.NewMsgNewTriggerOrder(sender, types.TestTokenPair, types.BuyOrder,
+			types.TriggerTypeTakeProfit, "9.5", "9.5", "1.0", "")
+		_, err := handleMsgNewTriggerOrder(ctx, k, msg, logger)
+		return err
+	}
+	balance := testInput.TokenKeeper.GetCoins(ctx, sender)
+	require.Nil(t, newTriggerOrder())
+	require.Nil(t, newTriggerOrder())
+	require.EqualValues(t, 2, k.GetTriggerOrderNum(ctx, sender))
+	fee := sdk.NewDecCoinFromDec(params.TriggerOrderFee.Denom, params.TriggerOrderFee.Amount.MulInt64(2))
+	require.EqualValues(t, balance.Sub(sdk.SysCoins{fee}), testInput.TokenKeeper.GetCoins(ctx, sender))
+
+	// the trigger orders of the account are limited
+	require.NotNil(t, newTriggerOrder())
+
+	// cancelled or triggered orders are not counted
+	triggerOrder := k.GetProductTriggerOrders(ctx, types.TestTokenPair)[0]
+	_, err = handleMsgCancelTriggerOrder(ctx, k, types.NewMsgCancelTriggerOrder(sender,
+		triggerOrder.TriggerOrderID), logger)
+	require.Nil(t, err)
+	require.EqualValues(t, 1, k.GetTriggerOrderNum(ctx, sender))
+	require.Nil(t, newTriggerOrder())
+
+	k.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.4"))
+	activateTriggerOrders(ctx, k)
+	require.EqualValues(t, 0, k.GetTriggerOrderNum(ctx, sender))
+
+	// the fee is charged from the sender
+	params.TriggerOrderFee = sdk.NewDecCoinFromDec(params.TriggerOrderFee.Denom, sdk.NewDec(1000000000))
+	k.SetParams(ctx, &params)
+	require.NotNil(t, newTriggerOrder())
+	require.EqualValues(t, 0, k.GetTriggerOrderNum(ctx, sender))
+}
+
+func TestGetTriggeredOrders(t *testing.T) {
+	testInput := keeper.CreateTestInput(t)
+	k := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	product := types.TestTokenPair
+	newTriggerOrder := func(side string, triggerType string, triggerPrice string) *types.TriggerOrder {
+		order := types.NewTriggerOrder("", testInput.TestAddrs[0], product, side, triggerType,
+			sdk.MustNewDecFromStr(triggerPrice), sdk.ZeroDec(), sdk.OneDec(), types.OrderTypeMarket, 0)
+		k.PlaceTriggerOrder(ctx, order)
+		return order
+	}
+	// waiting for a falling price
+	sellStopLoss := newTriggerOrder(types.SellOrder, types.TriggerTypeStopLoss, "9.0")
+	buyTakeProfit := newTriggerOrder(types.BuyOrder, types.TriggerTypeTakeProfit, "8.0")
+	// waiting for a rising price
+	buyStopLoss := newTriggerOrder(types.BuyOrder, types.TriggerTypeStopLoss, "11.0")
+	sellTakeProfit := newTriggerOrder(types.SellOrder, types.TriggerTypeTakeProfit, "12.0")
+	require.EqualValues(t, []string{product}, k.GetTriggerOrderProducts(ctx))
+
+	triggeredIDs := func(lastPrice string) (ids []string) {
+		for _, order := range k.GetTriggeredOrders(ctx, product, sdk.MustNewDecFromStr(lastPrice)) {
+			ids = append(ids, order.TriggerOrderID)
+		}
+		return ids
+	}
+	require.EqualValues(t, 0, len(triggeredIDs("10.0")))
+	require.EqualValues(t, 0, len(triggeredIDs("0")))
+	require.EqualValues(t, []string{sellStopLoss.TriggerOrderID}, triggeredIDs("9.0"))
+	require.EqualValues(t, []string{sellStopLoss.TriggerOrderID, buyTakeProfit.TriggerOrderID}, triggeredIDs("7.0"))
+	require.EqualValues(t, []string{buyStopLoss.TriggerOrderID}, triggeredIDs("11.5"))
+	require.EqualValues(t, []string{buyStopLoss.TriggerOrderID, sellTakeProfit.TriggerOrderID}, triggeredIDs("12.0"))
+
+	// the product is removed after all of its trigger orders are removed
+	for _, order := range []*types.TriggerOrder{sellStopLoss, buyTakeProfit, buyStopLoss} {
+		k.RemoveTriggerOrder(ctx, order)
+		require.EqualValues(t, []string{product}, k.GetTriggerOrderProducts(ctx))
+	}
+	k.RemoveTriggerOrder(ctx, sellTakeProfit)
+	require.EqualValues(t, 0, len(k.GetTriggerOrderProducts(ctx)))
+}
@@ -16,3 +16,14 @@ const (
 	BuyOrder            = "BUY"
 	SellOrder           = "SELL"
 )
+
+// fee types of the deal fees charged with the maker and taker fee rates set by dex operators,
+// and the rebates paid to makers
+const (
+	FeeTypeOrderDealMaker  = "deal_maker"
+	FeeTypeOrderDealTaker  = "deal_taker"
+	FeeTypeOrderDealRebate = "deal_rebate"
+)
+
+// FeeTypeTriggerOrderNew is the fee type of the fee charged for placing a trigger order
+const FeeTypeTriggerOrderNew = "trigger_new"
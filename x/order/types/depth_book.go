@@ -10,6 +10,7 @@ import (
 type Deal struct {
 	OrderID     string  `json:"order_id"`
 	Side        string  `json:"side"`
+	Price       sdk.Dec `json:"price"`
 	Quantity    sdk.Dec `json:"quantity"`
 	Fee         string  `json:"fee"`
 	FeeReceiver string  `json:"fee_receiver"`
@@ -126,3 +127,42 @@ func (depthBook *DepthBook) Copy() *DepthBook {
 	itemList = append(itemList, depthBook.Items...)
 	return &DepthBook{Items: itemList}
 }
+
+// AvailableQuantity returns the quantity on the opposite side that an order of side at price can match
+func (depthBook *DepthBook) AvailableQuantity(side string, price sdk.Dec) sdk.Dec {
+	available := sdk.ZeroDec()
+	for _, item := range depthBook.Items {
+		if side == BuyOrder && item.Price.LTE(price) {
+			available = available.Add(item.SellQuantity)
+		} else if side == SellOrder && item.Price.GTE(price) {
+			available = available.Add(item.BuyQuantity)
+		}
+	}
+	return available
+}
+
+// WorstPriceToFill returns the worst price that an order of side needs to fill quantity by matching the
+// opposite side from the best price, or the worst price of the opposite side if its quantity is not enough.
+// It returns false if there is no order on the opposite side.
+func (depthBook *DepthBook) WorstPriceToFill(side string, quantity sdk.Dec) (sdk.Dec, bool) {
+	worstPrice := sdk.ZeroDec()
+	found := false
+	filled := sdk.ZeroDec()
+	bookLength := len(depthBook.Items)
+	for i := 0; i < bookLength && filled.LT(quantity); i++ {
+		// buy orders match sell orders from low price to high price
+		item := depthBook.Items[bookLength-1-i]
+		itemQuantity := item.SellQuantity
+		if side == SellOrder {
+			// sell orders match buy orders from high price to low price
+			item = depthBook.Items[i]
+			itemQuantity = item.BuyQuantity
+		}
+		if itemQuantity.IsPositive() {
+			filled = filled.Add(itemQuantity)
+			worstPrice = item.Price
+			found = true
+		}
+	}
+	return worstPrice, found
+}
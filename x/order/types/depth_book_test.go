@@ -96,3 +96,35 @@ func TestCopy(t *testing.T) {
 	require.EqualValues(t, 1, len(depthBook.Items))
 	require.EqualValues(t, sdk.MustNewDecFromStr("0.5"), depthBook.Items[0].Price)
 }
+
+func TestAvailableQuantityAndWorstPriceToFill(t *testing.T) {
+	depthBook := &DepthBook{}
+	depthBook.InsertOrder(MockOrder("", TestTokenPair, SellOrder, "10.2", "1.0"))
+	depthBook.InsertOrder(MockOrder("", TestTokenPair, SellOrder, "10.1", "2.0"))
+	depthBook.InsertOrder(MockOrder("", TestTokenPair, BuyOrder, "9.9", "1.5"))
+
+	require.EqualValues(t, sdk.MustNewDecFromStr("3.0"), depthBook.AvailableQuantity(BuyOrder, sdk.MustNewDecFromStr("10.2")))
+	require.EqualValues(t, sdk.MustNewDecFromStr("2.0"), depthBook.AvailableQuantity(BuyOrder, sdk.MustNewDecFromStr("10.1")))
+	require.True(t, depthBook.AvailableQuantity(BuyOrder, sdk.MustNewDecFromStr("10.0")).IsZero())
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.5"), depthBook.AvailableQuantity(SellOrder, sdk.MustNewDecFromStr("9.0")))
+
+	price, ok := depthBook.WorstPriceToFill(BuyOrder, sdk.MustNewDecFromStr("2.0"))
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.1"), price)
+
+	price, ok = depthBook.WorstPriceToFill(BuyOrder, sdk.MustNewDecFromStr("2.5"))
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.2"), price)
+
+	// not enough quantity, the worst price of the opposite side
+	price, ok = depthBook.WorstPriceToFill(BuyOrder, sdk.MustNewDecFromStr("5.0"))
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.2"), price)
+
+	price, ok = depthBook.WorstPriceToFill(SellOrder, sdk.MustNewDecFromStr("5.0"))
+	require.True(t, ok)
+	require.EqualValues(t, sdk.MustNewDecFromStr("9.9"), price)
+
+	_, ok = (&DepthBook{}).WorstPriceToFill(SellOrder, sdk.MustNewDecFromStr("1.0"))
+	require.False(t, ok)
+}
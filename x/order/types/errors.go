@@ -38,6 +38,16 @@ const (
 	CodeNotOrderOwner                         uint32 = 63026
 	CodeProductIsEmpty                        uint32 = 63027
 	CodeAllOrderFailedToExecute               uint32 = 63028
+	CodeOrderItemOrderTypeIsInvalid           uint32 = 63029
+	CodePostOnlyOrderWouldMatch               uint32 = 63030
+	CodeNoLiquidityForMarketOrder             uint32 = 63031
+	CodeMarketOrderWithPrice                  uint32 = 63032
+	CodeTriggerOrderNotExist                  uint32 = 63033
+	CodeTriggerTypeIsInvalid                  uint32 = 63034
+	CodeTriggerPriceIsNotPositive             uint32 = 63035
+	CodeTriggerPriceAlreadyReached            uint32 = 63036
+	CodeTooManyTriggerOrders                  uint32 = 63037
+	CodeInsufficientTriggerOrderFee           uint32 = 63038
 )
 
 func ErrInvalidAddress(address string) sdk.EnvelopedErr {
@@ -152,3 +162,47 @@ func ErrNotOrderOwner(orderID string) sdk.EnvelopedErr {
 func ErrAllOrderFailedToExecute() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAllOrderFailedToExecute, "all order items failed to execute")}
 }
+
+func ErrOrderItemOrderTypeIsInvalid(orderType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeOrderItemOrderTypeIsInvalid, fmt.Sprintf("order item's order type(%s) is invalid", orderType))}
+}
+
+func ErrPostOnlyOrderWouldMatch(price sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodePostOnlyOrderWouldMatch, fmt.Sprintf("post only order with price(%v) would match the depth book immediately", price))}
+}
+
+func ErrNoLiquidityForMarketOrder(product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoLiquidityForMarketOrder, fmt.Sprintf("no orders of %s on the opposite side for the market order", product))}
+}
+
+func ErrMarketOrderWithPrice() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeMarketOrderWithPrice, "market order's price should be zero")}
+}
+
+func ErrTriggerOrderNotExist(triggerOrderID string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerOrderNotExist, fmt.Sprintf("trigger order(%s) does not exist or already closed", triggerOrderID))}
+}
+
+func ErrTriggerTypeIsInvalid(triggerType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerTypeIsInvalid, fmt.Sprintf("trigger type(%s) is not \"STOP_LOSS\" or \"TAKE_PROFIT\"", triggerType))}
+}
+
+func ErrTriggerPriceIsNotPositive() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerPriceIsNotPositive, "trigger price is not positive")}
+}
+
+func ErrTriggerPriceAlreadyReached(triggerPrice, lastPrice sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTriggerPriceAlreadyReached, fmt.Sprintf("trigger price(%v) is already reached by the last price(%v)", triggerPrice, lastPrice))}
+}
+
+func ErrTooManyTriggerOrders(maxTriggerOrders int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTooManyTriggerOrders, fmt.Sprintf("account already has the max number(%d) of trigger orders", maxTriggerOrders))}
+}
+
+func ErrInsufficientTriggerOrderFee(fee sdk.SysCoin, err error) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientTriggerOrderFee, fmt.Sprintf("failed to charge trigger order fee(%s): %v", fee, err))}
+}
+
+func ErrProductIsEmpty() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeProductIsEmpty, "product is required")}
+}
@@ -20,11 +20,13 @@ const (
 	RouterKey = ModuleName
 
 	// QueryOrderDetail query endpoints supported by the governance Querier
-	QueryOrderDetail = "detail"
-	QueryDepthBook   = "depthbook"
-	QueryParameters  = "params"
-	QueryStore       = "store"
-	QueryDepthBookV2 = "depthbookV2"
+	QueryOrderDetail   = "detail"
+	QueryDepthBook     = "depthbook"
+	QueryParameters    = "params"
+	QueryStore         = "store"
+	QueryDepthBookV2   = "depthbookV2"
+	QueryTriggerOrder  = "triggerOrder"
+	QueryTriggerOrders = "triggerOrders"
 
 	OrderStoreKey = ModuleName
 )
@@ -40,14 +42,62 @@ var (
 	PriceKey             = []byte{0x14}
 	ExpireBlockHeightKey = []byte{0x15}
 	OrderNumPerBlockKey  = []byte{0x16}
+	TriggerOrderKey      = []byte{0x22}
+	TriggerBookKey       = []byte{0x23}
+	TriggerProductKey    = []byte{0x25}
 
 	// none iterator keys
 	RecentlyClosedOrderIDsKey = []byte{0x17}
 	LastExpiredBlockHeightKey = []byte{0x18}
 	OpenOrderNumKey           = []byte{0x19}
 	StoreOrderNumKey          = []byte{0x20}
+	ImmediateOrderIDsKey      = []byte{0x21}
+	TriggerOrderSeqKey        = []byte{0x24}
+	TriggerOrderNumKey        = []byte{0x26}
 )
 
+// nolint
+func GetTriggerOrderKey(triggerOrderID string) []byte {
+	return append(TriggerOrderKey, []byte(triggerOrderID)...)
+}
+
+// GetTriggerBookKey returns the key of a trigger order in the trigger book of its product,
+// which is indexed by the trigger direction and the trigger price
+func GetTriggerBookKey(product string, rising bool, triggerPrice sdk.Dec, triggerOrderID string) []byte {
+	return append(GetTriggerBookPriceKey(product, rising, triggerPrice), []byte(triggerOrderID)...)
+}
+
+// GetTriggerBookPriceKey returns the prefix key of the trigger orders at a trigger price in the trigger book
+func GetTriggerBookPriceKey(product string, rising bool, triggerPrice sdk.Dec) []byte {
+	triggerPrice = sdk.MinDec(triggerPrice, sdk.MaxSortableDec)
+	return append(GetTriggerBookSideKey(product, rising), sdk.SortableDecBytes(triggerPrice)...)
+}
+
+// GetTriggerBookSideKey returns the prefix key of the trigger orders waiting for a rising or falling price
+// in the trigger book of a product
+func GetTriggerBookSideKey(product string, rising bool) []byte {
+	direction := byte(0)
+	if rising {
+		direction = 1
+	}
+	return append(GetTriggerBookProductKey(product), direction)
+}
+
+// GetTriggerBookProductKey returns the prefix key of the trigger book of a product
+func GetTriggerBookProductKey(product string) []byte {
+	return append(TriggerBookKey, []byte(product+":")...)
+}
+
+// GetTriggerOrderNumKey returns the key of the number of the trigger orders of an account
+func GetTriggerOrderNumKey(addr sdk.AccAddress) []byte {
+	return append(TriggerOrderNumKey, addr.Bytes()...)
+}
+
+// GetTriggerProductKey returns the key of a product which has trigger orders
+func GetTriggerProductKey(product string) []byte {
+	return append(TriggerProductKey, []byte(product)...)
+}
+
 // nolint
 func GetOrderKey(key string) []byte {
 	return append(OrderKey, []byte(key)...)
@@ -14,11 +14,12 @@ const (
 
 // nolint
 type MsgNewOrder struct {
-	Sender   sdk.AccAddress `json:"sender"`   // order maker address
-	Product  string         `json:"product"`  // product for trading pair in full name of the tokens
-	Side     string         `json:"side"`     // BUY/SELL
-	Price    sdk.Dec        `json:"price"`    // price of the order
-	Quantity sdk.Dec        `json:"quantity"` // quantity of the order
+	Sender    sdk.AccAddress `json:"sender"`     // order maker address
+	Product   string         `json:"product"`    // product for trading pair in full name of the tokens
+	Side      string         `json:"side"`       // BUY/SELL
+	Price     sdk.Dec        `json:"price"`      // price of the order
+	Quantity  sdk.Dec        `json:"quantity"`   // quantity of the order
+	OrderType string         `json:"order_type"` // LIMIT/MARKET/IOC/FOK/POST_ONLY
 }
 
 // NewMsgNewOrder is a constructor function for MsgNewOrder
@@ -62,10 +63,11 @@ type MsgNewOrders struct {
 
 // nolint
 type OrderItem struct {
-	Product  string  `json:"product"`  // product for trading pair in full name of the tokens
-	Side     string  `json:"side"`     // BUY/SELL
-	Price    sdk.Dec `json:"price"`    // price of the order
-	Quantity sdk.Dec `json:"quantity"` // quantity of the order
+	Product   string  `json:"product"`              // product for trading pair in full name of the tokens
+	Side      string  `json:"side"`                 // BUY/SELL
+	Price     sdk.Dec `json:"price"`                // price of the order, zero for market orders
+	Quantity  sdk.Dec `json:"quantity"`             // quantity of the order
+	OrderType string  `json:"order_type,omitempty"` // LIMIT/MARKET/IOC/FOK/POST_ONLY, LIMIT if empty
 }
 
 // nolint
@@ -79,6 +81,14 @@ func NewOrderItem(product string, side string, price string,
 	}
 }
 
+// NewOrderItemWithType is a constructor function for OrderItem with the specified order type
+func NewOrderItemWithType(product string, side string, price string,
+	quantity string, orderType string) OrderItem {
+	item := NewOrderItem(product, side, price, quantity)
+	item.OrderType = orderType
+	return item
+}
+
 // NewMsgNewOrders is a constructor function for MsgNewOrder
 func NewMsgNewOrders(sender sdk.AccAddress, orderItems []OrderItem) MsgNewOrders {
 	return MsgNewOrders{
@@ -118,7 +128,18 @@ func (msg MsgNewOrders) ValidateBasic() sdk.Error {
 		if item.Side != BuyOrder && item.Side != SellOrder {
 			return ErrOrderItemSideIsNotBuyAndSell()
 		}
-		if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
+		if !IsValidOrderType(item.OrderType) {
+			return ErrOrderItemOrderTypeIsInvalid(item.OrderType)
+		}
+		// the price of a market order is decided by the depth book
+		if item.OrderType == OrderTypeMarket {
+			if !item.Price.IsZero() {
+				return ErrMarketOrderWithPrice()
+			}
+			if !item.Quantity.IsPositive() {
+				return ErrOrderItemPriceOrQuantityIsNotPositive()
+			}
+		} else if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
 			return ErrOrderItemPriceOrQuantityIsNotPositive()
 		}
 	}
@@ -214,6 +235,122 @@ func (msg MsgCancelOrders) CalculateGas(gasUnit uint64) uint64 {
 	return uint64(len(msg.OrderIDs)) * gasUnit
 }
 
+// MsgNewTriggerOrder places a trigger order, which is placed as a new order when the last price
+// of the product reaches the trigger price
+type MsgNewTriggerOrder struct {
+	Sender       sdk.AccAddress `json:"sender"`        // order maker address
+	Product      string         `json:"product"`       // product for trading pair in full name of the tokens
+	Side         string         `json:"side"`          // BUY/SELL
+	TriggerType  string         `json:"trigger_type"`  // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice sdk.Dec        `json:"trigger_price"` // price to trigger the order
+	Price        sdk.Dec        `json:"price"`         // price of the triggered order, zero for market orders
+	Quantity     sdk.Dec        `json:"quantity"`      // quantity of the triggered order
+	OrderType    string         `json:"order_type"`    // LIMIT/MARKET/IOC/FOK, LIMIT if empty
+}
+
+// NewMsgNewTriggerOrder is a constructor function for MsgNewTriggerOrder
+func NewMsgNewTriggerOrder(sender sdk.AccAddress, product, side, triggerType, triggerPrice, price,
+	quantity, orderType string) MsgNewTriggerOrder {
+	return MsgNewTriggerOrder{
+		Sender:       sender,
+		Product:      product,
+		Side:         side,
+		TriggerType:  triggerType,
+		TriggerPrice: sdk.MustNewDecFromStr(triggerPrice),
+		Price:        sdk.MustNewDecFromStr(price),
+		Quantity:     sdk.MustNewDecFromStr(quantity),
+		OrderType:    orderType,
+	}
+}
+
+// nolint
+func (msg MsgNewTriggerOrder) Route() string { return "order" }
+
+// nolint
+func (msg MsgNewTriggerOrder) Type() string { return "newTrigger" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgNewTriggerOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	// post only orders are not allowed to be triggered, because they can't match the depth book
+	if msg.OrderType == OrderTypePostOnly {
+		return ErrOrderItemOrderTypeIsInvalid(msg.OrderType)
+	}
+	item := OrderItem{
+		Product:   msg.Product,
+		Side:      msg.Side,
+		Price:     msg.Price,
+		Quantity:  msg.Quantity,
+		OrderType: msg.OrderType,
+	}
+	if err := NewMsgNewOrders(msg.Sender, []OrderItem{item}).ValidateBasic(); err != nil {
+		return err
+	}
+	if !IsValidTriggerType(msg.TriggerType) {
+		return ErrTriggerTypeIsInvalid(msg.TriggerType)
+	}
+	if !msg.TriggerPrice.IsPositive() {
+		return ErrTriggerPriceIsNotPositive()
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgNewTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNewTriggerOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCancelTriggerOrder cancels a trigger order which is not triggered yet
+type MsgCancelTriggerOrder struct {
+	Sender         sdk.AccAddress `json:"sender"` // trigger order maker address
+	TriggerOrderID string         `json:"trigger_order_id"`
+}
+
+// NewMsgCancelTriggerOrder is a constructor function for MsgCancelTriggerOrder
+func NewMsgCancelTriggerOrder(sender sdk.AccAddress, triggerOrderID string) MsgCancelTriggerOrder {
+	return MsgCancelTriggerOrder{
+		Sender:         sender,
+		TriggerOrderID: triggerOrderID,
+	}
+}
+
+// nolint
+func (msg MsgCancelTriggerOrder) Route() string { return "order" }
+
+// nolint
+func (msg MsgCancelTriggerOrder) Type() string { return "cancelTrigger" }
+
+// nolint
+func (msg MsgCancelTriggerOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if msg.TriggerOrderID == "" {
+		return ErrUserInputOrderIDIsEmpty()
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelTriggerOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
 // nolint
 type OrderResult struct {
 	Error   error  `json:"error"`
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/common"
 
 	"github.com/stretchr/testify/require"
@@ -229,6 +230,96 @@ func TestMsgMultiNewOrderInvalid(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestMsgNewOrdersOrderType(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	product := "btc_" + common.NativeToken
+
+	for _, orderType := range []string{"", OrderTypeLimit, OrderTypeIOC, OrderTypeFOK, OrderTypePostOnly} {
+		item := NewOrderItemWithType(product, BuyOrder, testPrice, testQuantity, orderType)
+		err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+		require.Nil(t, err)
+	}
+
+	// invalid order type
+	item := NewOrderItemWithType(product, BuyOrder, testPrice, testQuantity, "GTC")
+	err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+	require.NotNil(t, err)
+
+	// market order with zero price
+	item = NewOrderItemWithType(product, BuyOrder, "0", testQuantity, OrderTypeMarket)
+	err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+	require.Nil(t, err)
+
+	// market order with price
+	item = NewOrderItemWithType(product, BuyOrder, testPrice, testQuantity, OrderTypeMarket)
+	err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+	require.NotNil(t, err)
+
+	// market order with zero quantity
+	item = NewOrderItemWithType(product, BuyOrder, "0", "0", OrderTypeMarket)
+	err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+	require.NotNil(t, err)
+
+	// IOC order with zero price
+	item = NewOrderItemWithType(product, BuyOrder, "0", testQuantity, OrderTypeIOC)
+	err = NewMsgNewOrders(addr, []OrderItem{item}).ValidateBasic()
+	require.NotNil(t, err)
+}
+
+func TestMsgNewTriggerOrder(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	product := "btc_" + common.NativeToken
+
+	msg := NewMsgNewTriggerOrder(addr, product, SellOrder, TriggerTypeStopLoss, "9.0", "0", testQuantity,
+		OrderTypeMarket)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "newTrigger", msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg = NewMsgNewTriggerOrder(addr, product, BuyOrder, TriggerTypeTakeProfit, "9.0", testPrice, testQuantity, "")
+	require.Nil(t, msg.ValidateBasic())
+
+	// empty sender
+	msg = NewMsgNewTriggerOrder(nil, product, SellOrder, TriggerTypeStopLoss, "9.0", testPrice, testQuantity, "")
+	require.NotNil(t, msg.ValidateBasic())
+
+	// invalid trigger type
+	msg = NewMsgNewTriggerOrder(addr, product, SellOrder, "STOP", "9.0", testPrice, testQuantity, "")
+	require.NotNil(t, msg.ValidateBasic())
+
+	// zero trigger price
+	msg = NewMsgNewTriggerOrder(addr, product, SellOrder, TriggerTypeStopLoss, "0", testPrice, testQuantity, "")
+	require.NotNil(t, msg.ValidateBasic())
+
+	// post only orders can't be triggered
+	msg = NewMsgNewTriggerOrder(addr, product, SellOrder, TriggerTypeStopLoss, "9.0", testPrice, testQuantity,
+		OrderTypePostOnly)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// market order with price
+	msg = NewMsgNewTriggerOrder(addr, product, SellOrder, TriggerTypeStopLoss, "9.0", testPrice, testQuantity,
+		OrderTypeMarket)
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgCancelTriggerOrder(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+
+	msg := NewMsgCancelTriggerOrder(addr, "TID0000000010-1")
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "cancelTrigger", msg.Type())
+
+	msg = NewMsgCancelTriggerOrder(addr, "")
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgCancelTriggerOrder(nil, "TID0000000010-1")
+	require.NotNil(t, msg.ValidateBasic())
+}
+
 func TestMsgMultiCancelOrder(t *testing.T) {
 	orderID := testOrderID
 	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
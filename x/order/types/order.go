@@ -52,6 +52,30 @@ const (
 	//OrderStatusPartialFilled          = 6
 )
 
+// order types, an empty order type is treated as a limit order
+const (
+	// OrderTypeLimit rests on the depth book until it is filled, cancelled or expired
+	OrderTypeLimit = "LIMIT"
+	// OrderTypeMarket takes the depth book at any price, the unfilled quantity is expired after matching
+	OrderTypeMarket = "MARKET"
+	// OrderTypeIOC (immediate or cancel) is expired after matching with its unfilled quantity
+	OrderTypeIOC = "IOC"
+	// OrderTypeFOK (fill or kill) is expired without any deal if it can't be fully filled in the first match
+	OrderTypeFOK = "FOK"
+	// OrderTypePostOnly is rejected if it would match the depth book when placed
+	OrderTypePostOnly = "POST_ONLY"
+)
+
+// IsValidOrderType returns true if the order type is supported
+func IsValidOrderType(orderType string) bool {
+	switch orderType {
+	case "", OrderTypeLimit, OrderTypeMarket, OrderTypeIOC, OrderTypeFOK, OrderTypePostOnly:
+		return true
+	default:
+		return false
+	}
+}
+
 // nolint
 const (
 	OrderExtraInfoKeyNewFee     = "newFee"
@@ -59,6 +83,9 @@ const (
 	OrderExtraInfoKeyExpireFee  = "expireFee"
 	OrderExtraInfoKeyDealFee    = "dealFee"
 	OrderExtraInfoKeyReceiveFee = "receiveFee"
+	OrderExtraInfoKeyMakerFee   = "makerFee"
+	OrderExtraInfoKeyTakerFee   = "takerFee"
+	OrderExtraInfoKeyDealRebate = "dealRebate"
 )
 
 // nolint
@@ -77,7 +104,8 @@ type Order struct {
 	Timestamp         int64          `json:"timestamp"`        // created timestamp
 	OrderExpireBlocks int64          `json:"order_expire_blocks"`
 	FeePerBlock       sdk.SysCoin    `json:"fee_per_block"`
-	ExtraInfo         string         `json:"extra_info"` // extra info of order in json format
+	ExtraInfo         string         `json:"extra_info"`           // extra info of order in json format
+	OrderType         string         `json:"order_type,omitempty"` // LIMIT/MARKET/IOC/FOK/POST_ONLY
 }
 
 // nolint
@@ -104,6 +132,16 @@ func NewOrder(txHash string, sender sdk.AccAddress, product, side string, price,
 	return order
 }
 
+// IsImmediate returns true if the order must not rest on the depth book after its first match
+func (order *Order) IsImmediate() bool {
+	switch order.OrderType {
+	case OrderTypeMarket, OrderTypeIOC, OrderTypeFOK:
+		return true
+	default:
+		return false
+	}
+}
+
 func (order *Order) String() string {
 	if orderJSON, err := json.Marshal(order); err != nil {
 		panic(err)
@@ -168,9 +206,29 @@ func (order *Order) RecordOrderReceiveFee(fee sdk.SysCoins) {
 
 // RecordOrderDealFee : An order may have several deals
 func (order *Order) RecordOrderDealFee(fee sdk.SysCoins) {
-	oldValue := order.GetExtraInfoWithKey(OrderExtraInfoKeyDealFee)
+	order.addExtraInfoFee(OrderExtraInfoKeyDealFee, fee)
+}
+
+// RecordOrderDealFeeAs records the deal fee charged with the fee schedule of the product, the fees charged
+// as a maker and as a taker are also recorded separately
+func (order *Order) RecordOrderDealFeeAs(fee sdk.SysCoins, isMaker bool) {
+	order.addExtraInfoFee(OrderExtraInfoKeyDealFee, fee)
+	if isMaker {
+		order.addExtraInfoFee(OrderExtraInfoKeyMakerFee, fee)
+	} else {
+		order.addExtraInfoFee(OrderExtraInfoKeyTakerFee, fee)
+	}
+}
+
+// RecordOrderDealRebate records the rebates paid to the order as a maker
+func (order *Order) RecordOrderDealRebate(rebate sdk.SysCoins) {
+	order.addExtraInfoFee(OrderExtraInfoKeyDealRebate, rebate)
+}
+
+func (order *Order) addExtraInfoFee(key string, fee sdk.SysCoins) {
+	oldValue := order.GetExtraInfoWithKey(key)
 	if oldValue == "" {
-		order.setExtraInfoWithKeyValue(OrderExtraInfoKeyDealFee, fee.String())
+		order.setExtraInfoWithKeyValue(key, fee.String())
 		return
 	}
 	oldFee, err := sdk.ParseDecCoins(oldValue)
@@ -179,7 +237,7 @@ func (order *Order) RecordOrderDealFee(fee sdk.SysCoins) {
 		return
 	}
 	newFee := oldFee.Add2(fee)
-	order.setExtraInfoWithKeyValue(OrderExtraInfoKeyDealFee, newFee.String())
+	order.setExtraInfoWithKeyValue(key, newFee.String())
 }
 
 // nolint
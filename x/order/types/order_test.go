@@ -49,6 +49,19 @@ func TestOrderUpdateExtraInfo(t *testing.T) {
 	order.RecordOrderDealFee(fee)
 	require.EqualValues(t, fee.Add2(fee).String(),
 		order.GetExtraInfoWithKey(OrderExtraInfoKeyDealFee))
+	require.EqualValues(t, "", order.GetExtraInfoWithKey(OrderExtraInfoKeyMakerFee))
+
+	// Record deal fee charged with the fee schedule
+	order.RecordOrderDealFeeAs(fee, true)
+	order.RecordOrderDealFeeAs(fee, false)
+	require.EqualValues(t, fee.Add2(fee).Add2(fee).Add2(fee).String(),
+		order.GetExtraInfoWithKey(OrderExtraInfoKeyDealFee))
+	require.EqualValues(t, fee.String(), order.GetExtraInfoWithKey(OrderExtraInfoKeyMakerFee))
+	require.EqualValues(t, fee.String(), order.GetExtraInfoWithKey(OrderExtraInfoKeyTakerFee))
+
+	// Record deal rebate
+	order.RecordOrderDealRebate(fee)
+	require.EqualValues(t, fee.String(), order.GetExtraInfoWithKey(OrderExtraInfoKeyDealRebate))
 
 	// Record new fee
 	order.RecordOrderNewFee(fee)
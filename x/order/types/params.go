@@ -20,6 +20,10 @@ const (
 	DefaultFeeRateTrade          = "0.001" // percentage
 	DefaultNewOrderMsgGasUnit    = 40000
 	DefaultCancelOrderMsgGasUnit = 30000
+
+	// Trigger order param
+	DefaultTriggerOrderFeeAmount      = "0.001" // okt
+	DefaultMaxTriggerOrdersPerAccount = 50
 )
 
 // nolint : Parameter keys
@@ -30,7 +34,14 @@ var (
 	KeyTradeFeeRate          = []byte("TradeFeeRate")
 	KeyNewOrderMsgGasUnit    = []byte("NewOrderMsgGasUnit")
 	KeyCancelOrderMsgGasUnit = []byte("CancelOrderMsgGasUnit")
+	KeyContinuousProducts    = []byte("ContinuousProducts")
+	KeyTriggerOrderFee       = []byte("TriggerOrderFee")
+	KeyMaxTriggerOrders      = []byte("MaxTriggerOrdersPerAccount")
 	DefaultFeePerBlock       = sdk.NewDecCoinFromDec(DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr(DefaultFeeAmountPerBlock))
+	DefaultTriggerOrderFee   = sdk.NewDecCoinFromDec(DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr(DefaultTriggerOrderFeeAmount))
+
+	// OptionalParamKeys are the keys of the params added after the genesis, which are the defaults until set
+	OptionalParamKeys = [][]byte{KeyContinuousProducts, KeyTriggerOrderFee, KeyMaxTriggerOrders}
 )
 
 // nolint
@@ -44,6 +55,12 @@ type Params struct {
 	TradeFeeRate          sdk.Dec     `json:"trade_fee_rate"`
 	NewOrderMsgGasUnit    uint64      `json:"new_order_msg_gas_unit"`
 	CancelOrderMsgGasUnit uint64      `json:"cancel_order_msg_gas_unit"`
+	// products matched by the continuous auction engine, others are matched by the periodic auction engine
+	ContinuousProducts []string `json:"continuous_products"`
+	// fee charged for placing a trigger order, which is not refunded when it is triggered or cancelled
+	TriggerOrderFee sdk.SysCoin `json:"trigger_order_fee"`
+	// max number of the trigger orders of an account waiting on the trigger books
+	MaxTriggerOrdersPerAccount int64 `json:"max_trigger_orders_per_account"`
 }
 
 // ParamKeyTable for auth module
@@ -56,6 +73,24 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateContinuousProducts(value interface{}) error {
+	products, ok := value.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	seen := make(map[string]struct{}, len(products))
+	for _, product := range products {
+		if len(product) == 0 {
+			return fmt.Errorf("continuous products contains an empty product")
+		}
+		if _, ok := seen[product]; ok {
+			return fmt.Errorf("continuous products contains duplicated product %s", product)
+		}
+		seen[product] = struct{}{}
+	}
+	return nil
+}
+
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 // nolint
@@ -67,6 +102,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyTradeFeeRate, &p.TradeFeeRate, common.ValidateRateNotNeg("trade fee rate")},
 		{KeyNewOrderMsgGasUnit, &p.NewOrderMsgGasUnit, common.ValidateUint64Positive("new order msg gas unit")},
 		{KeyCancelOrderMsgGasUnit, &p.CancelOrderMsgGasUnit, common.ValidateUint64Positive("cancel order msg gas unit")},
+		{KeyContinuousProducts, &p.ContinuousProducts, validateContinuousProducts},
+		{KeyTriggerOrderFee, &p.TriggerOrderFee, common.ValidateSysCoin("trigger order fee")},
+		{KeyMaxTriggerOrders, &p.MaxTriggerOrdersPerAccount, common.ValidateInt64Positive("max trigger orders per account")},
 	}
 }
 
@@ -79,7 +117,20 @@ func DefaultParams() Params {
 		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
 		NewOrderMsgGasUnit:    DefaultNewOrderMsgGasUnit,
 		CancelOrderMsgGasUnit: DefaultCancelOrderMsgGasUnit,
+
+		TriggerOrderFee:            DefaultTriggerOrderFee,
+		MaxTriggerOrdersPerAccount: DefaultMaxTriggerOrdersPerAccount,
+	}
+}
+
+// IsContinuousProduct returns true if the product is matched by the continuous auction engine
+func (p Params) IsContinuousProduct(product string) bool {
+	for _, continuousProduct := range p.ContinuousProducts {
+		if continuousProduct == product {
+			return true
+		}
 	}
+	return false
 }
 
 // String implements the stringer interface.
@@ -90,7 +141,11 @@ func (p Params) String() string {
   FeePerBlock: %s
   TradeFeeRate: %s
   NewOrderMsgGasUnit: %d
-  CancelOrderMsgGasUnit: %d`, p.OrderExpireBlocks,
+  CancelOrderMsgGasUnit: %d
+  ContinuousProducts: %v
+  TriggerOrderFee: %s
+  MaxTriggerOrdersPerAccount: %d`, p.OrderExpireBlocks,
 		p.MaxDealsPerBlock, p.FeePerBlock,
-		p.TradeFeeRate, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit)
+		p.TradeFeeRate, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit, p.ContinuousProducts,
+		p.TriggerOrderFee, p.MaxTriggerOrdersPerAccount)
 }
@@ -14,6 +14,9 @@ func DefaultTestParams() Params {
 		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+
+		TriggerOrderFee:            DefaultTriggerOrderFee,
+		MaxTriggerOrdersPerAccount: DefaultMaxTriggerOrdersPerAccount,
 	}
 }
 
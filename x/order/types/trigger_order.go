@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// TriggerTypeStopLoss is triggered when the price moves against the order:
+	// a stop-loss sell is triggered by a falling price and a stop-loss buy by a rising price
+	TriggerTypeStopLoss = "STOP_LOSS"
+	// TriggerTypeTakeProfit is triggered when the price moves in favor of the order:
+	// a take-profit sell is triggered by a rising price and a take-profit buy by a falling price
+	TriggerTypeTakeProfit = "TAKE_PROFIT"
+
+	TriggerOrderStatusTriggered = "triggered"
+	TriggerOrderStatusFailed    = "failed"
+	TriggerOrderStatusCancelled = "cancelled"
+)
+
+// TriggerOrder is a conditional order resting on the trigger book of its product.
+// It is placed as a regular order when the last price of its product crosses the trigger price.
+type TriggerOrder struct {
+	TriggerOrderID string         `json:"trigger_order_id"` // trigger order id
+	TxHash         string         `json:"txhash"`           // txHash of the place trigger order tx
+	Sender         sdk.AccAddress `json:"sender"`           // trigger order maker address
+	Product        string         `json:"product"`          // product for trading pair in full name of the tokens
+	Side           string         `json:"side"`             // BUY/SELL
+	TriggerType    string         `json:"trigger_type"`     // STOP_LOSS/TAKE_PROFIT
+	TriggerPrice   sdk.Dec        `json:"trigger_price"`    // price to trigger the order
+	Price          sdk.Dec        `json:"price"`            // price of the triggered order, zero for market orders
+	Quantity       sdk.Dec        `json:"quantity"`         // quantity of the triggered order
+	OrderType      string         `json:"order_type"`       // order type of the triggered order
+	Timestamp      int64          `json:"timestamp"`        // created timestamp
+}
+
+// NewTriggerOrder creates a new trigger order
+func NewTriggerOrder(txHash string, sender sdk.AccAddress, product, side, triggerType string,
+	triggerPrice, price, quantity sdk.Dec, orderType string, timestamp int64) *TriggerOrder {
+	return &TriggerOrder{
+		TxHash:       txHash,
+		Sender:       sender,
+		Product:      product,
+		Side:         side,
+		TriggerType:  triggerType,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+		Quantity:     quantity,
+		OrderType:    orderType,
+		Timestamp:    timestamp,
+	}
+}
+
+// IsTriggered returns true if the last price reaches the trigger price
+func (order *TriggerOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	if !lastPrice.IsPositive() {
+		return false
+	}
+	if order.IsRising() {
+		return lastPrice.GTE(order.TriggerPrice)
+	}
+	return lastPrice.LTE(order.TriggerPrice)
+}
+
+// IsRising returns true if the order waits for a rising price, which are stop-loss buy and take-profit sell orders
+func (order *TriggerOrder) IsRising() bool {
+	return (order.TriggerType == TriggerTypeStopLoss) == (order.Side == BuyOrder)
+}
+
+// IsValidTriggerType returns true if the trigger type is supported
+func IsValidTriggerType(triggerType string) bool {
+	return triggerType == TriggerTypeStopLoss || triggerType == TriggerTypeTakeProfit
+}
+
+// nolint
+func FormatTriggerOrderID(blockHeight, seq int64) string {
+	format := "TID%010d-%d"
+	if blockHeight > 9999999999 {
+		format = "TID%d-%d"
+	}
+	return fmt.Sprintf(format, blockHeight, seq)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerOrderIsTriggered(t *testing.T) {
+	triggerPrice := sdk.MustNewDecFromStr("10.0")
+	newTriggerOrder := func(side, triggerType string) *TriggerOrder {
+		return NewTriggerOrder("", nil, TestTokenPair, side, triggerType, triggerPrice,
+			sdk.ZeroDec(), sdk.OneDec(), OrderTypeMarket, 0)
+	}
+	higher := sdk.MustNewDecFromStr("10.1")
+	lower := sdk.MustNewDecFromStr("9.9")
+
+	// stop-loss sell and take-profit buy are triggered by a falling price
+	for _, order := range []*TriggerOrder{
+		newTriggerOrder(SellOrder, TriggerTypeStopLoss),
+		newTriggerOrder(BuyOrder, TriggerTypeTakeProfit),
+	} {
+		require.False(t, order.IsTriggered(higher))
+		require.True(t, order.IsTriggered(triggerPrice))
+		require.True(t, order.IsTriggered(lower))
+		require.False(t, order.IsTriggered(sdk.ZeroDec()))
+	}
+
+	// stop-loss buy and take-profit sell are triggered by a rising price
+	for _, order := range []*TriggerOrder{
+		newTriggerOrder(BuyOrder, TriggerTypeStopLoss),
+		newTriggerOrder(SellOrder, TriggerTypeTakeProfit),
+	} {
+		require.True(t, order.IsTriggered(higher))
+		require.True(t, order.IsTriggered(triggerPrice))
+		require.False(t, order.IsTriggered(lower))
+	}
+}
+
+func TestFormatTriggerOrderID(t *testing.T) {
+	require.EqualValues(t, "TID0000000010-1", FormatTriggerOrderID(10, 1))
+	require.EqualValues(t, "TID10000000000-2", FormatTriggerOrderID(10000000000, 2))
+}
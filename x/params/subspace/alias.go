@@ -5,6 +5,7 @@ import (
 )
 
 type (
+	ParamSet         = subspace.ParamSet
 	ParamSetPairs    = subspace.ParamSetPairs
 	KeyTable         = subspace.KeyTable
 	ValueValidatorFn = subspace.ValueValidatorFn
@@ -30,6 +30,8 @@ var (
 	NewQuerier                         = keeper.NewQuerier
 	RegisterCodec                      = types.RegisterCodec
 	NewCommission                      = types.NewCommission
+	NewCommissionRates                 = types.NewCommissionRates
+	DefaultCommissionRates             = types.DefaultCommissionRates
 	ErrNoValidatorFound                = types.ErrNoValidatorFound
 	ErrValidatorOwnerExists            = types.ErrValidatorOwnerExists
 	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
@@ -46,6 +48,9 @@ var (
 	NewValidator                       = types.NewValidator
 	NewDescription                     = types.NewDescription
 	NewMsgAddShares                    = types.NewMsgAddShares
+	NewMsgRedelegate                   = types.NewMsgRedelegate
+	NewMsgRegProxy                     = types.NewMsgRegProxy
+	NewMsgBindProxy                    = types.NewMsgBindProxy
 	NewGenesisState                    = types.NewGenesisState
 	DelegatorAddSharesInvariant        = keeper.DelegatorAddSharesInvariant
 
@@ -64,6 +69,7 @@ type (
 	ValidatorI                = exported.ValidatorI
 	Delegator                 = types.Delegator
 	UndelegationInfo          = types.UndelegationInfo
+	Redelegation              = types.Redelegation
 	ProxyDelegatorKeyExported = types.ProxyDelegatorKeyExported
 	SharesResponses           = types.SharesResponses
 )
@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 	stakingQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryDelegator(queryRoute, cdc),
+		GetCmdQueryRedelegations(queryRoute, cdc),
 		GetCmdQueryValidatorShares(queryRoute, cdc),
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
@@ -281,6 +282,50 @@ $ %s query staking delegator ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 	}
 }
 
+// GetCmdQueryRedelegations gets the redelegations query command.
+func GetCmdQueryRedelegations(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegations [address]",
+		Short: "query the redelegations in progress of a delegator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegations in progress of a delegator, which are slashable for the infractions of
+the source validators until completion
+
+Example:
+$ %s query staking redelegations ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryRedelegations)
+			res, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var redelegation types.Redelegation
+			if err := cdc.UnmarshalJSON(res, &redelegation); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(redelegation)
+		},
+	}
+}
+
 // DelegatorResponse is designed for delegator info query
 type DelegatorResponse struct {
 	DelegatorAddress     sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
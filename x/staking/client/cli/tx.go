@@ -43,6 +43,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 			GetCmdDeposit(cdc),
 			GetCmdWithdraw(cdc),
 			GetCmdAddShares(cdc),
+			GetCmdRedelegate(cdc),
 		)...)
 
 	stakingTxCmd.AddCommand(GetCmdProxy(cdc))
@@ -72,7 +73,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(FsPk)
 	//cmd.Flags().AddFlagSet(FsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
-	//cmd.Flags().AddFlagSet(FsCommissionCreate)
+	cmd.Flags().AddFlagSet(FsCommissionCreate)
 	//cmd.Flags().AddFlagSet(FsMinSelfDelegation)
 
 	cmd.Flags().String(FlagIP, "",
@@ -121,7 +122,17 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 			//}
 			//
 			//msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation)
-			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description)
+			var newRate *sdk.Dec
+			commissionRate := viper.GetString(FlagCommissionRate)
+			if commissionRate != "" {
+				rate, err := sdk.NewDecFromStr(commissionRate)
+				if err != nil {
+					return fmt.Errorf("invalid new commission rate: %v", err)
+				}
+				newRate = &rate
+			}
+
+			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate)
 
 			// build and sign the transaction, then broadcast to Tendermint
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
@@ -129,7 +140,7 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
-	//cmd.Flags().AddFlagSet(fsCommissionUpdate)
+	cmd.Flags().AddFlagSet(fsCommissionUpdate)
 
 	return cmd
 }
@@ -137,11 +148,11 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 //__________________________________________________________
 
 var (
-//defaultTokens                  = sdk.TokensFromConsensusPower(100)
-//defaultAmount                  = defaultTokens.String() + sdk.DefaultBondDenom
-//defaultCommissionRate          = "0.1"
-//defaultCommissionMaxRate       = "0.2"
-//defaultCommissionMaxChangeRate = "0.01"
+	//defaultTokens                  = sdk.TokensFromConsensusPower(100)
+	//defaultAmount                  = defaultTokens.String() + sdk.DefaultBondDenom
+	defaultCommissionRate          = "1"
+	defaultCommissionMaxRate       = "1"
+	defaultCommissionMaxChangeRate = "0"
 )
 
 // CreateValidatorMsgHelpers returns the flagset, particular flags, and a description of defaults
@@ -154,7 +165,7 @@ func CreateValidatorMsgHelpers(ipDefault string) (fs *flag.FlagSet, nodeIDFlag,
 	fsCreateValidator.String(FlagWebsite, "", "The validator's (optional) website")
 	fsCreateValidator.String(FlagDetails, "", "The validator's (optional) details")
 	fsCreateValidator.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
-	//fsCreateValidator.AddFlagSet(FsCommissionCreate)
+	fsCreateValidator.AddFlagSet(FsCommissionCreate)
 	//fsCreateValidator.AddFlagSet(FsMinSelfDelegation)
 	//fsCreateValidator.AddFlagSet(FsAmount)
 	fsCreateValidator.AddFlagSet(FsPk)
@@ -193,15 +204,15 @@ func PrepareFlagsForTxCreateValidator(
 	//if viper.GetString(FlagAmount) == "" {
 	//	viper.Set(FlagAmount, defaultAmount)
 	//}
-	//if viper.GetString(FlagCommissionRate) == "" {
-	//	viper.Set(FlagCommissionRate, defaultCommissionRate)
-	//}
-	//if viper.GetString(FlagCommissionMaxRate) == "" {
-	//	viper.Set(FlagCommissionMaxRate, defaultCommissionMaxRate)
-	//}
-	//if viper.GetString(FlagCommissionMaxChangeRate) == "" {
-	//	viper.Set(FlagCommissionMaxChangeRate, defaultCommissionMaxChangeRate)
-	//}
+	if viper.GetString(FlagCommissionRate) == "" {
+		viper.Set(FlagCommissionRate, defaultCommissionRate)
+	}
+	if viper.GetString(FlagCommissionMaxRate) == "" {
+		viper.Set(FlagCommissionMaxRate, defaultCommissionMaxRate)
+	}
+	if viper.GetString(FlagCommissionMaxChangeRate) == "" {
+		viper.Set(FlagCommissionMaxChangeRate, defaultCommissionMaxChangeRate)
+	}
 	// if viper.GetString(FlagMinSelfDelegation) == "" {
 	//	viper.Set(FlagMinSelfDelegation, defaultMinSelfDelegation)
 	//}
@@ -224,6 +235,18 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 		viper.GetString(FlagDetails),
 	)
 
+	// get the initial validator commission rates, the default ones are declared if none of them is specified
+	commission := types.DefaultCommissionRates()
+	rateStr := viper.GetString(FlagCommissionRate)
+	maxRateStr := viper.GetString(FlagCommissionMaxRate)
+	maxChangeRateStr := viper.GetString(FlagCommissionMaxChangeRate)
+	if rateStr != "" || maxRateStr != "" || maxChangeRateStr != "" {
+		commission, err = buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr)
+		if err != nil {
+			return txBldr, nil, err
+		}
+	}
+
 	// get the initial validator min self delegation
 	minSelfDelegation := sdk.NewDecCoinFromDec(common.NativeToken, types.DefaultMinSelfDelegation)
 
@@ -231,6 +254,7 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 		sdk.ValAddress(valAddr),
 		pk,
 		description,
+		commission,
 		minSelfDelegation,
 	)
 
@@ -244,3 +268,26 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 
 	return txBldr, msg, nil
 }
+
+func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionRates, err error) {
+	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
+		return commission, types.ErrCommissionRatesIncomplete()
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	return types.NewCommissionRates(rate, maxRate, maxChangeRate), nil
+}
@@ -144,6 +144,41 @@ func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdRedelegate gets command for redelegating shares from a validator to others
+func GetCmdRedelegate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr1, dst-validator-addr2, ... dst-validator-addrN] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "redelegate shares from a validator to one or more other validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf("Redelegate shares from a validator to one or more other validators immediately. The source "+
+				"validator of the deposited %s is tracked until the unbonding time passes."+
+				"\n\nExample:\n$ %s tx staking redelegate exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg "+
+				"exvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5,"+
+				"exvaloper10q0rk5qnyag7wfvvt7rtphlw589m7frshchly8 --from mykey\n",
+				sdk.DefaultBondDenom, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			valDstAddrs, err := getValsSet(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedelegate(delAddr, valSrcAddr, valDstAddrs)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdProxy gets subcommands for proxy voting
 func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 
@@ -26,6 +26,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// query delegator's redelegations in progress
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegations",
+		delegatorRedelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// query the proxy relationship on a proxy delegator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/proxy",
@@ -92,6 +98,11 @@ func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.Hand
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingDelegation))
 }
 
+// HTTP request handler to query the redelegations in progress of a delegator
+func delegatorRedelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegations))
+}
+
 // HTTP request handler to query the info of a delegator
 func delegatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegator))
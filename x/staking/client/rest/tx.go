@@ -22,6 +22,10 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegations",
+		postRedelegationsHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -40,6 +44,14 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.SysCoin    `json:"amount" yaml:"amount"`
 	}
+
+	// RedelegateRequest defines the properties of a redelegate request's body.
+	RedelegateRequest struct {
+		BaseReq               rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		DelegatorAddress      sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`             // in bech32
+		ValidatorSrcAddress   sdk.ValAddress   `json:"validator_src_address" yaml:"validator_src_address"`     // in bech32
+		ValidatorDstAddresses []sdk.ValAddress `json:"validator_dst_addresses" yaml:"validator_dst_addresses"` // in bech32
+	}
 )
 
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -109,3 +121,37 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postRedelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedelegateRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedelegate(req.DelegatorAddress, req.ValidatorSrcAddress, req.ValidatorDstAddresses)
+		if err := msg.ValidateBasic(); err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeInvalidParam, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			common.HandleErrorMsg(w, cliCtx, types.CodeAddressNotEqual, "must use own delegator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
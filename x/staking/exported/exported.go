@@ -9,6 +9,9 @@ import (
 type DelegatorI interface {
 	GetShareAddedValidatorAddresses() []sdk.ValAddress
 	GetLastAddedShares() sdk.Dec
+	GetProxyAddress() sdk.AccAddress
+	GetTokens() sdk.Dec
+	GetTotalTokens() sdk.Dec
 }
 
 // ValidatorI expected validator functions
@@ -39,6 +39,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, ubd := range data.UnbondingDelegations {
 		initUnbondingDelegation(ctx, ubd, keeper, &notBondedTokens)
 	}
+	for _, red := range data.Redelegations {
+		initRedelegation(ctx, red, keeper)
+	}
 	for _, sharesExported := range data.AllShares {
 		keeper.SetShares(ctx, sharesExported.DelAddress, sharesExported.ValidatorAddress, sharesExported.Shares)
 	}
@@ -104,6 +107,13 @@ func initUnbondingDelegation(ctx sdk.Context, ubd UndelegationInfo, keeper Keepe
 	*notBondedTokens = notBondedTokens.Add(ubd.Quantity)
 }
 
+func initRedelegation(ctx sdk.Context, red Redelegation, keeper Keeper) {
+	keeper.SetRedelegation(ctx, red)
+	for _, entry := range red.Entries {
+		keeper.SetRedelegationTimeKeyWithNilValue(ctx, entry.CompletionTime, red.DelegatorAddress)
+	}
+}
+
 func initDelegator(ctx sdk.Context, delegator Delegator, keeper Keeper, pBondedTokens *sdk.Dec) {
 	keeper.SetDelegator(ctx, delegator)
 	*pBondedTokens = pBondedTokens.Add(delegator.Tokens)
@@ -146,6 +156,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		undelegationInfos = append(undelegationInfos, ubd)
 		return false
 	})
+	var redelegations []types.Redelegation
+	keeper.IterateRedelegations(ctx, func(_ int64, red types.Redelegation) (stop bool) {
+		redelegations = append(redelegations, red)
+		return false
+	})
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.NewLastValidatorPower(addr, power))
@@ -171,6 +186,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Validators:           validators.Export(),
 		Delegators:           delegators,
 		UnbondingDelegations: undelegationInfos,
+		Redelegations:        redelegations,
 		AllShares:            sharesExportedSlice,
 		ProxyDelegatorKeys:   proxyDelegatorKeys,
 		Exported:             true,
@@ -26,6 +26,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdraw(ctx, msg, k)
 		case types.MsgAddShares:
 			return handleMsgAddShares(ctx, msg, k)
+		case types.MsgRedelegate:
+			return handleMsgRedelegate(ctx, msg, k)
 		case types.MsgBindProxy:
 			return handleMsgBindProxy(ctx, msg, k)
 		case types.MsgUnbindProxy:
@@ -66,6 +68,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
 
+	// Remove all mature redelegations from the redelegation queues.
+	k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+
 	k.IterateKeysBeforeCurrentTime(ctx, ctx.BlockHeader().Time,
 		func(index int64, key []byte) (stop bool) {
 			oldTime, delAddr := types.SplitCompleteTimeWithAddrKey(key)
@@ -124,7 +129,8 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 
 	minSelfDelegation := k.ParamsMinSelfDelegation(ctx)
 	validator := NewValidator(msg.ValidatorAddress, msg.PubKey, msg.Description, minSelfDelegation)
-	commission := NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0))
+	rates := msg.GetCommission()
+	commission := types.NewCommissionWithTime(rates.Rate, rates.MaxRate, rates.MaxChangeRate, ctx.BlockTime())
 	validator, err := validator.SetInitialCommission(commission)
 	if err != nil {
 		return nil, err
@@ -157,17 +163,29 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 
 	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return nil, err
+	if msg.Description != (types.Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		validator.Description = description
 	}
 
-	validator.Description = description
+	// the new rate is limited by the max rate, the max change rate and the update interval
+	if msg.CommissionRate != nil {
+		if err := validator.Commission.ValidateNewRate(*msg.CommissionRate, ctx.BlockTime()); err != nil {
+			return nil, err
+		}
+		validator.Commission.Rate = *msg.CommissionRate
+		validator.Commission.UpdateTime = ctx.BlockTime()
+	}
 
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(types.EventTypeEditValidator,
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
 			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
 		),
 		sdk.NewEvent(sdk.EventTypeMessage,
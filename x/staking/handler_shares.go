@@ -209,6 +209,87 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgRedelegate(ctx sdk.Context, msg types.MsgRedelegate, k keeper.Keeper) (*sdk.Result, error) {
+	// 0. check whether the delegator has added shares to the source validator
+	delegator, found := k.GetDelegator(ctx, msg.DelAddr)
+	if !found || delegator.Tokens.IsZero() {
+		return types.ErrNoDelegationToAddShares(msg.DelAddr.String()).Result()
+	}
+	if delegator.HasProxy() {
+		return types.ErrAddSharesDuringProxy(delegator.DelegatorAddress.String(),
+			delegator.ProxyAddress.String()).Result()
+	}
+	if !containsValAddr(delegator.ValidatorAddresses, msg.ValSrcAddr) {
+		return nil, types.ErrNoSharesToRedelegate(msg.DelAddr.String(), msg.ValSrcAddr.String())
+	}
+	if err := k.CheckRedelegation(ctx, msg.DelAddr, msg.ValSrcAddr); err != nil {
+		return nil, err
+	}
+
+	// 1. the source validator is replaced by the destination validators in the validator set
+	valAddrs := make([]sdk.ValAddress, 0, len(delegator.ValidatorAddresses)+len(msg.ValDstAddrs))
+	for _, valAddr := range delegator.ValidatorAddresses {
+		if !valAddr.Equals(msg.ValSrcAddr) {
+			valAddrs = append(valAddrs, valAddr)
+		}
+	}
+	for _, valAddr := range msg.ValDstAddrs {
+		if !containsValAddr(valAddrs, valAddr) {
+			valAddrs = append(valAddrs, valAddr)
+		}
+	}
+	maxValsToAddShares := int(k.ParamsMaxValsToAddShares(ctx))
+	if len(valAddrs) > maxValsToAddShares {
+		return types.ErrExceedValidatorAddrs(maxValsToAddShares).Result()
+	}
+	vals, sdkErr := k.GetValidatorsToAddShares(ctx, valAddrs)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	if sdkErr = validateSharesAdding(vals); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	// 2. move the shares to the new validator set immediately
+	lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, msg.DelAddr)
+	k.WithdrawLastShares(ctx, msg.DelAddr, lastVals, lastShares)
+	totalTokens := delegator.Tokens.Add(delegator.TotalDelegatedTokens)
+	shares, sdkErr := k.AddSharesToValidators(ctx, msg.DelAddr, vals, totalTokens)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	delegator.ValidatorAddresses = getValsAddrs(vals)
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// 3. the source validator of the tokens is tracked until the completion
+	completionTime := k.InsertRedelegationQueue(ctx, msg.DelAddr, msg.ValSrcAddr, msg.ValDstAddrs, delegator.Tokens)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValSrcAddr.String()),
+	}
+	for _, valAddr := range msg.ValDstAddrs {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDstValidator, valAddr.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCompletionTime,
+		completionTime.Format(time.RFC3339)))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRedelegate, attributes...))
+
+	completionTimeBz := types.ModuleCdc.MustMarshalBinaryLengthPrefixed(completionTime)
+	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
+}
+
+// containsValAddr tells whether the validator address is in the slice
+func containsValAddr(valAddrs []sdk.ValAddress, valAddr sdk.ValAddress) bool {
+	for _, addr := range valAddrs {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
 // validateSharesAdding gives a quick validity of target validators before shares adding
 func validateSharesAdding(vals types.Validators) error {
 	if len(vals) == 0 {
@@ -2,8 +2,10 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -82,3 +84,77 @@ func TestHandlerBadMessage(t *testing.T) {
 	r, err := handler(ctx, msg)
 	require.NotNil(t, err, r)
 }
+
+func TestHandlerRedelegate(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	params := setInstantUnbondPeriod(keeper, ctx)
+	params.UnbondingTime = time.Hour
+	keeper.SetParams(ctx, params)
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+	handler := NewHandler(keeper)
+
+	// 1. create three validators, and the delegator adds shares to the first two of them
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(Addrs[0]), sdk.ValAddress(Addrs[1]), sdk.ValAddress(Addrs[2])}
+	for i, valAddr := range valAddrs {
+		_, err := handler(ctx, NewTestMsgCreateValidator(valAddr, PKs[i], DefaultMSD))
+		require.Nil(t, err)
+	}
+	delAddr := Addrs[3]
+	_, err := handler(ctx, NewMsgDeposit(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000))))
+	require.Nil(t, err)
+	_, err = handler(ctx, NewMsgAddShares(delAddr, valAddrs[:2]))
+	require.Nil(t, err)
+	shares, found := keeper.GetShares(ctx, delAddr, valAddrs[0])
+	require.True(t, found)
+
+	// 2. redelegate from the validator without the delegator's shares or to itself
+	_, err = handler(ctx, NewMsgRedelegate(delAddr, valAddrs[2], valAddrs[:1]))
+	require.NotNil(t, err)
+	require.NotNil(t, NewMsgRedelegate(delAddr, valAddrs[0], valAddrs[:1]).ValidateBasic())
+
+	// 3. the shares are moved from the source validator to the destination one immediately
+	_, err = handler(ctx, NewMsgRedelegate(delAddr, valAddrs[0], valAddrs[2:]))
+	require.Nil(t, err)
+	_, found = keeper.GetShares(ctx, delAddr, valAddrs[0])
+	require.False(t, found)
+	dstShares, found := keeper.GetShares(ctx, delAddr, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, shares, dstShares)
+	delegator, _ := keeper.GetDelegator(ctx, delAddr)
+	require.Equal(t, []sdk.ValAddress{valAddrs[1], valAddrs[2]}, delegator.ValidatorAddresses)
+
+	// the redelegation is queryable, and the shares redelegated can't be redelegated again before the completion
+	querier := NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryRedelegations},
+		abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryDelegatorParams(delAddr))})
+	require.Nil(t, err)
+	var red types.Redelegation
+	types.ModuleCdc.MustUnmarshalJSON(bz, &red)
+	require.Equal(t, 1, len(red.Entries))
+	require.Equal(t, valAddrs[0], red.Entries[0].ValidatorSrcAddress)
+	require.Equal(t, sdk.NewDec(1000), red.Entries[0].Tokens)
+	_, err = handler(ctx, NewMsgRedelegate(delAddr, valAddrs[2], valAddrs[:1]))
+	require.NotNil(t, err)
+
+	// 4. the source validator is only tracked, and slashing it doesn't burn the tokens redelegated away
+	validator, _ := keeper.GetValidator(ctx, valAddrs[0])
+	keeper.Slash(ctx, validator.GetConsAddr(), 9, 0, sdk.NewDecWithPrec(1, 1))
+	delegator, _ = keeper.GetDelegator(ctx, delAddr)
+	require.Equal(t, sdk.NewDec(1000), delegator.Tokens)
+	red, _ = keeper.GetRedelegation(ctx, delAddr)
+	require.Equal(t, sdk.NewDec(1000), red.Entries[0].Tokens)
+
+	// 5. the redelegation is removed from the queue after completion
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetRedelegation(ctx, delAddr)
+	require.False(t, found)
+	_, err = handler(ctx, NewMsgRedelegate(delAddr, valAddrs[2], valAddrs[:1]))
+	require.Nil(t, err)
+
+	// 6. the redelegations in progress are exported and imported with the genesis
+	genesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, 1, len(genesis.Redelegations))
+}
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -175,7 +176,7 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 		SharesFromDefaultMSD, false)
 
 	// edit validator
-	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{Moniker: "moniker"})
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{Moniker: "moniker"}, nil)
 	require.Nil(t, msgEditValidator.ValidateBasic())
 
 	// no one could change msd
@@ -184,3 +185,51 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	SimpleCheckValidator(t, ctx, keeper, validatorAddr, DefaultMSD, sdk.Bonded,
 		SharesFromDefaultMSD, false)
 }
+
+func TestMsgEditValidatorCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mKeeper.Keeper
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now)
+	handler := NewHandler(keeper)
+
+	// create validator with the declared commission rates
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], DefaultMSD)
+	msgCreateValidator.Commission = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1))
+	got, err := handler(ctx, msgCreateValidator)
+	require.Nil(t, err, "expected create-validator to be ok, got %v", got)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, msgCreateValidator.Commission, validator.Commission.CommissionRates)
+	require.Equal(t, now.Unix(), validator.Commission.UpdateTime.Unix())
+
+	// the rate can't be changed within 24 hours since the creation
+	rate := sdk.NewDecWithPrec(5, 2)
+	_, err = handler(ctx, NewMsgEditValidator(validatorAddr, Description{}, &rate))
+	require.NotNil(t, err)
+
+	// the rate can't be increased more than the max change rate
+	ctx = ctx.WithBlockTime(now.Add(25 * time.Hour))
+	rate = sdk.NewDecWithPrec(25, 2)
+	_, err = handler(ctx, NewMsgEditValidator(validatorAddr, Description{}, &rate))
+	require.NotNil(t, err)
+	rate = sdk.NewDecWithPrec(2, 1)
+	_, err = handler(ctx, NewMsgEditValidator(validatorAddr, Description{}, &rate))
+	require.Nil(t, err)
+
+	// the rate can't be more than the max rate
+	ctx = ctx.WithBlockTime(now.Add(50 * time.Hour))
+	rate = sdk.NewDecWithPrec(31, 2)
+	_, err = handler(ctx, NewMsgEditValidator(validatorAddr, Description{}, &rate))
+	require.NotNil(t, err)
+
+	// the rate could be decreased to any one
+	rate = sdk.ZeroDec()
+	_, err = handler(ctx, NewMsgEditValidator(validatorAddr, Description{}, &rate))
+	require.Nil(t, err)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Commission.Rate.IsZero())
+	require.Equal(t, now.Add(50*time.Hour).Unix(), validator.Commission.UpdateTime.Unix())
+}
@@ -75,6 +75,7 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		}
 
 		// 1.delete related store
+		k.BeforeDelegationSharesModified(ctx, delAddr, vals[i].OperatorAddress)
 		k.DeleteValidatorByPowerIndex(ctx, vals[i])
 
 		// 2.update shares
@@ -84,6 +85,7 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares).Add(shares)
 		k.SetValidator(ctx, vals[i])
 		k.SetValidatorByPowerIndex(ctx, vals[i])
+		k.AfterDelegationModified(ctx, delAddr, vals[i].OperatorAddress)
 	}
 
 	// update the delegator struct
@@ -118,6 +120,7 @@ func (k Keeper) WithdrawLastShares(ctx sdk.Context, delAddr sdk.AccAddress, last
 
 func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.delete shares entity
+	k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
 	k.DeleteShares(ctx, val.OperatorAddress, delAddr)
 
 	// 2.update validator entity
@@ -138,6 +141,11 @@ func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val type
 
 func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.update shares entity
+	if _, found := k.GetShares(ctx, delAddr, val.OperatorAddress); found {
+		k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
+	} else {
+		k.BeforeDelegationCreated(ctx, delAddr, val.OperatorAddress)
+	}
 	k.SetShares(ctx, delAddr, val.OperatorAddress, shares)
 
 	// 2.update validator entity
@@ -145,6 +153,7 @@ func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Val
 	val.DelegatorShares = val.GetDelegatorShares().Add(shares)
 	k.SetValidator(ctx, val)
 	k.SetValidatorByPowerIndex(ctx, val)
+	k.AfterDelegationModified(ctx, delAddr, val.OperatorAddress)
 }
 
 // GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time
@@ -39,6 +39,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxy(ctx, req, k)
 		case types.QueryDelegator:
 			return queryDelegator(ctx, req, k)
+		case types.QueryRedelegations:
+			return queryRedelegations(ctx, req, k)
 		default:
 			return nil, types.ErrUnknownStakingQueryType()
 		}
@@ -205,6 +207,26 @@ func queryUndelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 	return res, nil
 }
 
+func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	redelegation, found := k.GetRedelegation(ctx, params.DelegatorAddr)
+	if !found {
+		return nil, types.ErrNoRedelegation(params.DelegatorAddr.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, redelegation)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return res, nil
+}
+
 func queryAddress(ctx sdk.Context, k Keeper) (res []byte, err error) {
 
 	ovPairs := k.GetOperAndValidatorAddr(ctx)
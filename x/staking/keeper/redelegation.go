@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
+)
+
+// GetRedelegation gets the redelegation queue of a delegator from store
+func (k Keeper) GetRedelegation(ctx sdk.Context, delAddr sdk.AccAddress) (red types.Redelegation, found bool) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetRedelegationKey(delAddr))
+	if bytes == nil {
+		return red, false
+	}
+
+	red = types.MustUnMarshalRedelegation(k.cdc, bytes)
+	return red, true
+}
+
+// SetRedelegation sets the redelegation queue of a delegator to store, or deletes it if it's empty
+func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
+	key := types.GetRedelegationKey(red.DelegatorAddress)
+	if len(red.Entries) == 0 {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	bytes := k.cdc.MustMarshalBinaryLengthPrefixed(red)
+	ctx.KVStore(k.storeKey).Set(key, bytes)
+}
+
+// IterateRedelegations iterates through all of the redelegation queues of delegators
+func (k Keeper) IterateRedelegations(ctx sdk.Context, fn func(index int64, red types.Redelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RedelegationKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		red := types.MustUnMarshalRedelegation(k.cdc, iterator.Value())
+		if stop := fn(i, red); stop {
+			break
+		}
+		i++
+	}
+}
+
+// SetRedelegationTimeKeyWithNilValue sets the time+delAddr key of the redelegation queue into store with an empty value
+func (k Keeper) SetRedelegationTimeKeyWithNilValue(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetRedelegationTimeWithAddrKey(timestamp, delAddr), []byte{})
+}
+
+// CheckRedelegation checks whether the delegator is able to redelegate from the source validator
+func (k Keeper) CheckRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress) error {
+	red, found := k.GetRedelegation(ctx, delAddr)
+	if !found {
+		return nil
+	}
+
+	// the shares redelegated can't be redelegated again until the redelegation completes, so that every redelegation in
+	// progress tracks the validator the tokens come from
+	if red.IsRedelegatingTo(valSrcAddr, ctx.BlockTime()) {
+		return types.ErrTransitiveRedelegation(valSrcAddr.String())
+	}
+
+	if len(red.Entries) >= types.MaxRedelegationEntries {
+		return types.ErrMaxRedelegationEntries(types.MaxRedelegationEntries)
+	}
+
+	return nil
+}
+
+// InsertRedelegationQueue appends a redelegation in progress to the queue of the delegator and returns the completion
+// time, until when the source validator of the tokens is tracked
+func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress,
+	valDstAddrs []sdk.ValAddress, tokens sdk.Dec) time.Time {
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	red, found := k.GetRedelegation(ctx, delAddr)
+	if !found {
+		red = types.NewRedelegation(delAddr)
+	}
+
+	red.AddEntry(types.NewRedelegationEntry(valSrcAddr, valDstAddrs, ctx.BlockHeight(), completionTime, tokens))
+	k.SetRedelegation(ctx, red)
+	k.SetRedelegationTimeKeyWithNilValue(ctx, completionTime, delAddr)
+	return completionTime
+}
+
+// DequeueAllMatureRedelegationQueue removes all the completed redelegations from the queues of delegators
+func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currentTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RedelegateQueueKey, sdk.PrefixEndBytes(types.GetRedelegationTimeKey(currentTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, delAddr := types.SplitRedelegationTimeWithAddrKey(iterator.Key())
+		store.Delete(iterator.Key())
+
+		red, found := k.GetRedelegation(ctx, delAddr)
+		if !found {
+			continue
+		}
+		for _, entry := range red.RemoveMatureEntries(currentTime) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompleteRedelegation,
+					sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeySrcValidator, entry.ValidatorSrcAddress.String()),
+				),
+			)
+		}
+		k.SetRedelegation(ctx, red)
+	}
+}
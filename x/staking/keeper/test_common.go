@@ -281,7 +281,7 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, msd
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, msdAmt)
 
 	return types.NewMsgCreateValidator(address, pubKey,
-		types.NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		types.NewDescription("my moniker", "my identity", "my website", "my details"), types.DefaultCommissionRates(), msd,
 	)
 }
 
@@ -316,3 +316,9 @@ func (dk mockDistributionKeeper) AfterValidatorBeginUnbonding(ctx sdk.Context, c
 }
 func (dk mockDistributionKeeper) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 }
+func (dk mockDistributionKeeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+func (dk mockDistributionKeeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+func (dk mockDistributionKeeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
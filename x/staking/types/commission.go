@@ -36,6 +36,17 @@ func NewCommissionRates(rate, maxRate, maxChangeRate sdk.Dec) CommissionRates {
 	}
 }
 
+// DefaultCommissionRates returns the commission rates of the validators which don't declare them, with which all the
+// rewards are taken by the validator as commission
+func DefaultCommissionRates() CommissionRates {
+	return NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())
+}
+
+// IsEmpty returns true if none of the commission rates is declared
+func (c CommissionRates) IsEmpty() bool {
+	return c.Rate.IsNil() && c.MaxRate.IsNil() && c.MaxChangeRate.IsNil()
+}
+
 // NewCommission returns an initialized validator commission.
 func NewCommission(rate, maxRate, maxChangeRate sdk.Dec) Commission {
 	return Commission{
@@ -126,6 +137,10 @@ func (c *Commission) UnmarshalFromAmino(data []byte) error {
 // If validation fails, an SDK error is returned
 func (c CommissionRates) Validate() sdk.Error {
 	switch {
+	case c.Rate.IsNil() || c.MaxRate.IsNil() || c.MaxChangeRate.IsNil():
+		// all the rates must be declared
+		return ErrCommissionRatesIncomplete()
+
 	case c.MaxRate.LT(sdk.ZeroDec()):
 		// max rate cannot be negative
 		return ErrCommissionNegative()
@@ -42,6 +42,25 @@ func (d Delegator) GetLastAddedShares() sdk.Dec {
 	return d.Shares
 }
 
+// GetProxyAddress gets the address of the proxy that the delegator has bound for other module
+func (d Delegator) GetProxyAddress() sdk.AccAddress {
+	return d.ProxyAddress
+}
+
+// GetTokens gets the self-delegated tokens of a delegator for other module
+func (d Delegator) GetTokens() sdk.Dec {
+	return d.Tokens
+}
+
+// GetTotalTokens gets the tokens that the shares of a delegator are calculated from for other module, including the
+// tokens delegated to it as a proxy
+func (d Delegator) GetTotalTokens() sdk.Dec {
+	if d.IsProxy {
+		return d.Tokens.Add(d.TotalDelegatedTokens)
+	}
+	return d.Tokens
+}
+
 // RegProxy registers or deregisters the identity of proxy
 func (d *Delegator) RegProxy(reg bool) {
 	d.IsProxy = reg
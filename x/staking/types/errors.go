@@ -58,6 +58,12 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeCommissionRatesIncomplete       uint32 = 67047
+	CodeSelfRedelegation                uint32 = 67048
+	CodeNoSharesToRedelegate            uint32 = 67049
+	CodeTransitiveRedelegation          uint32 = 67050
+	CodeMaxRedelegationEntries          uint32 = 67051
+	CodeNoRedelegation                  uint32 = 67052
 )
 
 // ErrNoValidatorFound returns an error when a validator doesn't exist
@@ -312,3 +318,40 @@ func ErrAlreadyBound(delAddr string) sdk.EnvelopedErr {
 		fmt.Sprintf("failed. %s has already bound a proxy. it's necessary to unbind before proxy register",
 			delAddr))}
 }
+
+// ErrCommissionRatesIncomplete returns an error when only a part of the commission rates is declared
+func ErrCommissionRatesIncomplete() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeCommissionRatesIncomplete,
+		"failed. the commission rate, max rate and max change rate must be declared together")
+}
+
+// ErrSelfRedelegation returns an error when the source validator is among the destination ones of a redelegation
+func ErrSelfRedelegation(valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeSelfRedelegation,
+		fmt.Sprintf("failed. validator %s can't be both the source and destination of a redelegation", valAddr))
+}
+
+// ErrNoSharesToRedelegate returns an error when a delegator redelegates from a validator without its shares
+func ErrNoSharesToRedelegate(delAddr, valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoSharesToRedelegate,
+		fmt.Sprintf("failed. delegator %s hasn't added shares to validator %s", delAddr, valAddr))
+}
+
+// ErrTransitiveRedelegation returns an error when a delegator redelegates from a validator which the shares were
+// redelegated to and the redelegation hasn't completed
+func ErrTransitiveRedelegation(valAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeTransitiveRedelegation,
+		fmt.Sprintf("failed. redelegation to validator %s is in progress, it can't be redelegated from", valAddr))
+}
+
+// ErrMaxRedelegationEntries returns an error when a delegator has too many redelegations in progress
+func ErrMaxRedelegationEntries(maxEntries int) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeMaxRedelegationEntries,
+		fmt.Sprintf("failed. too many redelegations in progress, the max is %d", maxEntries))
+}
+
+// ErrNoRedelegation returns an error when there's no redelegation in progress of a delegator
+func ErrNoRedelegation(delAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoRedelegation,
+		fmt.Sprintf("failed. delegator %s has no redelegation in progress", delAddr))
+}
@@ -8,6 +8,11 @@ const (
 	EventTypeDelegate          = "delegate"
 	EventTypeUnbond            = "unbond"
 
+	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteRedelegation = "complete_redelegation"
+	AttributeKeySrcValidator      = "source_validator"
+	AttributeKeyDstValidator      = "destination_validator"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
@@ -15,6 +15,7 @@ type GenesisState struct {
 	Validators           []ValidatorExported         `json:"validators" yaml:"validators"`
 	Delegators           []Delegator                 `json:"delegators" yaml:"delegators"`
 	UnbondingDelegations []UndelegationInfo          `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations        []Redelegation              `json:"redelegations" yaml:"redelegations"`
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
 	Exported             bool                        `json:"exported" yaml:"exported"`
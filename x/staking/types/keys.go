@@ -48,6 +48,8 @@ var (
 	UnDelegationInfoKey = []byte{0x53}
 	UnDelegateQueueKey  = []byte{0x54}
 	ProxyKey            = []byte{0x55}
+	RedelegationKey     = []byte{0x56}
+	RedelegateQueueKey  = []byte{0x57}
 
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
@@ -165,6 +167,28 @@ func SplitCompleteTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress) {
 	return endTime, delAddr
 }
 
+// GetRedelegationKey gets the key for the redelegation queue of a delegator
+func GetRedelegationKey(delAddr sdk.AccAddress) []byte {
+	return append(RedelegationKey, delAddr.Bytes()...)
+}
+
+// GetRedelegationTimeKey gets the key for the prefix of the redelegation completion time
+func GetRedelegationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RedelegateQueueKey, bz...)
+}
+
+// GetRedelegationTimeWithAddrKey gets the key for the redelegation completion time with delegator address
+func GetRedelegationTimeWithAddrKey(timestamp time.Time, delAddr sdk.AccAddress) []byte {
+	return append(GetRedelegationTimeKey(timestamp), delAddr.Bytes()...)
+}
+
+// SplitRedelegationTimeWithAddrKey splits the key of the redelegation queue and returns the completion time and
+// delegator address
+func SplitRedelegationTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress) {
+	return SplitCompleteTimeWithAddrKey(key)
+}
+
 // Bech32ifyConsPub returns a Bech32 encoded string containing the
 // Bech32PrefixConsPub prefixfor a given consensus node's PubKey.
 func Bech32ifyConsPub(pub crypto.PubKey) (string, error) {
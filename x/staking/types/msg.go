@@ -18,32 +18,35 @@ var (
 
 // MsgCreateValidator - struct for bonding transactions
 type MsgCreateValidator struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
+	Description       Description    `json:"description" yaml:"description"`
 	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
 	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
 	PubKey            crypto.PubKey  `json:"pubkey" yaml:"pubkey"`
+	// appended as the last field to keep the encoding of the msgs created before
+	Commission CommissionRates `json:"commission,omitempty" yaml:"commission,omitempty"`
 }
 
 type msgCreateValidatorJSON struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
+	Description       Description    `json:"description" yaml:"description"`
 	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
 	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
 	PubKey            string         `json:"pubkey" yaml:"pubkey"`
+	// nil if the commission rates are not declared, so that the sign bytes of the msgs created before are kept
+	Commission *CommissionRates `json:"commission,omitempty" yaml:"commission,omitempty"`
 }
 
 // NewMsgCreateValidator creates a msg of create-validator
 // Delegator address and validator address are the same
 func NewMsgCreateValidator(
 	valAddr sdk.ValAddress, pubKey crypto.PubKey,
-	description Description, minSelfDelegation sdk.SysCoin,
+	description Description, commission CommissionRates, minSelfDelegation sdk.SysCoin,
 ) MsgCreateValidator {
 
 	return MsgCreateValidator{
 		Description:       description,
+		Commission:        commission,
 		DelegatorAddress:  sdk.AccAddress(valAddr),
 		ValidatorAddress:  valAddr,
 		PubKey:            pubKey,
@@ -71,13 +74,18 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 
 // MarshalJSON implements the json.Marshaler interface to provide custom JSON serialization
 func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
-	return json.Marshal(msgCreateValidatorJSON{
+	msgJSON := msgCreateValidatorJSON{
 		Description:       msg.Description,
 		DelegatorAddress:  msg.DelegatorAddress,
 		ValidatorAddress:  msg.ValidatorAddress,
 		PubKey:            MustBech32ifyConsPub(msg.PubKey),
 		MinSelfDelegation: msg.MinSelfDelegation,
-	})
+	}
+	if !msg.Commission.IsEmpty() {
+		commission := msg.Commission
+		msgJSON.Commission = &commission
+	}
+	return json.Marshal(msgJSON)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface to provide custom JSON deserialization
@@ -88,6 +96,9 @@ func (msg *MsgCreateValidator) UnmarshalJSON(bz []byte) error {
 	}
 
 	msg.Description = msgCreateValJSON.Description
+	if msgCreateValJSON.Commission != nil {
+		msg.Commission = *msgCreateValJSON.Commission
+	}
 	msg.DelegatorAddress = msgCreateValJSON.DelegatorAddress
 	msg.ValidatorAddress = msgCreateValJSON.ValidatorAddress
 	var err error
@@ -124,21 +135,40 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 	if msg.Description == (Description{}) {
 		return ErrDescriptionIsEmpty()
 	}
+	// the commission rates are optional for the compatibility with the msgs created before, the default rates are
+	// applied if they're not declared
+	if !msg.Commission.IsEmpty() {
+		if err := msg.Commission.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// GetCommission returns the commission rates declared in the msg, or the default ones if not declared
+func (msg MsgCreateValidator) GetCommission() CommissionRates {
+	if msg.Commission.IsEmpty() {
+		return DefaultCommissionRates()
+	}
+	return msg.Commission
+}
+
 // MsgEditValidator - struct for editing a validator
 type MsgEditValidator struct {
 	Description
 	ValidatorAddress sdk.ValAddress `json:"address" yaml:"address"`
+
+	// the commission rate is not modified if it's nil
+	CommissionRate *sdk.Dec `json:"commission_rate,omitempty" yaml:"commission_rate,omitempty"`
 }
 
 // NewMsgEditValidator creates a msg of edit-validator
-func NewMsgEditValidator(valAddr sdk.ValAddress, description Description) MsgEditValidator {
+func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec) MsgEditValidator {
 	return MsgEditValidator{
 		Description:      description,
 		ValidatorAddress: valAddr,
+		CommissionRate:   newRate,
 	}
 }
 
@@ -161,9 +191,18 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		return ErrNilValidatorAddr()
 	}
 
-	if msg.Description == (Description{}) {
+	if msg.Description == (Description{}) && msg.CommissionRate == nil {
 		return ErrNilValidatorAddr()
 	}
 
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.IsNil() || msg.CommissionRate.IsNegative() {
+			return ErrCommissionNegative()
+		}
+		if msg.CommissionRate.GT(sdk.OneDec()) {
+			return ErrCommissionHuge()
+		}
+	}
+
 	return nil
 }
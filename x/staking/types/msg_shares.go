@@ -7,6 +7,7 @@ import (
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = (*MsgAddShares)(nil)
+	_ sdk.Msg = (*MsgRedelegate)(nil)
 	_ sdk.Msg = (*MsgDestroyValidator)(nil)
 )
 
@@ -197,6 +198,58 @@ func (msg MsgAddShares) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgRedelegate - struct for redelegating the shares from a validator to others
+type MsgRedelegate struct {
+	DelAddr     sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	ValSrcAddr  sdk.ValAddress   `json:"validator_src_address" yaml:"validator_src_address"`
+	ValDstAddrs []sdk.ValAddress `json:"validator_dst_addresses" yaml:"validator_dst_addresses"`
+}
+
+// NewMsgRedelegate creates a msg of redelegating shares from a validator to others
+func NewMsgRedelegate(delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress, valDstAddrs []sdk.ValAddress) MsgRedelegate {
+	return MsgRedelegate{
+		DelAddr:     delAddr,
+		ValSrcAddr:  valSrcAddr,
+		ValDstAddrs: valDstAddrs,
+	}
+}
+
+// nolint
+func (MsgRedelegate) Route() string { return RouterKey }
+func (MsgRedelegate) Type() string  { return "redelegate" }
+func (msg MsgRedelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelAddr}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgRedelegate) ValidateBasic() error {
+	if msg.DelAddr.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+
+	if msg.ValSrcAddr.Empty() || len(msg.ValDstAddrs) == 0 {
+		return ErrBadValidatorAddr()
+	}
+
+	if isValsDuplicate(msg.ValDstAddrs) {
+		return ErrTargetValsDuplicate()
+	}
+
+	for _, valDstAddr := range msg.ValDstAddrs {
+		if valDstAddr.Equals(msg.ValSrcAddr) {
+			return ErrSelfRedelegation(msg.ValSrcAddr.String())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgRedelegate) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
 func isValsDuplicate(valAddrs []sdk.ValAddress) bool {
 	lenAddrs := len(valAddrs)
 	filter := make(map[string]struct{}, lenAddrs)
@@ -81,12 +81,53 @@ func TestMsgDestroyValidator(t *testing.T) {
 	}
 }
 
+func TestMsgCreateValidatorCommission(t *testing.T) {
+	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2000))
+	description := NewDescription("my moniker", "my identity", "my website", "my details")
+	tests := []struct {
+		name       string
+		commission CommissionRates
+		expectPass bool
+	}{
+		{"basic good", NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)), true},
+		{"not declared", CommissionRates{}, true},
+		{"incomplete", CommissionRates{Rate: sdk.NewDecWithPrec(1, 1)}, false},
+		{"rate more than max rate", NewCommissionRates(sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)), false},
+		{"max rate more than 100%", NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(1, 2)), false},
+		{"max change rate more than max rate", NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1)), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCreateValidator(valAddr1, pk1, description, tc.commission, msd)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	// the default rates are applied if the commission isn't declared
+	msg := NewMsgCreateValidator(valAddr1, pk1, description, CommissionRates{}, msd)
+	require.Equal(t, DefaultCommissionRates(), msg.GetCommission())
+
+	// the sign bytes of the msgs without the commission are kept as before
+	require.NotContains(t, string(msg.GetSignBytes()), "commission")
+	var newMsg MsgCreateValidator
+	require.Nil(t, ModuleCdc.UnmarshalJSON(msg.GetSignBytes(), &newMsg))
+	require.True(t, newMsg.Commission.IsEmpty())
+
+	msg = NewMsgCreateValidator(valAddr1, pk1, description, DefaultCommissionRates(), msd)
+	require.Contains(t, string(msg.GetSignBytes()), "commission")
+	require.Nil(t, ModuleCdc.UnmarshalJSON(msg.GetSignBytes(), &newMsg))
+	require.True(t, DefaultCommissionRates().Rate.Equal(newMsg.Commission.Rate))
+}
+
 func TestMsgCreateValidator_Smoke(t *testing.T) {
 
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2000))
 
 	msg := NewMsgCreateValidator(valAddr1, pk1,
-		NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		NewDescription("my moniker", "my identity", "my website", "my details"), DefaultCommissionRates(), msd,
 	)
 	require.Contains(t, msg.Route(), RouterKey)
 	require.Contains(t, msg.Type(), "create_validator")
@@ -106,20 +147,27 @@ func TestMsgCreateValidator_Smoke(t *testing.T) {
 
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
+	rate, zeroRate := sdk.NewDecWithPrec(5, 1), sdk.ZeroDec()
+	negativeRate, hugeRate := sdk.NewDecWithPrec(-1, 1), sdk.NewDecWithPrec(11, 1)
 	tests := []struct {
 		name, moniker, identity, website, details string
+		rate                                      *sdk.Dec
 		validatorAddr                             sdk.ValAddress
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", valAddr1, true},
-		{"partial description", "", "", "c", "", valAddr1, true},
-		{"empty description", "", "", "", "", valAddr1, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, false},
+		{"basic good", "a", "b", "c", "d", nil, valAddr1, true},
+		{"partial description", "", "", "c", "", nil, valAddr1, true},
+		{"empty description", "", "", "", "", nil, valAddr1, false},
+		{"empty address", "a", "b", "c", "d", nil, emptyAddr, false},
+		{"commission rate only", "", "", "", "", &rate, valAddr1, true},
+		{"zero commission rate", "a", "b", "c", "d", &zeroRate, valAddr1, true},
+		{"negative commission rate", "a", "b", "c", "d", &negativeRate, valAddr1, false},
+		{"commission rate more than 100%", "a", "b", "c", "d", &hugeRate, valAddr1, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgEditValidator(tc.validatorAddr, description)
+		msg := NewMsgEditValidator(tc.validatorAddr, description, tc.rate)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 			checkMsg(t, msg, "edit_validator")
@@ -136,7 +184,6 @@ func checkMsg(t *testing.T, msg sdk.Msg, expType string) {
 	require.True(t, len(msg.GetSignBytes()) > 0, msg)
 }
 
-// test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 
 	coinPos := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000))
@@ -343,3 +390,30 @@ func TestMsgAddShares(t *testing.T) {
 //		}
 //	}
 //}
+
+func TestMsgRedelegate(t *testing.T) {
+	tests := []struct {
+		name        string
+		dlgAddr     sdk.AccAddress
+		valSrcAddr  sdk.ValAddress
+		valDstAddrs []sdk.ValAddress
+		expectPass  bool
+	}{
+		{"basic good", dlgAddr1, valAddr1, []sdk.ValAddress{valAddr2}, true},
+		{"empty delegator", emptyAddr.Bytes(), valAddr1, []sdk.ValAddress{valAddr2}, false},
+		{"empty source", dlgAddr1, emptyAddr, []sdk.ValAddress{valAddr2}, false},
+		{"empty destination", dlgAddr1, valAddr1, nil, false},
+		{"duplicate destination", dlgAddr1, valAddr1, []sdk.ValAddress{valAddr2, valAddr2}, false},
+		{"redelegate to self", dlgAddr1, valAddr1, []sdk.ValAddress{valAddr2, valAddr1}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedelegate(tc.dlgAddr, tc.valSrcAddr, tc.valDstAddrs)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "redelegate")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxRedelegationEntries is the max number of redelegations in progress of a delegator
+const MaxRedelegationEntries = 7
+
+// RedelegationEntry is the struct of a redelegation in progress. The shares are moved to the destination validators
+// immediately, while the source validator of the tokens is tracked until the completion
+type RedelegationEntry struct {
+	ValidatorSrcAddress   sdk.ValAddress   `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddresses []sdk.ValAddress `json:"validator_dst_addresses" yaml:"validator_dst_addresses"`
+	CreationHeight        int64            `json:"creation_height" yaml:"creation_height"`
+	CompletionTime        time.Time        `json:"completion_time" yaml:"completion_time"`
+	Tokens                sdk.Dec          `json:"tokens" yaml:"tokens"`
+}
+
+// NewRedelegationEntry creates a new instance of RedelegationEntry
+func NewRedelegationEntry(valSrcAddr sdk.ValAddress, valDstAddrs []sdk.ValAddress, creationHeight int64,
+	completionTime time.Time, tokens sdk.Dec) RedelegationEntry {
+	return RedelegationEntry{
+		ValidatorSrcAddress:   valSrcAddr,
+		ValidatorDstAddresses: valDstAddrs,
+		CreationHeight:        creationHeight,
+		CompletionTime:        completionTime,
+		Tokens:                tokens,
+	}
+}
+
+// IsMature tells whether the redelegation has completed
+func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// HasDestination tells whether the validator is among the destination validators of the redelegation
+func (e RedelegationEntry) HasDestination(valAddr sdk.ValAddress) bool {
+	for _, dstAddr := range e.ValidatorDstAddresses {
+		if dstAddr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redelegation is the queue of the redelegations in progress of a delegator, which is sorted by the completion time
+type Redelegation struct {
+	DelegatorAddress sdk.AccAddress      `json:"delegator_address" yaml:"delegator_address"`
+	Entries          []RedelegationEntry `json:"entries" yaml:"entries"`
+}
+
+// NewRedelegation creates a new instance of Redelegation
+func NewRedelegation(delAddr sdk.AccAddress) Redelegation {
+	return Redelegation{
+		DelegatorAddress: delAddr,
+	}
+}
+
+// AddEntry appends an entry to the redelegation queue
+func (red *Redelegation) AddEntry(entry RedelegationEntry) {
+	red.Entries = append(red.Entries, entry)
+}
+
+// RemoveMatureEntries removes the completed entries from the redelegation queue and returns them
+func (red *Redelegation) RemoveMatureEntries(currentTime time.Time) (matured []RedelegationEntry) {
+	var entries []RedelegationEntry
+	for _, entry := range red.Entries {
+		if entry.IsMature(currentTime) {
+			matured = append(matured, entry)
+		} else {
+			entries = append(entries, entry)
+		}
+	}
+	red.Entries = entries
+	return
+}
+
+// IsRedelegatingTo tells whether there's a redelegation in progress to the validator
+func (red Redelegation) IsRedelegatingTo(valAddr sdk.ValAddress, currentTime time.Time) bool {
+	for _, entry := range red.Entries {
+		if !entry.IsMature(currentTime) && entry.HasDestination(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a human readable string representation of Redelegation
+func (red Redelegation) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Redelegations of %s:", red.DelegatorAddress))
+	for i, entry := range red.Entries {
+		b.WriteString(fmt.Sprintf(`
+  Entry %d:
+    Source Validator:       %s
+    Destination Validators: %s
+    Creation Height:        %d
+    Completion Time:        %s
+    Tokens:                 %s`,
+			i, entry.ValidatorSrcAddress, entry.ValidatorDstAddresses, entry.CreationHeight,
+			entry.CompletionTime.Format(time.RFC3339), entry.Tokens))
+	}
+	return b.String()
+}
+
+// MustUnMarshalRedelegation must return the Redelegation object by unmarshaling
+func MustUnMarshalRedelegation(cdc *codec.Codec, value []byte) (red Redelegation) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(value, &red)
+	return
+}
@@ -369,6 +369,7 @@ func (conn *Conn) cliSubscribe(op *BaseOp) (err error) {
 	// 4. push initial data
 	initialDataMap := map[string]func(topic *SubscriptionTopic){
 		DexSpotDepthBook: conn.initialDepthBook,
+		DexSpotDepthDiff: conn.initialDepthDiff,
 	}
 	for _, topic := range topics {
 		initialDataFunc, ok := initialDataMap[topic.Channel]
@@ -395,6 +396,51 @@ func (conn *Conn) initialDepthBook(topic *SubscriptionTopic) {
 	conn.cliOutChan <- resp
 }
 
+// initialDepthDiff pushes the snapshot of the depth book with its seq, the updates whose seq are not greater than the
+// snapshot's should be dropped by the client
+func (conn *Conn) initialDepthDiff(topic *SubscriptionTopic) {
+	snapshot, ok := GetDepthSnapshotFromCache(topic.Filter)
+	conn.logger.Debug("initialDepthDiff", "snapshot", snapshot, "ok", ok)
+	if !ok {
+		return
+	}
+	resp := TableResponse{
+		Table:  topic.Channel,
+		Action: depthActionPartial,
+		Data:   []interface{}{snapshot},
+	}
+	conn.cliOutChan <- resp
+}
+
+// cliResync pushes the snapshots of the depth books again without subscribing, so that the client could rebuild the
+// books once it detects a gap in the seq or a mismatched checksum
+func (conn *Conn) cliResync(op *BaseOp) error {
+	if op == nil || op.Op != eventResync || len(op.Args) == 0 {
+		errResp := ErrorResponse{
+			Event:     "error",
+			Message:   fmt.Sprintf("invalid request, when doing: %s", eventResync),
+			ErrorCode: 30043,
+		}
+		conn.cliOutChan <- errResp
+		return nil
+	}
+
+	for _, subStr := range op.Args {
+		topic := FormSubscriptionTopic(subStr)
+		if topic.Channel != DexSpotDepthDiff {
+			errResp := ErrorResponse{
+				Event:     "error",
+				Message:   fmt.Sprintf("channel %s doesn't support %s", topic.Channel, eventResync),
+				ErrorCode: 30043,
+			}
+			conn.cliOutChan <- errResp
+			continue
+		}
+		conn.initialDepthDiff(topic)
+	}
+	return nil
+}
+
 func (conn *Conn) receiveRPCResultEvents(eventCh <-chan ctypes.ResultEvent, subscriber, channel string) {
 	conn.logger.Debug("receiveRPCResultEvents start", subscriber, channel)
 
@@ -512,6 +558,7 @@ func (conn *Conn) handleConvert() {
 		eventSubscribe:   conn.cliSubscribe,
 		eventUnsubscribe: conn.cliUnSubscribe,
 		eventLogin:       conn.cliLogin,
+		eventResync:      conn.cliResync,
 	}
 
 	for cliInMsg := range conn.cliInChan {
@@ -12,6 +12,7 @@ import (
 
 type cache struct {
 	depthBooksMap map[string]pushservice.BookRes
+	depthSeqMap   map[string]int64
 	lock          sync.RWMutex
 }
 
@@ -34,6 +35,7 @@ func InitialCache(ctx sdk.Context, orderKeeper types.OrderKeeper, dexKeeper type
 		logger.Debug("initial websocket cache", "depthbook", depthBooksMap)
 		singletonCache = &cache{
 			depthBooksMap: depthBooksMap,
+			depthSeqMap:   make(map[string]int64, len(tokenPairs)),
 		}
 	})
 }
@@ -45,8 +47,27 @@ func GetDepthBookFromCache(product string) (depthBook pushservice.BookRes, ok bo
 	return
 }
 
-func UpdateDepthBookCache(product string, bookRes pushservice.BookRes) {
+// GetDepthSnapshotFromCache returns the depth book of the product with its current seq
+func GetDepthSnapshotFromCache(product string) (snapshot DepthDiff, ok bool) {
+	singletonCache.lock.RLock()
+	defer singletonCache.lock.RUnlock()
+	depthBook, ok := singletonCache.depthBooksMap[product]
+	if !ok {
+		return
+	}
+	return NewDepthSnapshot(depthBook, singletonCache.depthSeqMap[product]), true
+}
+
+// UpdateDepthBookCache replaces the depth book of the product, and returns the diff from the cached one. The seq of
+// the product increases only if the book is changed.
+func UpdateDepthBookCache(product string, bookRes pushservice.BookRes) (diff DepthDiff, changed bool) {
 	singletonCache.lock.Lock()
 	defer singletonCache.lock.Unlock()
+	diff = NewDepthDiff(singletonCache.depthBooksMap[product], bookRes, singletonCache.depthSeqMap[product])
 	singletonCache.depthBooksMap[product] = bookRes
+	if diff.Empty() {
+		return diff, false
+	}
+	singletonCache.depthSeqMap[product] = diff.Seq
+	return diff, true
 }
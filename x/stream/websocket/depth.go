@@ -0,0 +1,117 @@
+package websocket
+
+import (
+	"hash/crc32"
+	"strings"
+
+	pushservice "github.com/okex/exchain/x/stream/pushservice/types"
+)
+
+const (
+	depthActionPartial = "partial"
+	depthActionUpdate  = "update"
+
+	// the number of the levels on each side of the book, which the checksum is calculated with
+	depthChecksumLevels = 25
+	// the quantity of a removed level in the depth diff
+	depthRemovedQuantity = "0"
+)
+
+// DepthDiff is the data pushed on the depth diff channel. The seq of a product increases by one every time its depth
+// book changes, so the client can detect a gap once the prev_seq of an update doesn't equal the seq it holds, and
+// resync the book with a snapshot.
+type DepthDiff struct {
+	Product   string     `json:"instrument_id"`
+	Action    string     `json:"action"`
+	Asks      [][]string `json:"asks"`
+	Bids      [][]string `json:"bids"`
+	PrevSeq   int64      `json:"prev_seq"`
+	Seq       int64      `json:"seq"`
+	Checksum  int32      `json:"checksum"`
+	Timestamp string     `json:"timestamp"`
+}
+
+// NewDepthSnapshot creates the full snapshot of the depth book with seq
+func NewDepthSnapshot(bookRes pushservice.BookRes, seq int64) DepthDiff {
+	return DepthDiff{
+		Product:   bookRes.Product,
+		Action:    depthActionPartial,
+		Asks:      bookRes.Asks,
+		Bids:      bookRes.Bids,
+		PrevSeq:   seq,
+		Seq:       seq,
+		Checksum:  DepthChecksum(bookRes.Asks, bookRes.Bids),
+		Timestamp: bookRes.Timestamp,
+	}
+}
+
+// NewDepthDiff creates the incremental update from the prev depth book to the current one. The levels changed are
+// carried with their new quantities, and the levels removed are carried with zero quantities.
+func NewDepthDiff(prev, cur pushservice.BookRes, prevSeq int64) DepthDiff {
+	return DepthDiff{
+		Product:   cur.Product,
+		Action:    depthActionUpdate,
+		Asks:      diffDepthLevels(prev.Asks, cur.Asks),
+		Bids:      diffDepthLevels(prev.Bids, cur.Bids),
+		PrevSeq:   prevSeq,
+		Seq:       prevSeq + 1,
+		Checksum:  DepthChecksum(cur.Asks, cur.Bids),
+		Timestamp: cur.Timestamp,
+	}
+}
+
+// Empty returns true if no level is changed in the diff
+func (diff DepthDiff) Empty() bool {
+	return len(diff.Asks) == 0 && len(diff.Bids) == 0
+}
+
+// DepthChecksum calculates the crc32 checksum of the top levels of the book, which is the signed crc32 of the string
+// "bid1_price:bid1_quantity:ask1_price:ask1_quantity:bid2_price:..."
+func DepthChecksum(asks, bids [][]string) int32 {
+	fields := make([]string, 0, depthChecksumLevels*4)
+	for i := 0; i < depthChecksumLevels; i++ {
+		if i < len(bids) {
+			fields = append(fields, bids[i][0], bids[i][1])
+		}
+		if i < len(asks) {
+			fields = append(fields, asks[i][0], asks[i][1])
+		}
+	}
+	return int32(crc32.ChecksumIEEE([]byte(strings.Join(fields, ":"))))
+}
+
+// diffDepthLevels returns the levels of cur whose quantities or order counts differ from prev, and the levels of prev
+// which don't exist in cur any more
+func diffDepthLevels(prev, cur [][]string) (diff [][]string) {
+	prevLevels := make(map[string][]string, len(prev))
+	for _, level := range prev {
+		prevLevels[level[0]] = level
+	}
+
+	diff = [][]string{}
+	for _, level := range cur {
+		prevLevel, ok := prevLevels[level[0]]
+		if !ok || !equalDepthLevel(prevLevel, level) {
+			diff = append(diff, level)
+		}
+		delete(prevLevels, level[0])
+	}
+	for _, level := range prev {
+		if _, ok := prevLevels[level[0]]; ok {
+			diff = append(diff, []string{level[0], depthRemovedQuantity, depthRemovedQuantity})
+		}
+	}
+	return diff
+}
+
+func equalDepthLevel(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
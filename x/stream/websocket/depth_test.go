@@ -0,0 +1,67 @@
+package websocket
+
+import (
+	"testing"
+
+	pushservice "github.com/okex/exchain/x/stream/pushservice/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepthDiff(t *testing.T) {
+	prev := pushservice.BookRes{
+		Asks:    [][]string{{"10.1", "5", "1"}, {"10.2", "3", "2"}},
+		Bids:    [][]string{{"9.9", "1", "1"}},
+		Product: "xxb_okt",
+	}
+	cur := pushservice.BookRes{
+		Asks:    [][]string{{"10.1", "5", "1"}, {"10.3", "4", "1"}},
+		Bids:    [][]string{{"9.9", "2", "2"}},
+		Product: "xxb_okt",
+	}
+
+	diff := NewDepthDiff(prev, cur, 7)
+	require.Equal(t, depthActionUpdate, diff.Action)
+	require.Equal(t, int64(7), diff.PrevSeq)
+	require.Equal(t, int64(8), diff.Seq)
+	require.Equal(t, [][]string{{"10.3", "4", "1"}, {"10.2", "0", "0"}}, diff.Asks)
+	require.Equal(t, [][]string{{"9.9", "2", "2"}}, diff.Bids)
+	require.Equal(t, DepthChecksum(cur.Asks, cur.Bids), diff.Checksum)
+	require.NotEqual(t, DepthChecksum(prev.Asks, prev.Bids), diff.Checksum)
+
+	require.True(t, NewDepthDiff(cur, cur, 8).Empty())
+
+	snapshot := NewDepthSnapshot(cur, 8)
+	require.Equal(t, depthActionPartial, snapshot.Action)
+	require.Equal(t, int64(8), snapshot.Seq)
+	require.Equal(t, diff.Checksum, snapshot.Checksum)
+}
+
+func TestDepthChecksum(t *testing.T) {
+	// crc32 of "9.9:1:10.1:5:9.8:2"
+	asks := [][]string{{"10.1", "5", "1"}}
+	bids := [][]string{{"9.9", "1", "1"}, {"9.8", "2", "1"}}
+	require.Equal(t, int32(-1608823056), DepthChecksum(asks, bids))
+}
+
+func TestUpdateDepthBookCache(t *testing.T) {
+	singletonCache = &cache{
+		depthBooksMap: make(map[string]pushservice.BookRes),
+		depthSeqMap:   make(map[string]int64),
+	}
+	book := pushservice.BookRes{Asks: [][]string{{"10.1", "5", "1"}}, Bids: [][]string{}, Product: "xxb_okt"}
+
+	diff, changed := UpdateDepthBookCache("xxb_okt", book)
+	require.True(t, changed)
+	require.Equal(t, int64(1), diff.Seq)
+
+	// the seq doesn't increase if the book isn't changed
+	_, changed = UpdateDepthBookCache("xxb_okt", book)
+	require.False(t, changed)
+
+	snapshot, ok := GetDepthSnapshotFromCache("xxb_okt")
+	require.True(t, ok)
+	require.Equal(t, int64(1), snapshot.Seq)
+	require.Equal(t, book.Asks, snapshot.Asks)
+	_, ok = GetDepthSnapshotFromCache("yyb_okt")
+	require.False(t, ok)
+}
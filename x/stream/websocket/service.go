@@ -89,6 +89,16 @@ func (engine *Engine) Write(data types.IStreamData, success *bool) {
 		events = append(events, event)
 	}
 
+	// 5. collect depth diff events
+	for key, value := range wsData.DepthDiffsMap {
+		channel := fmt.Sprintf("%s:%s", DexSpotDepthDiff, key)
+		event, err := engine.NewEvent(channel, value)
+		if err != nil {
+			panic(err)
+		}
+		events = append(events, event)
+	}
+
 	wsData.eventMgr.EmitEvents(events)
 	*success = true
 }
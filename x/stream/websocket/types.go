@@ -9,12 +9,13 @@ import (
 
 type PushData struct {
 	*pushservice.RedisBlock
-	eventMgr *sdk.EventManager
+	DepthDiffsMap map[string]DepthDiff `json:"depthDiffs"`
+	eventMgr      *sdk.EventManager
 }
 
 func NewPushData() *PushData {
 	baseData := pushservice.NewRedisBlock()
-	pd := PushData{RedisBlock: baseData, eventMgr: nil}
+	pd := PushData{RedisBlock: baseData, DepthDiffsMap: make(map[string]DepthDiff), eventMgr: nil}
 	return &pd
 }
 
@@ -28,7 +29,9 @@ func (data *PushData) SetData(ctx sdk.Context, orderKeeper types.OrderKeeper, to
 	for _, product := range products {
 		depthBook := orderKeeper.GetDepthBookCopy(product)
 		bookRes := pushservice.ConvertBookRes(product, orderKeeper, depthBook, 200)
-		UpdateDepthBookCache(product, bookRes)
+		if diff, changed := UpdateDepthBookCache(product, bookRes); changed {
+			data.DepthDiffsMap[product] = diff
+		}
 	}
 
 }
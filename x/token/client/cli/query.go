@@ -8,6 +8,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/x/token/types"
 	"github.com/spf13/cobra"
@@ -26,6 +27,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	queryCmd.AddCommand(flags.GetCommands(
 		getCmdQueryParams(queryRoute, cdc),
 		getCmdTokenInfo(queryRoute, cdc),
+		getCmdQueryVestingAccount(queryRoute, cdc),
+		getCmdQueryFrozenAddresses(queryRoute, cdc),
+		getCmdQueryTokenMetadata(queryRoute, cdc),
 		//getAccountCmd(queryRoute, cdc),
 	)...)
 
@@ -105,6 +109,80 @@ $ exchaincli query token params
 	}
 }
 
+// getCmdQueryVestingAccount implements the query vesting account command.
+func getCmdQueryVestingAccount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting [address]",
+		Short: "Query the vesting schedule of a vesting account",
+		Long: strings.TrimSpace(`Query the vesting schedule of a vesting account, and the coins still vesting:
+
+$ exchaincli query token vesting ex1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryVestingAccount, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var vestingAccount types.VestingAccountResponse
+			cdc.MustUnmarshalJSON(bz, &vestingAccount)
+			return cliCtx.PrintOutput(vestingAccount)
+		},
+	}
+}
+
+// getCmdQueryFrozenAddresses implements the query frozen addresses command.
+func getCmdQueryFrozenAddresses(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen [symbol]",
+		Short: "Query the addresses frozen for a controllable token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryFrozenAddresses, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var addrs []sdk.AccAddress
+			cdc.MustUnmarshalJSON(bz, &addrs)
+			addrStrs := make(Strings, len(addrs))
+			for i, addr := range addrs {
+				addrStrs[i] = addr.String()
+			}
+			return cliCtx.PrintOutput(addrStrs)
+		},
+	}
+}
+
+// getCmdQueryTokenMetadata implements the query token metadata command.
+func getCmdQueryTokenMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [symbol]",
+		Short: "Query the metadata of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTokenMetadata, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.TokenMetadata
+			cdc.MustUnmarshalJSON(bz, &metadata)
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
 // just for the object of []string could be inputted into cliCtx.PrintOutput(...)
 type Strings []string
 
@@ -11,12 +11,16 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	authTypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/gov"
+	tokenutils "github.com/okex/exchain/x/token/client/utils"
 	"github.com/okex/exchain/x/token/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
@@ -29,8 +33,16 @@ const (
 	WholeName     = "whole-name"
 	TokenDesc     = "desc"
 	Mintable      = "mintable"
+	Controllable  = "controllable"
 	Transfers     = "transfers"
 	TransfersFile = "transfers-file"
+	StartTime     = "start-time"
+	EndTime       = "end-time"
+	PeriodsFile   = "periods-file"
+	Decimals      = "decimals"
+	DenomUnits    = "denom-units"
+	LogoURI       = "logo-uri"
+	Website       = "website"
 )
 
 const (
@@ -69,6 +81,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		getCmdTransferOwnership(cdc),
 		getCmdConfirmOwnership(cdc),
 		getCmdTokenEdit(cdc),
+		getCmdVestingTransfer(cdc),
+		getCmdTokenPause(cdc, true),
+		getCmdTokenPause(cdc, false),
+		getCmdTokenFreeze(cdc, true),
+		getCmdTokenFreeze(cdc, false),
 	)...)
 
 	return distTxCmd
@@ -126,11 +143,16 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return errMintableNotValid
 			}
+			controllable, err := flags.GetBool(Controllable)
+			if err != nil {
+				return err
+			}
 
 			var symbol string
 
 			// totalSupply int64 ,coins bigint
 			msg := types.NewMsgTokenIssue(tokenDesc, symbol, originalSymbol, wholeName, totalSupply, cliCtx.FromAddress, mintable)
+			msg.Controllable = controllable
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -141,6 +163,7 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(TokenDesc, "", "describe of the token")
 	cmd.Flags().StringP(TotalSupply, "n", "0", "total supply of the new token")
 	cmd.Flags().Bool(Mintable, false, "whether the token can be minted")
+	cmd.Flags().Bool(Controllable, false, "whether the owner can pause the transfers of the token and freeze addresses")
 
 	return cmd
 }
@@ -412,21 +435,77 @@ func getCmdTokenEdit(cdc *codec.Codec) *cobra.Command {
 					return errTokenWholeNameNotValid
 				}
 			}
-			if !isWholeNameEdit && !isDescEdit {
+			metadata, err := getMetadataToModify(cliCtx, cdc, flags, symbol)
+			if err != nil {
+				return err
+			}
+			if !isWholeNameEdit && !isDescEdit && metadata == nil {
 				return errParam
 			}
 
 			msg := types.NewMsgTokenModify(symbol, tokenDesc, wholeName, isDescEdit, isWholeNameEdit, cliCtx.FromAddress)
+			msg.Metadata = metadata
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
 	cmd.Flags().StringP(WholeName, "w", "", "whole name of the token")
 	cmd.Flags().String(TokenDesc, "", "description of the token")
+	cmd.Flags().Uint32(Decimals, 0, "decimals to display the amounts of the token with")
+	cmd.Flags().String(DenomUnits, "", `denom units of the token, format: [{"denom": "musdk", "exponent": -3, "aliases": ["millusdk"]}, ...]`)
+	cmd.Flags().String(LogoURI, "", "uri of the token's logo")
+	cmd.Flags().String(Website, "", "website of the token")
 
 	return cmd
 }
 
+// getMetadataToModify returns the current metadata of the token overwritten by the metadata flags,
+// or nil if none of the flags is set
+func getMetadataToModify(cliCtx context.CLIContext, cdc *codec.Codec, flags *pflag.FlagSet, symbol string) (
+	*types.TokenMetadata, error) {
+	if !flags.Changed(Decimals) && !flags.Changed(DenomUnits) && !flags.Changed(LogoURI) && !flags.Changed(Website) {
+		return nil, nil
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTokenMetadata, symbol), nil)
+	if err != nil {
+		return nil, err
+	}
+	var metadata types.TokenMetadata
+	if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+	// the verification is revoked by the modification
+	metadata.Verified = false
+
+	if flags.Changed(Decimals) {
+		if metadata.Decimals, err = flags.GetUint32(Decimals); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Changed(DenomUnits) {
+		denomUnits, err := flags.GetString(DenomUnits)
+		if err != nil {
+			return nil, err
+		}
+		metadata.DenomUnits = nil
+		if err := cdc.UnmarshalJSON([]byte(denomUnits), &metadata.DenomUnits); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Changed(LogoURI) {
+		if metadata.LogoURI, err = flags.GetString(LogoURI); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Changed(Website) {
+		if metadata.Website, err = flags.GetString(Website); err != nil {
+			return nil, err
+		}
+	}
+	return &metadata, nil
+}
+
 // getCmdConfirmOwnership is the CLI command for sending a ConfirmOwnership transaction
 func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -460,3 +539,182 @@ func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP("symbol", "s", "", "symbol of the token to be transferred")
 	return cmd
 }
+
+// getCmdVestingTransfer is the CLI command for sending a VestingTransfer transaction
+func getCmdVestingTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-transfer [to_address] [amount]",
+		Short: "transfer coins vesting linearly or by periods to an address",
+		Long: strings.TrimSpace(`Transfer coins vesting linearly between the start time and the end time:
+
+$ exchaincli tx token vesting-transfer ex1... 1000okt --start-time 1640995200 --end-time 1672531200 --from mykey
+
+or vesting at the end of each period defined in a json file, where the length of a period is in seconds:
+
+$ exchaincli tx token vesting-transfer ex1... 1000okt --start-time 1640995200 --periods-file periods.json --from mykey
+
+[{"length": 2592000, "amount": [{"denom": "okt", "amount": "500"}]}, {"length": 2592000, "amount": [{"denom": "okt", "amount": "500"}]}]
+
+The vesting starts at the time of the block including the tx if the start time is not set.
+The account of the address becomes a vesting account, whose vesting coins can be delegated but not spent.
+Coins can only be transferred to an existing vesting account by the same schedule.
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			flags := cmd.Flags()
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+			coins, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+			startTime, err := flags.GetInt64(StartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := flags.GetInt64(EndTime)
+			if err != nil {
+				return err
+			}
+			periodsFile, err := flags.GetString(PeriodsFile)
+			if err != nil {
+				return err
+			}
+
+			var periods types.VestingPeriods
+			if periodsFile != "" {
+				periodsBytes, err := ioutil.ReadFile(periodsFile)
+				if err != nil {
+					return err
+				}
+				if err := cdc.UnmarshalJSON(periodsBytes, &periods); err != nil {
+					return err
+				}
+				endTime = 0
+			}
+
+			msg := types.NewMsgVestingTransfer(cliCtx.GetFromAddress(), to, coins, startTime, endTime, periods)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Int64(StartTime, 0, "unix time in seconds when the coins start to vest")
+	cmd.Flags().Int64(EndTime, 0, "unix time in seconds when all the coins vest linearly")
+	cmd.Flags().String(PeriodsFile, "", "json file of the vesting periods, if it is set, --end-time will be ignored")
+	return cmd
+}
+
+// getCmdTokenPause is the CLI command for sending a TokenPause transaction, which pauses or resumes the transfers
+func getCmdTokenPause(cdc *codec.Codec, paused bool) *cobra.Command {
+	use, short := "pause [symbol]", "pause all the transfers of a controllable token"
+	if !paused {
+		use, short = "unpause [symbol]", "resume the transfers of a paused token"
+	}
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgTokenPause(args[0], paused, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// getCmdTokenFreeze is the CLI command for sending a TokenFreeze transaction, which freezes or unfreezes addresses
+func getCmdTokenFreeze(cdc *codec.Codec, frozen bool) *cobra.Command {
+	use, short := "freeze [symbol] [addresses]", "freeze the comma separated addresses for a controllable token"
+	if !frozen {
+		use, short = "unfreeze [symbol] [addresses]", "unfreeze the comma separated addresses for a controllable token"
+	}
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var addrs []sdk.AccAddress
+			for _, addrStr := range strings.Split(args[1], ",") {
+				addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(addrStr))
+				if err != nil {
+					return fmt.Errorf("invalid address：%s", addrStr)
+				}
+				addrs = append(addrs, addr)
+			}
+
+			msg := types.NewMsgTokenFreeze(args[0], addrs, frozen, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdVerifyTokenMetadataProposal implements a command handler for submitting a verify token metadata proposal
+func GetCmdVerifyTokenMetadataProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-token-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to verify the metadata of a token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal verifying the metadata of a token, or revoking the verification, along with an
+initial deposit. The proposal details must be supplied via a JSON file. The metadata to verify is pinned by its
+hash, which is queried from the chain if it's not set in the file.
+
+Example:
+$ %s tx gov submit-proposal verify-token-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "verify the metadata of usdk",
+ "description": "the logo and the website of usdk have been checked",
+ "symbol": "usdk-017",
+ "verified": true,
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := tokenutils.ParseVerifyTokenMetadataProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			if proposal.Verified && len(proposal.MetadataHash) == 0 {
+				route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTokenMetadata, proposal.Symbol)
+				bz, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+				var metadata types.TokenMetadata
+				cdc.MustUnmarshalJSON(bz, &metadata)
+				proposal.MetadataHash = metadata.Hash()
+			}
+
+			content := types.NewVerifyTokenMetadataProposal(proposal.Title, proposal.Description, proposal.Symbol,
+				proposal.Verified, proposal.MetadataHash)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
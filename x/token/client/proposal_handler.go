@@ -0,0 +1,13 @@
+package client
+
+import (
+	govcli "github.com/okex/exchain/x/gov/client"
+	"github.com/okex/exchain/x/token/client/cli"
+	"github.com/okex/exchain/x/token/client/rest"
+)
+
+var (
+	// VerifyTokenMetadataProposalHandler alias gov NewProposalHandler
+	VerifyTokenMetadataProposalHandler = govcli.NewProposalHandler(cli.GetCmdVerifyTokenMetadataProposal,
+		rest.VerifyTokenMetadataProposalRESTHandler)
+)
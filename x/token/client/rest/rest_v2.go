@@ -16,6 +16,7 @@ import (
 // which is called by the rest module in main application
 func RegisterRoutesV2(cliCtx context.CLIContext, r *mux.Router, storeName string) {
 	r.HandleFunc(fmt.Sprintf("/tokens/{currency}"), tokenHandlerV2(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/tokens/{currency}/metadata"), tokenMetadataHandlerV2(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/tokens"), tokensHandlerV2(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/accounts/{address}"), accountsHandlerV2(cliCtx, storeName)).Methods("GET")
 }
@@ -30,6 +31,15 @@ func tokenHandlerV2(cliCtx context.CLIContext, storeName string) http.HandlerFun
 	}
 }
 
+func tokenMetadataHandlerV2(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tokenName := mux.Vars(r)["currency"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryTokenMetadataV2, tokenName), nil)
+		common.HandleResponseV2(w, res, err)
+	}
+}
+
 func tokensHandlerV2(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokensV2), nil)
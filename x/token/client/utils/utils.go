@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// VerifyTokenMetadataProposalJSON defines a VerifyTokenMetadataProposal with a deposit used to parse verify token
+// metadata proposals from a JSON file.
+type VerifyTokenMetadataProposalJSON struct {
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Symbol       string       `json:"symbol" yaml:"symbol"`
+	Verified     bool         `json:"verified" yaml:"verified"`
+	MetadataHash string       `json:"metadata_hash" yaml:"metadata_hash"`
+	Deposit      sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseVerifyTokenMetadataProposalJSON parses json from proposal file to VerifyTokenMetadataProposalJSON struct
+func ParseVerifyTokenMetadataProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal VerifyTokenMetadataProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -0,0 +1,62 @@
+package token
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// SetAddressFrozen freezes or unfreezes the address for the token
+func (k Keeper) SetAddressFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	key := types.GetFrozenAddressKey(symbol, addr)
+	if frozen {
+		store.Set(key, []byte{})
+		return
+	}
+	store.Delete(key)
+}
+
+// IsAddressFrozen returns whether the address is frozen for the token
+func (k Keeper) IsAddressFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.tokenStoreKey).Has(types.GetFrozenAddressKey(symbol, addr))
+}
+
+// GetFrozenAddresses gets all the addresses frozen for the token
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, symbol string) (addrs []sdk.AccAddress) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.tokenStoreKey), types.GetFrozenAddressesPrefix(symbol))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, addr := types.SplitFrozenAddressKey(iter.Key())
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// IterateFrozenAddresses iterates over the frozen addresses of all the tokens and performs a callback function
+func (k Keeper) IterateFrozenAddresses(ctx sdk.Context, cb func(symbol string, addr sdk.AccAddress) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.tokenStoreKey), types.FrozenAddressKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.SplitFrozenAddressKey(iter.Key())) {
+			break
+		}
+	}
+}
+
+// CheckCoinsTransferable checks whether the coins can be transferred out of the address.
+// The transfers of a controllable token are rejected when the token is paused or the address is frozen
+func (k Keeper) CheckCoinsTransferable(ctx sdk.Context, from sdk.AccAddress, coins sdk.SysCoins) error {
+	for _, coin := range coins {
+		token := k.GetTokenInfo(ctx, coin.Denom)
+		if !token.Controllable {
+			continue
+		}
+		if token.Paused {
+			return types.ErrTokenTransfersPaused(coin.Denom)
+		}
+		if k.IsAddressFrozen(ctx, coin.Denom, from) {
+			return types.ErrAddressIsFrozen(from, coin.Denom)
+		}
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ type GenesisState struct {
 	Tokens       []types.Token    `json:"tokens"`
 	LockedAssets []types.AccCoins `json:"locked_assets"`
 	LockedFees   []types.AccCoins `json:"locked_fees"`
+
+	FrozenAddresses []types.FrozenAddress `json:"frozen_addresses"`
+	TokensMetadata  []types.TokenMetadata `json:"tokens_metadata"`
 }
 
 // default GenesisState used by Cosmos Hub
@@ -64,6 +67,16 @@ func validateGenesis(data GenesisState) error {
 			return errors.New(err.Error())
 		}
 	}
+	for _, frozen := range data.FrozenAddresses {
+		if len(frozen.Symbol) == 0 || frozen.Address.Empty() {
+			return fmt.Errorf("invalid frozen address %s of token %s", frozen.Address, frozen.Symbol)
+		}
+	}
+	for _, metadata := range data.TokensMetadata {
+		if err := metadata.ValidateBasic(); err != nil {
+			return errors.New(err.Error())
+		}
+	}
 	return nil
 }
 
@@ -93,6 +106,12 @@ func initGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+	for _, frozen := range data.FrozenAddresses {
+		keeper.SetAddressFrozen(ctx, frozen.Symbol, frozen.Address, true)
+	}
+	for _, metadata := range data.TokensMetadata {
+		keeper.SetTokenMetadata(ctx, metadata)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -113,10 +132,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	var frozenAddresses []types.FrozenAddress
+	keeper.IterateFrozenAddresses(ctx, func(symbol string, addr sdk.AccAddress) bool {
+		frozenAddresses = append(frozenAddresses, types.FrozenAddress{Symbol: symbol, Address: addr})
+		return false
+	})
+
 	return GenesisState{
-		Params:       params,
-		Tokens:       tokens,
-		LockedAssets: lockedAsset,
-		LockedFees:   lockedFees,
+		Params:          params,
+		Tokens:          tokens,
+		LockedAssets:    lockedAsset,
+		LockedFees:      lockedFees,
+		FrozenAddresses: frozenAddresses,
+		TokensMetadata:  keeper.GetTokensMetadata(ctx),
 	}
 }
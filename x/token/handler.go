@@ -2,6 +2,7 @@ package token
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/okex/exchain/x/common"
 
@@ -52,6 +53,12 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 				return handleMsgSend(ctx, keeper, msg, logger)
 			}
 
+		case types.MsgVestingTransfer:
+			name = "handleMsgVestingTransfer"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgVestingTransfer(ctx, keeper, msg, logger)
+			}
+
 		case types.MsgTransferOwnership:
 			name = "handleMsgTransferOwnership"
 			handlerFun = func() (*sdk.Result, error) {
@@ -68,6 +75,18 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenModify(ctx, keeper, msg, logger)
 			}
+
+		case types.MsgTokenPause:
+			name = "handleMsgTokenPause"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenPause(ctx, keeper, msg, logger)
+			}
+
+		case types.MsgTokenFreeze:
+			name = "handleMsgTokenFreeze"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenFreeze(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Unrecognized token Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -99,6 +118,7 @@ func handleMsgTokenIssue(ctx sdk.Context, keeper Keeper, msg types.MsgTokenIssue
 		OriginalTotalSupply: totalSupply,
 		Owner:               msg.Owner,
 		Mintable:            msg.Mintable,
+		Controllable:        msg.Controllable,
 	}
 
 	// generate a random symbol
@@ -268,6 +288,9 @@ func handleMsgMultiSend(ctx sdk.Context, keeper Keeper, msg types.MsgMultiSend,
 	var coinNum int
 	for _, transferUnit := range msg.Transfers {
 		coinNum += len(transferUnit.Coins)
+		if err := keeper.CheckCoinsTransferable(ctx, msg.From, transferUnit.Coins); err != nil {
+			return nil, err
+		}
 		err := keeper.SendCoinsFromAccountToAccount(ctx, msg.From, transferUnit.To, transferUnit.Coins)
 		if err != nil {
 			return types.ErrSendCoinsFromAccountToAccountFailed(err.Error()).Result()
@@ -297,6 +320,9 @@ func handleMsgSend(ctx sdk.Context, keeper Keeper, msg types.MsgSend, logger log
 		return types.ErrSendDisabled().Result()
 	}
 
+	if err := keeper.CheckCoinsTransferable(ctx, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
 	err := keeper.SendCoinsFromAccountToAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return types.ErrSendCoinsFromAccountToAccountFailed(err.Error()).Result()
@@ -318,6 +344,44 @@ func handleMsgSend(ctx sdk.Context, keeper Keeper, msg types.MsgSend, logger log
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgVestingTransfer(ctx sdk.Context, keeper Keeper, msg types.MsgVestingTransfer, logger log.Logger) (*sdk.Result, error) {
+	if !keeper.bankKeeper.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled().Result()
+	}
+
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+	endTime := msg.GetEndTime(startTime)
+	err := keeper.VestingTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, startTime, endTime, msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	var name = "handleMsgVestingTransfer"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<From:%s,To:%s,Amount:%s,StartTime:%d,EndTime:%d>\n",
+			ctx.BlockHeight(), name,
+			msg.FromAddress, msg.ToAddress, msg.Amount, startTime, endTime))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVestingTransfer,
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.ToAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(startTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(endTime, 10)),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership, logger log.Logger) (*sdk.Result, error) {
 	tokenInfo := keeper.GetTokenInfo(ctx, msg.Symbol)
 
@@ -417,7 +481,7 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	if !token.Owner.Equals(msg.Owner) {
 		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
 	}
-	if !msg.IsWholeNameModified && !msg.IsDescriptionModified {
+	if !msg.IsWholeNameModified && !msg.IsDescriptionModified && msg.Metadata == nil {
 		return types.ErrWholeNameAndDescriptionIsNotModified().Result()
 	}
 	// modify
@@ -429,6 +493,10 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	}
 
 	keeper.UpdateToken(ctx, token)
+	// the verification of governance is revoked once the metadata is modified
+	if msg.Metadata != nil {
+		keeper.SetTokenMetadata(ctx, *msg.Metadata)
+	}
 
 	// deduction fee
 	feeDecCoins := keeper.GetParams(ctx).FeeModify.ToCoins()
@@ -456,3 +524,69 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgTokenPause(ctx sdk.Context, keeper Keeper, msg types.MsgTokenPause, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Controllable {
+		return types.ErrTokenIsNotControllable(msg.Symbol).Result()
+	}
+
+	token.Paused = msg.Paused
+	keeper.UpdateToken(ctx, token)
+
+	name := "handleMsgTokenPause"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Paused:%v>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Paused))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(msg.Paused)),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenFreeze(ctx sdk.Context, keeper Keeper, msg types.MsgTokenFreeze, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Controllable {
+		return types.ErrTokenIsNotControllable(msg.Symbol).Result()
+	}
+
+	events := make(sdk.Events, 0, len(msg.Addresses)+1)
+	for _, addr := range msg.Addresses {
+		keeper.SetAddressFrozen(ctx, msg.Symbol, addr, msg.Frozen)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeFreezeAddresses,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(msg.Frozen)),
+		))
+	}
+
+	name := "handleMsgTokenFreeze"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Addresses:%v,Frozen:%v>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Addresses, msg.Frozen))
+	}
+
+	events = append(events, sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)))
+	ctx.EventManager().EmitEvents(events)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -12,6 +12,7 @@ import (
 	app "github.com/okex/exchain/app/types"
 	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/common/version"
+	govtypes "github.com/okex/exchain/x/gov/types"
 	"github.com/okex/exchain/x/token"
 	"github.com/okex/exchain/x/token/types"
 	"github.com/stretchr/testify/require"
@@ -72,6 +73,68 @@ func TestHandlerBlockedContractAddrSend(t *testing.T) {
 	}
 }
 
+func TestHandlerPauseAndFreeze(t *testing.T) {
+	okexapp := initApp(true)
+	ctx := okexapp.BaseApp.NewContext(true, abci.Header{Height: 1})
+	gAcc := CreateEthAccounts(2, sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec("usdk", sdk.NewDec(10000)),
+		sdk.NewDecCoinFromDec("xxb", sdk.NewDec(10000)),
+	})
+	okexapp.AccountKeeper.SetAccount(ctx, gAcc[0])
+	okexapp.AccountKeeper.SetAccount(ctx, gAcc[1])
+	owner, holder := gAcc[0].Address, gAcc[1].Address
+	okexapp.TokenKeeper.NewToken(ctx, types.Token{Symbol: "usdk", OriginalSymbol: "usdk", Owner: owner, Controllable: true})
+	okexapp.TokenKeeper.NewToken(ctx, types.Token{Symbol: "xxb", OriginalSymbol: "xxb", Owner: owner})
+	handler := token.NewTokenHandler(okexapp.TokenKeeper, version.CurrentProtocolVersion)
+	okexapp.BankKeeper.SetSendEnabled(ctx, true)
+	coins := func(denom string) sdk.SysCoins {
+		return sdk.SysCoins{sdk.NewDecCoinFromDec(denom, sdk.NewDec(1))}
+	}
+
+	// only the owner of a controllable token can freeze addresses
+	_, err := handler(ctx, types.NewMsgTokenFreeze("usdk", []sdk.AccAddress{holder}, true, holder))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgTokenFreeze("xxb", []sdk.AccAddress{holder}, true, owner))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgTokenFreeze("usdk", []sdk.AccAddress{holder}, true, owner))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{holder}, okexapp.TokenKeeper.GetFrozenAddresses(ctx, "usdk"))
+
+	// the frozen address can't transfer the token out, but can still receive it and transfer other tokens
+	_, err = handler(ctx, types.NewMsgTokenSend(holder, owner, coins("usdk")))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgMultiSend(holder, []types.TransferUnit{{To: owner, Coins: coins("usdk")}}))
+	require.Error(t, err)
+	require.Error(t, okexapp.TokenKeeper.LockCoins(ctx, holder, coins("usdk"), types.LockCoinsTypeQuantity))
+	_, err = handler(ctx, types.NewMsgTokenSend(owner, holder, coins("usdk")))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgTokenSend(holder, owner, coins("xxb")))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgTokenFreeze("usdk", []sdk.AccAddress{holder}, false, owner))
+	require.NoError(t, err)
+	require.Empty(t, okexapp.TokenKeeper.GetFrozenAddresses(ctx, "usdk"))
+	_, err = handler(ctx, types.NewMsgTokenSend(holder, owner, coins("usdk")))
+	require.NoError(t, err)
+
+	// all the transfers of the paused token are rejected
+	_, err = handler(ctx, types.NewMsgTokenPause("xxb", true, owner))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgTokenPause("usdk", true, owner))
+	require.NoError(t, err)
+	require.True(t, okexapp.TokenKeeper.GetTokenInfo(ctx, "usdk").Paused)
+	_, err = handler(ctx, types.NewMsgTokenSend(owner, holder, coins("usdk")))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgTokenSend(owner, holder, coins(common.NativeToken)))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgTokenPause("usdk", false, owner))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgTokenSend(owner, holder, coins("usdk")))
+	require.NoError(t, err)
+}
+
 // Setup initializes a new OKExChainApp. A Nop logger is set in OKExChainApp.
 func initApp(isCheckTx bool) *okexchain.OKExChainApp {
 	db := dbm.NewMemDB()
@@ -117,3 +180,57 @@ func CreateEthAccounts(numAccs int, genCoins sdk.SysCoins) (genAccs []app.EthAcc
 	}
 	return
 }
+
+func TestHandlerTokenMetadata(t *testing.T) {
+	okexapp := initApp(true)
+	ctx := okexapp.BaseApp.NewContext(true, abci.Header{Height: 1})
+	gAcc := CreateEthAccounts(2, sdk.SysCoins{
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(10000)),
+	})
+	okexapp.AccountKeeper.SetAccount(ctx, gAcc[0])
+	okexapp.AccountKeeper.SetAccount(ctx, gAcc[1])
+	owner, other := gAcc[0].Address, gAcc[1].Address
+	okexapp.TokenKeeper.SetParams(ctx, types.DefaultParams())
+	okexapp.TokenKeeper.NewToken(ctx, types.Token{Symbol: "usdk", OriginalSymbol: "usdk", Owner: owner})
+	handler := token.NewTokenHandler(okexapp.TokenKeeper, version.CurrentProtocolVersion)
+	proposalHandler := token.NewProposalHandler(&okexapp.TokenKeeper)
+
+	// the metadata of a token without metadata set is the default one
+	require.Equal(t, types.DefaultTokenMetadata("usdk"), okexapp.TokenKeeper.GetTokenMetadataOrDefault(ctx, "usdk"))
+
+	metadata := types.NewTokenMetadata("usdk", 6, []types.DenomUnit{{Denom: "musdk", Exponent: -3}},
+		"https://static.okex.com/usdk.png", "https://www.okex.com")
+	modifyMsg := func(sender sdk.AccAddress) types.MsgTokenModify {
+		msg := types.NewMsgTokenModify("usdk", "", "", false, false, sender)
+		msg.Metadata = &metadata
+		return msg
+	}
+	_, err := handler(ctx, modifyMsg(other))
+	require.Error(t, err)
+	_, err = handler(ctx, modifyMsg(owner))
+	require.NoError(t, err)
+	stored, found := okexapp.TokenKeeper.GetTokenMetadata(ctx, "usdk")
+	require.True(t, found)
+	require.Equal(t, metadata, stored)
+
+	// only the governance can verify the metadata pinned by the proposal
+	proposal := govtypes.Proposal{Content: types.NewVerifyTokenMetadataProposal("title", "desc", "usdk", true,
+		types.DefaultTokenMetadata("usdk").Hash())}
+	require.Error(t, proposalHandler(ctx, &proposal))
+	require.False(t, okexapp.TokenKeeper.GetTokenMetadataOrDefault(ctx, "usdk").Verified)
+	proposal = govtypes.Proposal{Content: types.NewVerifyTokenMetadataProposal("title", "desc", "usdk", true,
+		metadata.Hash())}
+	require.NoError(t, proposalHandler(ctx, &proposal))
+	require.True(t, okexapp.TokenKeeper.GetTokenMetadataOrDefault(ctx, "usdk").Verified)
+	proposal = govtypes.Proposal{Content: types.NewVerifyTokenMetadataProposal("title", "desc", "xxb", true,
+		metadata.Hash())}
+	require.Error(t, proposalHandler(ctx, &proposal))
+
+	// the verification is reset once the owner modifies the metadata
+	metadata.Decimals = 8
+	_, err = handler(ctx, modifyMsg(owner))
+	require.NoError(t, err)
+	stored = okexapp.TokenKeeper.GetTokenMetadataOrDefault(ctx, "usdk")
+	require.False(t, stored.Verified)
+	require.Equal(t, uint32(8), stored.Decimals)
+}
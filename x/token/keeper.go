@@ -22,6 +22,7 @@ type Keeper struct {
 	bankKeeper       bank.Keeper
 	supplyKeeper     SupplyKeeper
 	accountKeeper    types.AccountKeeper
+	govKeeper        types.GovKeeper
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 
 	// The reference to the Paramstore to get and set gov specific params
@@ -192,6 +193,9 @@ func (k Keeper) SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccA
 
 // nolint
 func (k Keeper) LockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error {
+	if err := k.CheckCoinsTransferable(ctx, addr, coins); err != nil {
+		return err
+	}
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
 		return types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
 	}
@@ -447,3 +451,8 @@ func (k Keeper) DeleteConfirmOwnership(ctx sdk.Context, symbol string) {
 	key := types.GetConfirmOwnershipKey(symbol)
 	store.Delete(key)
 }
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
@@ -3,6 +3,7 @@ package token
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/mock"
@@ -279,3 +280,76 @@ func TestKeeper_SetCoins(t *testing.T) {
 	require.EqualValues(t, "1001.000000000000000000", keeper.GetCoinsInfo(ctx,
 		testAccounts[1].baseAccount.Address)[0].Available)
 }
+
+func TestKeeper_VestingTransfer(t *testing.T) {
+	mapp, keeper, _ := getMockDexApp(t, 0)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockTime(time.Unix(1000, 0))
+	mapp.supplyKeeper.SetSupply(ctx, supply.NewSupply(mapp.TotalCoinsSupply))
+
+	genAccs, testAccounts := CreateGenAccounts(3,
+		sdk.SysCoins{
+			sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(1000)),
+		})
+	mock.SetGenesis(mapp.App, types.DecAccountArrToBaseAccountArr(genAccs))
+	from, to := testAccounts[0].baseAccount.Address, testAccounts[1].baseAccount.Address
+	other := testAccounts[2].baseAccount.Address
+	okt := func(amount int64) sdk.SysCoins {
+		return sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(amount))}
+	}
+
+	// the schedule ended before the block time is rejected
+	err := keeper.VestingTransfer(ctx, from, to, okt(100), 900, 1000, nil)
+	require.Error(t, err)
+
+	// the coins are transferred into the vesting account of the receiver, where they can't be spent until they vest
+	err = keeper.VestingTransfer(ctx, from, to, okt(100), 1000, 1100, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, okt(900), keeper.GetCoins(ctx, from))
+	require.EqualValues(t, okt(1100), keeper.GetCoins(ctx, to))
+	vestingAccount, found := keeper.GetVestingAccount(ctx, to)
+	require.True(t, found)
+	require.EqualValues(t, okt(100), vestingAccount.GetVestingCoins(ctx.BlockTime()))
+	require.Error(t, keeper.SendCoinsFromAccountToAccount(ctx, to, from, okt(1001)))
+	require.NoError(t, keeper.SendCoinsFromAccountToAccount(ctx, to, from, okt(1000)))
+
+	// the vesting coins can be delegated
+	moduleAcc := mapp.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, mapp.bankKeeper.DelegateCoins(ctx, to, moduleAcc.GetAddress(), okt(100)))
+	vestingAccount, _ = keeper.GetVestingAccount(ctx, to)
+	require.EqualValues(t, okt(100), vestingAccount.GetDelegatedVesting())
+
+	// the vesting account is only extended by the same schedule
+	periods := types.VestingPeriods{{Length: 10, Amount: okt(10)}, {Length: 20, Amount: okt(20)}}
+	require.Error(t, keeper.VestingTransfer(ctx, from, to, okt(100), 1000, 1200, nil))
+	require.Error(t, keeper.VestingTransfer(ctx, from, to, okt(30), 1000, 1030, periods))
+	require.NoError(t, keeper.VestingTransfer(ctx, from, to, okt(100), 1000, 1100, nil))
+	vestingAccount, _ = keeper.GetVestingAccount(ctx, to)
+	require.EqualValues(t, okt(200), vestingAccount.GetOriginalVesting())
+	require.EqualValues(t, okt(100), vestingAccount.GetVestingCoins(time.Unix(1050, 0)))
+
+	// the periodic vesting account is extended by the same periods
+	require.NoError(t, keeper.VestingTransfer(ctx, from, other, okt(30), 1000, 1030, periods))
+	otherPeriods := types.VestingPeriods{{Length: 20, Amount: okt(20)}, {Length: 10, Amount: okt(10)}}
+	require.Error(t, keeper.VestingTransfer(ctx, from, other, okt(30), 1000, 1030, otherPeriods))
+	require.NoError(t, keeper.VestingTransfer(ctx, from, other, okt(30), 1000, 1030, periods))
+	vestingAccount, _ = keeper.GetVestingAccount(ctx, other)
+	require.EqualValues(t, okt(60), vestingAccount.GetOriginalVesting())
+	require.EqualValues(t, okt(20), vestingAccount.GetVestedCoins(time.Unix(1010, 0)))
+
+	// the vested coins can be spent
+	ctx = ctx.WithBlockTime(time.Unix(1010, 0))
+	require.Error(t, keeper.SendCoinsFromAccountToAccount(ctx, other, from, okt(1021)))
+	require.NoError(t, keeper.SendCoinsFromAccountToAccount(ctx, other, from, okt(1020)))
+
+	res, err := queryVestingAccount(ctx, []string{other.String()}, keeper)
+	require.NoError(t, err)
+	var response types.VestingAccountResponse
+	keeper.cdc.MustUnmarshalJSON(res, &response)
+	require.EqualValues(t, 1030, response.EndTime)
+	require.EqualValues(t, okt(40), response.VestingCoins)
+	require.Equal(t, 2, len(response.VestingPeriods))
+	_, err = queryVestingAccount(ctx, []string{from.String()}, keeper)
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package token
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// GetTokenMetadata gets the metadata of the token
+func (k Keeper) GetTokenMetadata(ctx sdk.Context, symbol string) (metadata types.TokenMetadata, found bool) {
+	bz := ctx.KVStore(k.tokenStoreKey).Get(types.GetTokenMetadataKey(symbol))
+	if bz == nil {
+		return metadata, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return metadata, true
+}
+
+// GetTokenMetadataOrDefault gets the metadata of the token, or the default one if it has not been set
+func (k Keeper) GetTokenMetadataOrDefault(ctx sdk.Context, symbol string) types.TokenMetadata {
+	metadata, found := k.GetTokenMetadata(ctx, symbol)
+	if !found {
+		return types.DefaultTokenMetadata(symbol)
+	}
+	return metadata
+}
+
+// SetTokenMetadata sets the metadata of the token into the store
+func (k Keeper) SetTokenMetadata(ctx sdk.Context, metadata types.TokenMetadata) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	store.Set(types.GetTokenMetadataKey(metadata.Symbol), k.cdc.MustMarshalBinaryBare(metadata))
+}
+
+// IterateTokensMetadata iterates over the metadata of all the tokens and performs a callback function
+func (k Keeper) IterateTokensMetadata(ctx sdk.Context, cb func(metadata types.TokenMetadata) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.tokenStoreKey), types.TokenMetadataKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var metadata types.TokenMetadata
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &metadata)
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetTokensMetadata gets the metadata of all the tokens which has been set
+func (k Keeper) GetTokensMetadata(ctx sdk.Context) (metadataList []types.TokenMetadata) {
+	k.IterateTokensMetadata(ctx, func(metadata types.TokenMetadata) bool {
+		metadataList = append(metadataList, metadata)
+		return false
+	})
+	return metadataList
+}
+
+// SetTokenMetadataVerified sets the verified flag of the token's metadata, which is only called by governance
+func (k Keeper) SetTokenMetadataVerified(ctx sdk.Context, symbol string, verified bool) {
+	metadata := k.GetTokenMetadataOrDefault(ctx, symbol)
+	metadata.Verified = verified
+	k.SetTokenMetadata(ctx, metadata)
+}
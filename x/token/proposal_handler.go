@@ -0,0 +1,36 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	govTypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// NewProposalHandler handles "gov" type message in "token"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.VerifyTokenMetadataProposal:
+			return handleVerifyTokenMetadataProposal(ctx, k, content)
+		default:
+			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleVerifyTokenMetadataProposal(ctx sdk.Context, k *Keeper, proposal types.VerifyTokenMetadataProposal) sdk.Error {
+	if !k.TokenExist(ctx, proposal.Symbol) {
+		return types.ErrInvalidCoins(proposal.Symbol)
+	}
+	if proposal.Verified {
+		if hash := k.GetTokenMetadataOrDefault(ctx, proposal.Symbol).Hash(); hash != proposal.MetadataHash {
+			return types.ErrInvalidTokenMetadata(fmt.Sprintf(
+				"metadata hash %s mismatches the one of the proposal %s", hash, proposal.MetadataHash))
+		}
+	}
+	k.SetTokenMetadataVerified(ctx, proposal.Symbol, proposal.Verified)
+	return nil
+}
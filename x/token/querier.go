@@ -34,6 +34,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTokenV2(ctx, path[1:], req, keeper)
 		case types.UploadAccount:
 			return uploadAccount(ctx, keeper)
+		case types.QueryVestingAccount:
+			return queryVestingAccount(ctx, path[1:], keeper)
+		case types.QueryFrozenAddresses:
+			return queryFrozenAddresses(ctx, path[1:], keeper)
+		case types.QueryTokenMetadata:
+			return queryTokenMetadata(ctx, path[1:], keeper)
+		case types.QueryTokenMetadataV2:
+			return queryTokenMetadataV2(ctx, path[1:], keeper)
 		default:
 			return nil, types.ErrUnknownTokenQueryType()
 		}
@@ -184,3 +192,58 @@ func uploadAccount(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 
 	return []byte("Complete the Export account data and Upload it to oss"), nil
 }
+
+func queryVestingAccount(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrAddressIsRequired()
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, common.ErrCreateAddrFromBech32Failed(path[0], err.Error())
+	}
+
+	vestingAccount, found := keeper.GetVestingAccount(ctx, addr)
+	if !found {
+		return nil, types.ErrVestingAccountNotExist(addr)
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.NewVestingAccountResponse(vestingAccount, ctx.BlockTime()))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func queryFrozenAddresses(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrMsgSymbolIsEmpty()
+	}
+	if !keeper.TokenExist(ctx, path[0]) {
+		return nil, types.ErrInvalidCoins(path[0])
+	}
+
+	addrs := keeper.GetFrozenAddresses(ctx, path[0])
+	if addrs == nil {
+		addrs = []sdk.AccAddress{}
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, addrs)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func queryTokenMetadata(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrMsgSymbolIsEmpty()
+	}
+	if !keeper.TokenExist(ctx, path[0]) {
+		return nil, types.ErrInvalidCoins(path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTokenMetadataOrDefault(ctx, path[0]))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
@@ -95,3 +95,15 @@ func queryTokenV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	}
 	return res, nil
 }
+
+func queryTokenMetadataV2(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || !keeper.TokenExist(ctx, path[0]) {
+		return nil, sdk.ErrInvalidCoins("unknown token")
+	}
+
+	res, err := common.JSONMarshalV2(keeper.GetTokenMetadataOrDefault(ctx, path[0]))
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return res, nil
+}
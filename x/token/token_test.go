@@ -11,6 +11,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/bank"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/mock"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
@@ -41,6 +42,7 @@ type MockDexApp struct {
 func registerCodec(cdc *codec.Codec) {
 	RegisterCodec(cdc)
 	supply.RegisterCodec(cdc)
+	vesting.RegisterCodec(cdc)
 }
 
 func getEndBlocker(keeper Keeper) sdk.EndBlocker {
@@ -43,6 +43,12 @@ const (
 	CodeTotalsupplyExceedsTheUpperLimit            uint32 = 61032
 	CodeBlockedContractRecipient                   uint32 = 61033
 	CodeSendCoinsFromAccountToAccountFailed        uint32 = 61034
+	CodeInvalidVestingSchedule                     uint32 = 61035
+	CodeVestingAccountNotExist                     uint32 = 61036
+	CodeTokenIsNotControllable                     uint32 = 61037
+	CodeTokenTransfersPaused                       uint32 = 61038
+	CodeAddressIsFrozen                            uint32 = 61039
+	CodeInvalidTokenMetadata                       uint32 = 61040
 )
 
 var (
@@ -81,6 +87,12 @@ var (
 	errCodeConfirmOwnershipAddressNotEqualsMsgAddress = sdkerrors.Register(DefaultCodespace, CodeConfirmOwnershipAddressNotEqualsMsgAddress, "input address is not equal confirm ownership address")
 	errCodeGetDecimalFromDecimalStringFailed          = sdkerrors.Register(DefaultCodespace, CodeGetDecimalFromDecimalStringFailed, "create a decimal from an input decimal string failed")
 	errCodeTotalsupplyExceedsTheUpperLimit            = sdkerrors.Register(DefaultCodespace, CodeTotalsupplyExceedsTheUpperLimit, "total-supply exceeds the upper limit")
+	errCodeInvalidVestingSchedule                     = sdkerrors.Register(DefaultCodespace, CodeInvalidVestingSchedule, "invalid vesting schedule")
+	errCodeVestingAccountNotExist                     = sdkerrors.Register(DefaultCodespace, CodeVestingAccountNotExist, "vesting account not exist")
+	errCodeTokenIsNotControllable                     = sdkerrors.Register(DefaultCodespace, CodeTokenIsNotControllable, "token is not controllable")
+	errCodeTokenTransfersPaused                       = sdkerrors.Register(DefaultCodespace, CodeTokenTransfersPaused, "token transfers are paused")
+	errCodeAddressIsFrozen                            = sdkerrors.Register(DefaultCodespace, CodeAddressIsFrozen, "address is frozen")
+	errCodeInvalidTokenMetadata                       = sdkerrors.Register(DefaultCodespace, CodeInvalidTokenMetadata, "invalid token metadata")
 )
 
 // ErrBlockedContractRecipient returns an error when a transfer is tried on a blocked contract recipient
@@ -213,3 +225,27 @@ func ErrGetDecimalFromDecimalStringFailed(msg string) sdk.EnvelopedErr {
 func ErrCodeTotalsupplyExceedsTheUpperLimit(totalSupplyAfterMint sdk.Dec, TotalSupplyUpperbound int64) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTotalsupplyExceedsTheUpperLimit, fmt.Sprintf("total-supply(%s) exceeds the upper limit(%d)", totalSupplyAfterMint, TotalSupplyUpperbound))}
 }
+
+func ErrInvalidVestingSchedule(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidVestingSchedule, fmt.Sprintf("invalid vesting schedule: %s", msg))}
+}
+
+func ErrVestingAccountNotExist(address sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeVestingAccountNotExist, fmt.Sprintf("vesting account %s not exist", address))}
+}
+
+func ErrTokenIsNotControllable(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenIsNotControllable, fmt.Sprintf("token %s is not controllable", symbol))}
+}
+
+func ErrTokenTransfersPaused(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenTransfersPaused, fmt.Sprintf("transfers of token %s are paused", symbol))}
+}
+
+func ErrAddressIsFrozen(address sdk.AccAddress, symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAddressIsFrozen, fmt.Sprintf("address %s is frozen for token %s", address, symbol))}
+}
+
+func ErrInvalidTokenMetadata(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidTokenMetadata, fmt.Sprintf("invalid token metadata: %s", msg))}
+}
@@ -0,0 +1,16 @@
+package types
+
+// token module event types
+const (
+	EventTypeVestingTransfer = "vesting-transfer"
+	EventTypePauseToken      = "pause-token"
+	EventTypeFreezeAddresses = "freeze-addresses"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyFrom      = "from"
+	AttributeKeyStartTime = "start_time"
+	AttributeKeyEndTime   = "end_time"
+	AttributeKeySymbol    = "symbol"
+	AttributeKeyPaused    = "paused"
+	AttributeKeyFrozen    = "frozen"
+)
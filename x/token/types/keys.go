@@ -35,6 +35,13 @@ const (
 	QueryTokensV2  = "tokensV2"
 	QueryTokenV2   = "tokenV2"
 
+	QueryVestingAccount = "vesting"
+
+	QueryFrozenAddresses = "frozen"
+
+	QueryTokenMetadata   = "metadata"
+	QueryTokenMetadataV2 = "metadataV2"
+
 	UploadAccount = "upload"
 )
 
@@ -45,6 +52,8 @@ var (
 	PrefixUserTokenKey        = []byte{0x03} // the address prefix of the user-token relationship
 	LockedFeeKey              = []byte{0x04} // the address prefix of the locked order fee coins
 	PrefixConfirmOwnershipKey = []byte{0x05} // the prefix of the confirm ownership key
+	FrozenAddressKey          = []byte{0x08} // the prefix of the addresses frozen by the token's owner
+	TokenMetadataKey          = []byte{0x09} // the symbol prefix of the token's metadata
 )
 
 func GetUserTokenPrefix(owner sdk.AccAddress) []byte {
@@ -71,3 +80,25 @@ func GetLockFeeAddress(addr sdk.AccAddress) []byte {
 func GetConfirmOwnershipKey(symbol string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(symbol)...)
 }
+
+// GetTokenMetadataKey gets the key for the metadata of the token
+func GetTokenMetadataKey(symbol string) []byte {
+	return append(TokenMetadataKey, []byte(symbol)...)
+}
+
+// GetFrozenAddressesPrefix gets the prefix of the addresses frozen for the token
+func GetFrozenAddressesPrefix(symbol string) []byte {
+	return append(append(FrozenAddressKey, byte(len(symbol))), []byte(symbol)...)
+}
+
+// GetFrozenAddressKey gets the key for the address frozen for the token
+func GetFrozenAddressKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(GetFrozenAddressesPrefix(symbol), addr.Bytes()...)
+}
+
+// SplitFrozenAddressKey splits the frozen address key and returns the symbol and the address
+func SplitFrozenAddressKey(key []byte) (string, sdk.AccAddress) {
+	symbolLen := int(key[len(FrozenAddressKey)])
+	symbolEnd := len(FrozenAddressKey) + 1 + symbolLen
+	return string(key[len(FrozenAddressKey)+1 : symbolEnd]), key[symbolEnd:]
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// MaxDenomUnits is the max number of the denom units of a token
+	MaxDenomUnits = 10
+	// MaxDenomUnitLength is the max length of the denom or an alias of a denom unit
+	MaxDenomUnitLength = 32
+	// MaxURILength is the max length of the logo uri and the website of a token
+	MaxURILength = 256
+)
+
+// DenomUnit represents a unit of the token, which equals 10^Exponent of the token
+type DenomUnit struct {
+	Denom    string   `json:"denom" v2:"denom"`       // e.g. "musdk"
+	Exponent int32    `json:"exponent" v2:"exponent"` // e.g. -3
+	Aliases  []string `json:"aliases,omitempty" v2:"aliases"`
+}
+
+// ValidateBasic checks the denom and the aliases of the denom unit
+func (du DenomUnit) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(du.Denom)) == 0 || len(du.Denom) > MaxDenomUnitLength {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("invalid denom unit %q", du.Denom))
+	}
+	for _, alias := range du.Aliases {
+		if len(strings.TrimSpace(alias)) == 0 || len(alias) > MaxDenomUnitLength {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("invalid alias %q of denom unit %s", alias, du.Denom))
+		}
+	}
+	return nil
+}
+
+// TokenMetadata is the display information of a token for wallets and explorers.
+// All the fields except Verified are set by the owner, and Verified can only be set by governance
+type TokenMetadata struct {
+	Symbol     string      `json:"symbol" v2:"symbol"`           // e.g. "usdk-017"
+	Decimals   uint32      `json:"decimals" v2:"decimals"`       // e.g. 6, the decimals to display the amounts with
+	DenomUnits []DenomUnit `json:"denom_units" v2:"denom_units"` // e.g. [{"denom": "musdk", "exponent": -3}]
+	LogoURI    string      `json:"logo_uri" v2:"logo_uri"`       // e.g. "https://static.okex.com/usdk.png"
+	Website    string      `json:"website" v2:"website"`         // e.g. "https://www.okex.com"
+	Verified   bool        `json:"verified" v2:"verified"`       // e.g. false, whether the metadata is verified by governance
+}
+
+// NewTokenMetadata creates a new instance of TokenMetadata which is not verified
+func NewTokenMetadata(symbol string, decimals uint32, denomUnits []DenomUnit, logoURI, website string) TokenMetadata {
+	return TokenMetadata{
+		Symbol:     symbol,
+		Decimals:   decimals,
+		DenomUnits: denomUnits,
+		LogoURI:    logoURI,
+		Website:    website,
+	}
+}
+
+// DefaultTokenMetadata returns the metadata of the token whose metadata has not been set, which displays the amounts
+// with the precision of the coins
+func DefaultTokenMetadata(symbol string) TokenMetadata {
+	return NewTokenMetadata(symbol, sdk.Precision, nil, "", "")
+}
+
+// ValidateBasic checks the decimals, the denom units and the uris of the metadata
+func (m TokenMetadata) ValidateBasic() sdk.Error {
+	if m.Decimals > sdk.Precision {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("decimals should not be more than %d", sdk.Precision))
+	}
+	if len(m.DenomUnits) > MaxDenomUnits {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("the number of denom units should not be more than %d", MaxDenomUnits))
+	}
+	denoms := make(map[string]bool, len(m.DenomUnits))
+	for i, du := range m.DenomUnits {
+		if err := du.ValidateBasic(); err != nil {
+			return err
+		}
+		if denoms[du.Denom] {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("duplicated denom unit %s", du.Denom))
+		}
+		denoms[du.Denom] = true
+		if i > 0 && du.Exponent <= m.DenomUnits[i-1].Exponent {
+			return ErrInvalidTokenMetadata("the exponents of the denom units should be in ascending order")
+		}
+		if du.Exponent < -sdk.Precision {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("exponent of denom unit %s is less than -%d", du.Denom, sdk.Precision))
+		}
+	}
+	if err := validateURI(m.LogoURI); err != nil {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("invalid logo uri: %s", err))
+	}
+	if err := validateURI(m.Website); err != nil {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("invalid website: %s", err))
+	}
+	return nil
+}
+
+// Hash returns the hex encoded sha256 hash of the metadata regardless of its verification, which is pinned by the
+// proposals to verify the metadata
+func (m TokenMetadata) Hash() string {
+	m.Verified = false
+	hash := sha256.Sum256(ModuleCdc.MustMarshalBinaryBare(m))
+	return hex.EncodeToString(hash[:])
+}
+
+func (m TokenMetadata) String() string {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// validateURI checks the uri is empty or an absolute one
+func validateURI(uri string) error {
+	if len(uri) == 0 {
+		return nil
+	}
+	if len(uri) > MaxURILength {
+		return fmt.Errorf("length is longer than %d", MaxURILength)
+	}
+	u, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return err
+	}
+	if len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return fmt.Errorf("%s is not an absolute uri", uri)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenMetadataValidateBasic(t *testing.T) {
+	units := []DenomUnit{{Denom: "musdk", Exponent: -3, Aliases: []string{"milliusdk"}}, {Denom: "kusdk", Exponent: 3}}
+	require.Nil(t, NewTokenMetadata("usdk", 6, units, "https://static.okex.com/usdk.png", "").ValidateBasic())
+	require.Nil(t, DefaultTokenMetadata("usdk").ValidateBasic())
+
+	testCases := []TokenMetadata{
+		NewTokenMetadata("usdk", 19, nil, "", ""),
+		NewTokenMetadata("usdk", 6, []DenomUnit{{Denom: "", Exponent: 1}}, "", ""),
+		NewTokenMetadata("usdk", 6, []DenomUnit{{Denom: "musdk", Exponent: -3, Aliases: []string{" "}}}, "", ""),
+		NewTokenMetadata("usdk", 6, []DenomUnit{{Denom: "musdk", Exponent: -3}, {Denom: "musdk", Exponent: 3}}, "", ""),
+		NewTokenMetadata("usdk", 6, []DenomUnit{{Denom: "kusdk", Exponent: 3}, {Denom: "musdk", Exponent: -3}}, "", ""),
+		NewTokenMetadata("usdk", 6, []DenomUnit{{Denom: "ausdk", Exponent: -19}}, "", ""),
+		NewTokenMetadata("usdk", 6, nil, "static.okex.com/usdk.png", ""),
+		NewTokenMetadata("usdk", 6, nil, "", "https://"+strings.Repeat("a", MaxURILength)),
+	}
+	for _, metadata := range testCases {
+		require.NotNil(t, metadata.ValidateBasic(), metadata.String())
+	}
+}
+
+func TestMsgTokenModifyMetadata(t *testing.T) {
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	metadata := NewTokenMetadata("usdk", 6, nil, "https://static.okex.com/usdk.png", "https://www.okex.com")
+	msg := NewMsgTokenModify("usdk", "", "", false, false, owner)
+	msg.Metadata = &metadata
+	require.Nil(t, msg.ValidateBasic())
+
+	// the metadata of another token
+	metadata.Symbol = "xxb"
+	require.NotNil(t, msg.ValidateBasic())
+
+	// the owner can't verify the metadata by itself
+	metadata.Symbol, metadata.Verified = "usdk", true
+	require.NotNil(t, msg.ValidateBasic())
+
+	metadata.Verified, metadata.LogoURI = false, "usdk.png"
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestVerifyTokenMetadataProposalValidateBasic(t *testing.T) {
+	metadata := NewTokenMetadata("usdk", 6, nil, "https://static.okex.com/usdk.png", "")
+	hash := metadata.Hash()
+	metadata.Verified = true
+	require.Equal(t, hash, metadata.Hash())
+	metadata.Decimals = 8
+	require.NotEqual(t, hash, metadata.Hash())
+
+	require.Nil(t, NewVerifyTokenMetadataProposal("title", "desc", "usdk", true, hash).ValidateBasic())
+	require.Nil(t, NewVerifyTokenMetadataProposal("title", "desc", "usdk", false, "").ValidateBasic())
+	require.NotNil(t, NewVerifyTokenMetadataProposal("title", "desc", "usdk", true, "").ValidateBasic())
+	require.NotNil(t, NewVerifyTokenMetadataProposal("title", "desc", "usdk", true, hash[2:]).ValidateBasic())
+	require.NotNil(t, NewVerifyTokenMetadataProposal("title", "desc", "usdk", false, hash).ValidateBasic())
+}
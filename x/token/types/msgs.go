@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/common"
 )
@@ -9,6 +11,7 @@ import (
 const (
 	DescLenLimit   = 256
 	MultiSendLimit = 1000
+	FreezeLimit    = 1000
 
 	// 90 billion
 	TotalSupplyUpperbound = int64(9 * 1e10)
@@ -23,6 +26,8 @@ type MsgTokenIssue struct {
 	TotalSupply    string         `json:"total_supply"`
 	Owner          sdk.AccAddress `json:"owner"`
 	Mintable       bool           `json:"mintable"`
+	// whether the owner can pause the transfers of the token and freeze addresses, which can't be changed later
+	Controllable bool `json:"controllable,omitempty"`
 }
 
 func NewMsgTokenIssue(tokenDescription, symbol, originalSymbol, wholeName, totalSupply string, owner sdk.AccAddress, mintable bool) MsgTokenIssue {
@@ -304,6 +309,8 @@ type MsgTokenModify struct {
 	WholeName             string         `json:"whole_name"`
 	IsDescriptionModified bool           `json:"description_modified"`
 	IsWholeNameModified   bool           `json:"whole_name_modified"`
+	// the new metadata replacing the current one, which is not modified if it's nil
+	Metadata *TokenMetadata `json:"metadata,omitempty"`
 }
 
 func NewMsgTokenModify(symbol, desc, wholeName string, isDescEdit, isWholeNameEdit bool, owner sdk.AccAddress) MsgTokenModify {
@@ -346,6 +353,16 @@ func (msg MsgTokenModify) ValidateBasic() sdk.Error {
 			return ErrDescLenBiggerThanLimit()
 		}
 	}
+	// check metadata
+	if msg.Metadata != nil {
+		if msg.Metadata.Symbol != msg.Symbol {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("symbol %s mismatches the token %s", msg.Metadata.Symbol, msg.Symbol))
+		}
+		if msg.Metadata.Verified {
+			return ErrInvalidTokenMetadata("verified flag can only be set by governance")
+		}
+		return msg.Metadata.ValidateBasic()
+	}
 	return nil
 }
 
@@ -398,3 +415,185 @@ func (msg MsgConfirmOwnership) GetSignBytes() []byte {
 func (msg MsgConfirmOwnership) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Address}
 }
+
+// MsgVestingTransfer - transfers coins into the vesting account of ToAddress, which vest continuously between
+// StartTime and EndTime, or at the end of each period if VestingPeriods is not empty.
+// A zero StartTime means the vesting starts at the time of the block including the msg
+type MsgVestingTransfer struct {
+	FromAddress    sdk.AccAddress `json:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address"`
+	Amount         sdk.SysCoins   `json:"amount"`
+	StartTime      int64          `json:"start_time"`
+	EndTime        int64          `json:"end_time"`
+	VestingPeriods VestingPeriods `json:"vesting_periods"`
+}
+
+func NewMsgVestingTransfer(from, to sdk.AccAddress, coins sdk.SysCoins, startTime, endTime int64,
+	periods VestingPeriods) MsgVestingTransfer {
+	return MsgVestingTransfer{
+		FromAddress:    from,
+		ToAddress:      to,
+		Amount:         coins,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		VestingPeriods: periods,
+	}
+}
+
+func (msg MsgVestingTransfer) Route() string { return RouterKey }
+
+func (msg MsgVestingTransfer) Type() string { return "vestingTransfer" }
+
+func (msg MsgVestingTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if msg.ToAddress.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if msg.FromAddress.Equals(msg.ToAddress) {
+		return ErrInvalidVestingSchedule("coins can't be transferred to the sender itself")
+	}
+	if !msg.Amount.IsValid() {
+		return ErrInvalidCoins(msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return common.ErrInsufficientCoins(DefaultParamspace, msg.Amount.String())
+	}
+	if msg.StartTime < 0 {
+		return ErrInvalidVestingSchedule(fmt.Sprintf("start time %d should not be negative", msg.StartTime))
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		if msg.EndTime <= msg.StartTime {
+			return ErrInvalidVestingSchedule(fmt.Sprintf("end time %d should be after start time %d", msg.EndTime, msg.StartTime))
+		}
+		return nil
+	}
+	if msg.EndTime != 0 {
+		return ErrInvalidVestingSchedule("end time is derived from the vesting periods and should be zero")
+	}
+	if err := msg.VestingPeriods.ValidateBasic(); err != nil {
+		return err
+	}
+	if !msg.VestingPeriods.TotalAmount().IsEqual(msg.Amount) {
+		return ErrInvalidVestingSchedule("amount mismatches the total amount of the vesting periods")
+	}
+	return nil
+}
+
+func (msg MsgVestingTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgVestingTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetEndTime returns the end time of the vesting starting at startTime transferred by the msg
+func (msg MsgVestingTransfer) GetEndTime(startTime int64) int64 {
+	if len(msg.VestingPeriods) == 0 {
+		return msg.EndTime
+	}
+	return startTime + msg.VestingPeriods.TotalLength()
+}
+
+// MsgTokenPause - pauses or resumes all the transfers of a controllable token
+type MsgTokenPause struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Paused bool           `json:"paused"`
+}
+
+func NewMsgTokenPause(symbol string, paused bool, owner sdk.AccAddress) MsgTokenPause {
+	return MsgTokenPause{
+		Owner:  owner,
+		Symbol: symbol,
+		Paused: paused,
+	}
+}
+
+func (msg MsgTokenPause) Route() string { return RouterKey }
+
+func (msg MsgTokenPause) Type() string { return "pause" }
+
+func (msg MsgTokenPause) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(msg.Symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(msg.Symbol)
+	}
+	return nil
+}
+
+func (msg MsgTokenPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTokenFreeze - freezes or unfreezes the addresses for a controllable token.
+// A frozen address can't transfer the token out
+type MsgTokenFreeze struct {
+	Owner     sdk.AccAddress   `json:"owner"`
+	Symbol    string           `json:"symbol"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+	Frozen    bool             `json:"frozen"`
+}
+
+func NewMsgTokenFreeze(symbol string, addrs []sdk.AccAddress, frozen bool, owner sdk.AccAddress) MsgTokenFreeze {
+	return MsgTokenFreeze{
+		Owner:     owner,
+		Symbol:    symbol,
+		Addresses: addrs,
+		Frozen:    frozen,
+	}
+}
+
+func (msg MsgTokenFreeze) Route() string { return RouterKey }
+
+func (msg MsgTokenFreeze) Type() string { return "freeze" }
+
+func (msg MsgTokenFreeze) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(msg.Symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(msg.Symbol)
+	}
+	if len(msg.Addresses) == 0 {
+		return ErrAddressIsRequired()
+	}
+	if len(msg.Addresses) > FreezeLimit {
+		return ErrMsgTransfersAmountBiggerThanSendLimit()
+	}
+	for _, addr := range msg.Addresses {
+		if addr.Empty() {
+			return ErrAddressIsRequired()
+		}
+		if msg.Frozen && addr.Equals(msg.Owner) {
+			return ErrAddressIsFrozen(addr, msg.Symbol)
+		}
+	}
+	return nil
+}
+
+func (msg MsgTokenFreeze) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -375,3 +375,35 @@ bnbbbbbbbbbbbnbbbbbbbbbbnbbbbbbbbbbbnbbbbbbbbb1234`, "bnbbbbbbbbbb", false, fals
 	err := tokenEditMsg.ValidateBasic()
 	require.NoError(t, err)
 }
+
+func TestNewMsgTokenFreeze(t *testing.T) {
+	common.InitConfig()
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCase := []struct {
+		msg MsgTokenFreeze
+		err sdk.Error
+	}{
+		{NewMsgTokenFreeze("usdk", []sdk.AccAddress{addr}, true, owner), nil},
+		{NewMsgTokenFreeze("usdk", []sdk.AccAddress{owner}, false, owner), nil},
+		{NewMsgTokenFreeze("", []sdk.AccAddress{addr}, true, owner), ErrMsgSymbolIsEmpty()},
+		{NewMsgTokenFreeze("usdk", nil, true, owner), ErrAddressIsRequired()},
+		{NewMsgTokenFreeze("usdk", []sdk.AccAddress{addr}, true, sdk.AccAddress{}), ErrAddressIsRequired()},
+		{NewMsgTokenFreeze("usdk", []sdk.AccAddress{owner}, true, owner), ErrAddressIsFrozen(owner, "usdk")},
+	}
+	for _, msgCase := range testCase {
+		err := msgCase.msg.ValidateBasic()
+		if msgCase.err != nil {
+			require.EqualValues(t, msgCase.err.Error(), err.Error())
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	pauseMsg := NewMsgTokenPause("usdk", true, owner)
+	require.NoError(t, pauseMsg.ValidateBasic())
+	require.EqualValues(t, []sdk.AccAddress{owner}, pauseMsg.GetSigners())
+	require.Error(t, NewMsgTokenPause("", true, owner).ValidateBasic())
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeVerifyTokenMetadata defines the type for a VerifyTokenMetadataProposal
+	proposalTypeVerifyTokenMetadata = "VerifyTokenMetadata"
+
+	sha256Size = 32
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeVerifyTokenMetadata)
+	govtypes.RegisterProposalTypeCodec(VerifyTokenMetadataProposal{}, "okexchain/token/VerifyTokenMetadataProposal")
+}
+
+var _ govtypes.Content = (*VerifyTokenMetadataProposal)(nil)
+
+// VerifyTokenMetadataProposal - structure for the proposal to verify the metadata of a token, or to revoke it.
+// The metadata to verify is pinned by its hash, so the proposal fails if the owner modifies the metadata after
+// the proposal is submitted
+type VerifyTokenMetadataProposal struct {
+	Title        string `json:"title" yaml:"title"`
+	Description  string `json:"description" yaml:"description"`
+	Symbol       string `json:"symbol" yaml:"symbol"`
+	Verified     bool   `json:"verified" yaml:"verified"`
+	MetadataHash string `json:"metadata_hash,omitempty" yaml:"metadata_hash,omitempty"`
+}
+
+// NewVerifyTokenMetadataProposal creates a new instance of VerifyTokenMetadataProposal
+func NewVerifyTokenMetadataProposal(title, description, symbol string, verified bool,
+	metadataHash string) VerifyTokenMetadataProposal {
+	return VerifyTokenMetadataProposal{
+		Title:        title,
+		Description:  description,
+		Symbol:       symbol,
+		Verified:     verified,
+		MetadataHash: metadataHash,
+	}
+}
+
+// GetTitle returns title of a verify token metadata proposal object
+func (vp VerifyTokenMetadataProposal) GetTitle() string {
+	return vp.Title
+}
+
+// GetDescription returns description of a verify token metadata proposal object
+func (vp VerifyTokenMetadataProposal) GetDescription() string {
+	return vp.Description
+}
+
+// ProposalRoute returns route key of a verify token metadata proposal object
+func (vp VerifyTokenMetadataProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a verify token metadata proposal object
+func (vp VerifyTokenMetadataProposal) ProposalType() string {
+	return proposalTypeVerifyTokenMetadata
+}
+
+// ValidateBasic validates a verify token metadata proposal
+func (vp VerifyTokenMetadataProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(vp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(vp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(vp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(vp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if vp.ProposalType() != proposalTypeVerifyTokenMetadata {
+		return govtypes.ErrInvalidProposalType(vp.ProposalType())
+	}
+
+	if sdk.ValidateDenom(vp.Symbol) != nil {
+		return govtypes.ErrInvalidProposalContent(fmt.Sprintf("invalid symbol %s", vp.Symbol))
+	}
+
+	if vp.Verified {
+		if hash, err := hex.DecodeString(vp.MetadataHash); err != nil || len(hash) != sha256Size {
+			return govtypes.ErrInvalidProposalContent(fmt.Sprintf("invalid metadata hash %s", vp.MetadataHash))
+		}
+	} else if len(vp.MetadataHash) != 0 {
+		return govtypes.ErrInvalidProposalContent("metadata hash is only required to verify the metadata")
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a VerifyTokenMetadataProposal
+func (vp VerifyTokenMetadataProposal) String() string {
+	return fmt.Sprintf(`VerifyTokenMetadataProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Symbol:				%s
+ Verified:				%t
+ MetadataHash:			%s`,
+		vp.Title, vp.Description, vp.ProposalType(), vp.Symbol, vp.Verified, vp.MetadataHash)
+}
@@ -15,6 +15,8 @@ type Token struct {
 	Type                int            `json:"type"`                                             //e.g. 1 common token, 2 interest token
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`                                 // e.g. ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 	Mintable            bool           `json:"mintable" v2:"mintable"`                           // e.g. false
+	Controllable        bool           `json:"controllable,omitempty" v2:"controllable"`         // e.g. false, whether the owner can pause transfers and freeze addresses
+	Paused              bool           `json:"paused,omitempty" v2:"paused"`                     // e.g. false, whether all the transfers are paused
 }
 
 func (token Token) String() string {
@@ -35,6 +37,8 @@ type TokenResp struct {
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`
 	Mintable            bool           `json:"mintable" v2:"mintable"`
 	TotalSupply         sdk.Dec        `json:"total_supply" v2:"total_supply"`
+	Controllable        bool           `json:"controllable" v2:"controllable"`
+	Paused              bool           `json:"paused" v2:"paused"`
 }
 
 func (token TokenResp) String() string {
@@ -125,3 +129,9 @@ type AccCoins struct {
 	Acc   sdk.AccAddress `json:"address"`
 	Coins sdk.SysCoins   `json:"coins"`
 }
+
+// FrozenAddress is the address frozen by the owner of the token
+type FrozenAddress struct {
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting"
+	vestexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// VestingPeriod defines a length of time in seconds and the amount of coins that will vest at the end of it
+type VestingPeriod = vesting.Period
+
+// VestingPeriods is the slice of VestingPeriod
+type VestingPeriods []VestingPeriod
+
+// TotalLength returns the sum of the lengths of all the periods
+func (ps VestingPeriods) TotalLength() (length int64) {
+	for _, p := range ps {
+		length += p.Length
+	}
+	return length
+}
+
+// TotalAmount returns the sum of the amounts of all the periods
+func (ps VestingPeriods) TotalAmount() (amount sdk.SysCoins) {
+	for _, p := range ps {
+		amount = amount.Add2(p.Amount)
+	}
+	return amount
+}
+
+// ValidateBasic checks the lengths and amounts of the periods
+func (ps VestingPeriods) ValidateBasic() sdk.Error {
+	for i, p := range ps {
+		if p.Length <= 0 {
+			return ErrInvalidVestingSchedule(fmt.Sprintf("length of period %d should be positive", i))
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return ErrInvalidVestingSchedule(fmt.Sprintf("amount of period %d is invalid: %s", i, p.Amount))
+		}
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (ps VestingPeriods) String() string {
+	return vesting.Periods(ps).String()
+}
+
+// VestingAccountResponse is the response of the vesting account query
+type VestingAccountResponse struct {
+	Address          sdk.AccAddress `json:"address"`
+	OriginalVesting  sdk.SysCoins   `json:"original_vesting"`
+	StartTime        int64          `json:"start_time"`
+	EndTime          int64          `json:"end_time"`
+	VestingPeriods   VestingPeriods `json:"vesting_periods,omitempty"`
+	VestingCoins     sdk.SysCoins   `json:"vesting_coins"`     // coins still vesting
+	DelegatedVesting sdk.SysCoins   `json:"delegated_vesting"` // vesting coins delegated
+	DelegatedFree    sdk.SysCoins   `json:"delegated_free"`    // vested coins delegated
+}
+
+// NewVestingAccountResponse creates a new instance of VestingAccountResponse at blockTime
+func NewVestingAccountResponse(va vestexported.VestingAccount, blockTime time.Time) VestingAccountResponse {
+	var periods VestingPeriods
+	if pva, ok := va.(*vesting.PeriodicVestingAccount); ok {
+		periods = VestingPeriods(pva.VestingPeriods)
+	}
+	return VestingAccountResponse{
+		Address:          va.GetAddress(),
+		OriginalVesting:  va.GetOriginalVesting(),
+		StartTime:        va.GetStartTime(),
+		EndTime:          va.GetEndTime(),
+		VestingPeriods:   periods,
+		VestingCoins:     va.GetVestingCoins(blockTime),
+		DelegatedVesting: va.GetDelegatedVesting(),
+		DelegatedFree:    va.GetDelegatedFree(),
+	}
+}
+
+// String implements the stringer interface
+func (r VestingAccountResponse) String() string {
+	str := fmt.Sprintf(`Vesting Account:
+  Address:          %s
+  OriginalVesting:  %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingCoins:     %s
+  DelegatedVesting: %s
+  DelegatedFree:    %s`, r.Address, r.OriginalVesting, r.StartTime, r.EndTime,
+		r.VestingCoins, r.DelegatedVesting, r.DelegatedFree)
+	if len(r.VestingPeriods) > 0 {
+		str = fmt.Sprintf("%s\n  VestingPeriods:\n%s", str, r.VestingPeriods)
+	}
+	return str
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/crypto/secp256k1"
+	"github.com/okex/exchain/x/common"
+	"github.com/stretchr/testify/require"
+)
+
+func okt(amount int64) sdk.SysCoins {
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(amount))}
+}
+
+func TestMsgVestingTransfer(t *testing.T) {
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	periods := VestingPeriods{{Length: 10, Amount: okt(10)}, {Length: 20, Amount: okt(20)}}
+
+	testCases := []struct {
+		msg   MsgVestingTransfer
+		valid bool
+	}{
+		{NewMsgVestingTransfer(from, to, okt(30), 1000, 1100, nil), true},
+		{NewMsgVestingTransfer(from, to, okt(30), 0, 1100, nil), true},
+		{NewMsgVestingTransfer(from, to, okt(30), 1000, 0, periods), true},
+		{NewMsgVestingTransfer(from, to, okt(30), 0, 0, periods), true},
+		{NewMsgVestingTransfer(nil, to, okt(30), 1000, 1100, nil), false},
+		{NewMsgVestingTransfer(from, nil, okt(30), 1000, 1100, nil), false},
+		{NewMsgVestingTransfer(from, from, okt(30), 1000, 1100, nil), false},
+		{NewMsgVestingTransfer(from, to, sdk.SysCoins{}, 1000, 1100, nil), false},
+		{NewMsgVestingTransfer(from, to, okt(30), 1100, 1000, nil), false},
+		{NewMsgVestingTransfer(from, to, okt(30), -1, 1000, nil), false},
+		{NewMsgVestingTransfer(from, to, okt(30), 1000, 1030, periods), false},
+		{NewMsgVestingTransfer(from, to, okt(20), 1000, 0, periods), false},
+		{NewMsgVestingTransfer(from, to, okt(30), 1000, 0, VestingPeriods{{Length: 0, Amount: okt(30)}}), false},
+	}
+	for i, tc := range testCases {
+		if tc.valid {
+			require.Nil(t, tc.msg.ValidateBasic(), "test case %d", i)
+		} else {
+			require.NotNil(t, tc.msg.ValidateBasic(), "test case %d", i)
+		}
+	}
+}
+
+func TestMsgVestingTransferGetEndTime(t *testing.T) {
+	periods := VestingPeriods{{Length: 10, Amount: okt(10)}, {Length: 20, Amount: okt(20)}}
+	require.EqualValues(t, 1100, NewMsgVestingTransfer(nil, nil, okt(30), 1000, 1100, nil).GetEndTime(1000))
+	require.EqualValues(t, 1030, NewMsgVestingTransfer(nil, nil, okt(30), 1000, 0, periods).GetEndTime(1000))
+	require.EqualValues(t, 2030, NewMsgVestingTransfer(nil, nil, okt(30), 0, 0, periods).GetEndTime(2000))
+}
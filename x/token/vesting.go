@@ -0,0 +1,112 @@
+package token
+
+import (
+	"fmt"
+
+	app "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting"
+	vestexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// GetVestingAccount gets the vesting account of the address
+func (k Keeper) GetVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (vestexported.VestingAccount, bool) {
+	vestingAccount, ok := k.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+	return vestingAccount, ok
+}
+
+// VestingTransfer transfers the coins of the sender to the receiver, whose account becomes a continuous vesting
+// account, or a periodic one if the periods are not empty. The bank keeper keeps the vesting coins from being spent
+// until they vest, while they can still be delegated. An existing vesting account is only extended by the same schedule
+func (k Keeper) VestingTransfer(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.SysCoins,
+	startTime, endTime int64, periods types.VestingPeriods) error {
+	if endTime <= ctx.BlockTime().Unix() {
+		return types.ErrInvalidVestingSchedule(fmt.Sprintf("end time %d is not after the block time %d",
+			endTime, ctx.BlockTime().Unix()))
+	}
+	if err := checkVestingSchedule(k.accountKeeper.GetAccount(ctx, to), startTime, endTime, periods); err != nil {
+		return err
+	}
+	if err := k.CheckCoinsTransferable(ctx, from, amount); err != nil {
+		return err
+	}
+	if err := k.SendCoinsFromAccountToAccount(ctx, from, to, amount); err != nil {
+		return err
+	}
+
+	k.accountKeeper.SetAccount(ctx, addVestingSchedule(k.accountKeeper.GetAccount(ctx, to), amount, startTime, endTime, periods))
+	return nil
+}
+
+// checkVestingSchedule checks whether the account can be converted into a vesting account by the schedule,
+// or the vesting account has the same schedule. A nil account will be created by the transfer
+func checkVestingSchedule(acc authexported.Account, startTime, endTime int64, periods types.VestingPeriods) error {
+	switch acc := acc.(type) {
+	case nil, *app.EthAccount, *authtypes.BaseAccount:
+		return nil
+
+	case *vesting.ContinuousVestingAccount:
+		if len(periods) != 0 || acc.StartTime != startTime || acc.EndTime != endTime {
+			return types.ErrInvalidVestingSchedule(fmt.Sprintf(
+				"schedule mismatches the continuous vesting account %s", acc.Address))
+		}
+		return nil
+
+	case *vesting.PeriodicVestingAccount:
+		if acc.StartTime != startTime || len(acc.VestingPeriods) != len(periods) {
+			return types.ErrInvalidVestingSchedule(fmt.Sprintf(
+				"schedule mismatches the periodic vesting account %s", acc.Address))
+		}
+		for i, p := range periods {
+			if acc.VestingPeriods[i].Length != p.Length {
+				return types.ErrInvalidVestingSchedule(fmt.Sprintf(
+					"length of period %d mismatches the periodic vesting account %s", i, acc.Address))
+			}
+		}
+		return nil
+
+	default:
+		return types.ErrInvalidVestingSchedule(fmt.Sprintf(
+			"account %s of type %T can't be a vesting account", acc.GetAddress(), acc))
+	}
+}
+
+// addVestingSchedule converts the account into a vesting account locking the amount by the schedule,
+// or adds the amount into the vesting account with the same schedule.
+// CONTRACT: the account has been checked by checkVestingSchedule, and the amount has been added into its coins
+func addVestingSchedule(acc authexported.Account, amount sdk.SysCoins, startTime, endTime int64,
+	periods types.VestingPeriods) vestexported.VestingAccount {
+	var baseAccount *authtypes.BaseAccount
+	switch acc := acc.(type) {
+	case *vesting.ContinuousVestingAccount:
+		acc.OriginalVesting = acc.OriginalVesting.Add2(amount)
+		return acc
+
+	case *vesting.PeriodicVestingAccount:
+		for i, p := range periods {
+			acc.VestingPeriods[i].Amount = acc.VestingPeriods[i].Amount.Add2(p.Amount)
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add2(amount)
+		return acc
+
+	case *app.EthAccount:
+		baseAccount = acc.BaseAccount
+	case *authtypes.BaseAccount:
+		baseAccount = acc
+	}
+
+	baseVestingAccount := &vesting.BaseVestingAccount{
+		BaseAccount:      baseAccount,
+		OriginalVesting:  amount,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          endTime,
+	}
+	if len(periods) == 0 {
+		return vesting.NewContinuousVestingAccountRaw(baseVestingAccount, startTime)
+	}
+	return vesting.NewPeriodicVestingAccountRaw(baseVestingAccount, startTime, vesting.Periods(periods))
+}